@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // AccountCreditedDrawdown is the account which is credited in a drawdown
@@ -90,16 +92,17 @@ func (creditDD *AccountCreditedDrawdown) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on AccountCreditedDrawdown and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (creditDD *AccountCreditedDrawdown) Validate() error {
+	var errs base.ErrorList
 	if err := creditDD.fieldInclusion(); err != nil {
-		return err
+		errs.Add(err)
 	}
 	if creditDD.tag != TagAccountCreditedDrawdown {
-		return fieldError("tag", ErrValidTagForType, creditDD.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, creditDD.tag))
 	}
 	if err := creditDD.isNumeric(creditDD.DrawdownCreditAccountNumber); err != nil {
-		return fieldError("DrawdownCreditAccountNumber", err, creditDD.DrawdownCreditAccountNumber)
+		errs.Add(fieldError("DrawdownCreditAccountNumber", err, creditDD.DrawdownCreditAccountNumber))
 	}
-	return nil
+	return creditDD.collected(errs)
 }
 
 // fieldInclusion validate mandatory fields. If fields are
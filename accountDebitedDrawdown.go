@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // AccountDebitedDrawdown is the account which is debited in a drawdown
@@ -135,38 +137,39 @@ func (debitDD *AccountDebitedDrawdown) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on AccountDebitedDrawdown and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (debitDD *AccountDebitedDrawdown) Validate() error {
+	var errs base.ErrorList
 	if err := debitDD.fieldInclusion(); err != nil {
-		return err
+		errs.Add(err)
 	}
 	if debitDD.tag != TagAccountDebitedDrawdown {
-		return fieldError("tag", ErrValidTagForType, debitDD.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, debitDD.tag))
 	}
 	if err := debitDD.isIdentificationCode(debitDD.IdentificationCode); err != nil {
-		return fieldError("IdentificationCode", err, debitDD.IdentificationCode)
+		errs.Add(fieldError("IdentificationCode", err, debitDD.IdentificationCode))
 	}
 	// Can only be these Identification Codes
 	switch debitDD.IdentificationCode {
 	case
 		DemandDepositAccountNumber:
 	default:
-		return fieldError("IdentificationCode", ErrIdentificationCode, debitDD.IdentificationCode)
+		errs.Add(fieldError("IdentificationCode", ErrIdentificationCode, debitDD.IdentificationCode))
 	}
 	if err := debitDD.isAlphanumeric(debitDD.Identifier); err != nil {
-		return fieldError("Identifier", err, debitDD.Identifier)
+		errs.Add(fieldError("Identifier", err, debitDD.Identifier))
 	}
 	if err := debitDD.isAlphanumeric(debitDD.Name); err != nil {
-		return fieldError("Name", err, debitDD.Name)
+		errs.Add(fieldError("Name", err, debitDD.Name))
 	}
 	if err := debitDD.isAlphanumeric(debitDD.Address.AddressLineOne); err != nil {
-		return fieldError("AddressLineOne", err, debitDD.Address.AddressLineOne)
+		errs.Add(fieldError("AddressLineOne", err, debitDD.Address.AddressLineOne))
 	}
 	if err := debitDD.isAlphanumeric(debitDD.Address.AddressLineTwo); err != nil {
-		return fieldError("AddressLineTwo", err, debitDD.Address.AddressLineTwo)
+		errs.Add(fieldError("AddressLineTwo", err, debitDD.Address.AddressLineTwo))
 	}
 	if err := debitDD.isAlphanumeric(debitDD.Address.AddressLineThree); err != nil {
-		return fieldError("AddressLineThree", err, debitDD.Address.AddressLineThree)
+		errs.Add(fieldError("AddressLineThree", err, debitDD.Address.AddressLineThree))
 	}
-	return nil
+	return debitDD.collected(errs)
 }
 
 // fieldInclusion validate mandatory fields. If fields are
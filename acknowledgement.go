@@ -0,0 +1,141 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"errors"
+	"time"
+)
+
+// AcknowledgementStatus is the outcome of a message at the Fedwire Funds Service
+type AcknowledgementStatus string
+
+const (
+	// AcknowledgementAccepted is a message processed successfully, with or without accounting
+	AcknowledgementAccepted AcknowledgementStatus = "accepted"
+	// AcknowledgementRejected is a message rejected due to an error condition
+	AcknowledgementRejected AcknowledgementStatus = "rejected"
+	// AcknowledgementIntercepted is a message in process or intercepted
+	AcknowledgementIntercepted AcknowledgementStatus = "intercepted"
+)
+
+var (
+	// ErrNoAcknowledgement is the error given when a message has no MessageDisposition appended by the Fed
+	ErrNoAcknowledgement = errors.New("has no acknowledgement appended by the Fed")
+	// ErrMessageStatusIndicator is the error given for an unknown MessageStatusIndicator
+	ErrMessageStatusIndicator = errors.New("is an invalid MessageStatusIndicator")
+)
+
+// receiptTimeFormat is the layout of the ReceiptDate and ReceiptTime of ReceiptTimeStamp
+const receiptTimeFormat = "01021504"
+
+// Acknowledgement is the Fed's acknowledgement of a message, read from the tags it appends: MessageDisposition
+// {1100}, ReceiptTimeStamp {1110}, OutputMessageAccountabilityData {1120} and ErrorWire {1130}.
+type Acknowledgement struct {
+	// Status is accepted, rejected or intercepted, from the MessageStatusIndicator
+	Status AcknowledgementStatus `json:"status"`
+	// MessageStatusIndicator is the status as appended by the Fed
+	MessageStatusIndicator string `json:"messageStatusIndicator"`
+	// Value is true if the message was processed with accounting
+	Value bool `json:"value"`
+	// ReceiptTime is when the Fed received the message, in EasternTime, or nil without a ReceiptTimeStamp
+	ReceiptTime *time.Time `json:"receiptTime,omitempty"`
+	// ReceiptApplicationIdentification is the Fed application which received the message
+	ReceiptApplicationIdentification string `json:"receiptApplicationIdentification,omitempty"`
+	// OMAD is the Output Message Accountability Data of the message, its reference at the Fed
+	OMAD string `json:"omad,omitempty"`
+	// ErrorCategory is the category of the error of a rejected or intercepted message, see ErrorCategoryData
+	ErrorCategory string `json:"errorCategory,omitempty"`
+	// ErrorCode is the code of the error of a rejected or intercepted message
+	ErrorCode string `json:"errorCode,omitempty"`
+	// ErrorDescription describes the error of a rejected or intercepted message
+	ErrorDescription string `json:"errorDescription,omitempty"`
+}
+
+// IsAcknowledgement returns true if the message is the Fed's acknowledgement of an outgoing message: it has
+// a MessageDisposition, whose MessageStatusIndicator isn't that of an incoming message.
+func (fwm *FEDWireMessage) IsAcknowledgement() bool {
+	if fwm.MessageDisposition == nil {
+		return false
+	}
+	switch fwm.MessageDisposition.MessageStatusIndicator {
+	case MessageStatusIncomingValue, MessageStatusIncomingNonValue:
+		return false
+	}
+	return true
+}
+
+// Acknowledgement returns the Fed's acknowledgement of the message, read from the tags it appends. It returns
+// ErrNoAcknowledgement if the message has no MessageDisposition.
+func (fwm *FEDWireMessage) Acknowledgement() (*Acknowledgement, error) {
+	if fwm.MessageDisposition == nil {
+		return nil, fieldError("MessageDisposition", ErrNoAcknowledgement)
+	}
+	ack := &Acknowledgement{
+		MessageStatusIndicator: fwm.MessageDisposition.MessageStatusIndicator,
+	}
+	switch ack.MessageStatusIndicator {
+	case MessageStatusSuccessfulValue, MessageStatusIncomingValue:
+		ack.Status, ack.Value = AcknowledgementAccepted, true
+	case MessageStatusSuccessfulNonValue, MessageStatusIncomingNonValue:
+		ack.Status = AcknowledgementAccepted
+	case MessageStatusRejected:
+		ack.Status = AcknowledgementRejected
+	case MessageStatusInProcess:
+		ack.Status = AcknowledgementIntercepted
+	default:
+		return nil, fieldError("MessageStatusIndicator", ErrMessageStatusIndicator, ack.MessageStatusIndicator)
+	}
+
+	if rts := fwm.ReceiptTimeStamp; rts != nil {
+		receipt, err := time.ParseInLocation(receiptTimeFormat, rts.ReceiptDate+rts.ReceiptTime, EasternTime)
+		if err != nil {
+			return nil, fieldError("ReceiptTimeStamp", ErrValidDate, rts.ReceiptDate+rts.ReceiptTime)
+		}
+		receipt = receiptYear(receipt, fwm.acknowledgementReference())
+		ack.ReceiptTime = &receipt
+		ack.ReceiptApplicationIdentification = rts.ReceiptApplicationIdentification
+	}
+	if omad := fwm.OutputMessageAccountabilityData; omad != nil {
+		ack.OMAD = omad.OutputCycleDateField() + omad.OutputDestinationIDField() + omad.OutputSequenceNumberField() +
+			omad.OutputDateField() + omad.OutputTimeField() + omad.OutputFRBApplicationIdentificationField()
+	}
+	if ew := fwm.ErrorWire; ew != nil {
+		ack.ErrorCategory = ew.ErrorCategory
+		ack.ErrorCode = ew.ErrorCode
+		ack.ErrorDescription = ew.ErrorDescription
+	}
+	return ack, nil
+}
+
+// acknowledgementReference returns a date near the Fed's receipt of the message, as ReceiptTimeStamp has no
+// year: its IMAD or OMAD cycle date, or now
+func (fwm *FEDWireMessage) acknowledgementReference() time.Time {
+	var dates []string
+	if fwm.InputMessageAccountabilityData != nil {
+		dates = append(dates, fwm.InputMessageAccountabilityData.InputCycleDate)
+	}
+	if fwm.OutputMessageAccountabilityData != nil {
+		dates = append(dates, fwm.OutputMessageAccountabilityData.OutputCycleDate)
+	}
+	for _, date := range dates {
+		if t, err := time.ParseInLocation(CycleDateFormat, date, EasternTime); err == nil {
+			return t
+		}
+	}
+	return time.Now().In(EasternTime)
+}
+
+// receiptYear moves t, parsed without a year, to the year which puts it closest to ref
+func receiptYear(t, ref time.Time) time.Time {
+	t = t.AddDate(ref.Year()-t.Year(), 0, 0)
+	switch diff := t.Sub(ref); {
+	case diff > 183*24*time.Hour:
+		return t.AddDate(-1, 0, 0)
+	case diff < -183*24*time.Hour:
+		return t.AddDate(1, 0, 0)
+	}
+	return t
+}
@@ -0,0 +1,105 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFEDWireMessage_Acknowledgement(t *testing.T) {
+	f, err := os.Open(filepath.Join("test", "testdata", "fedWireMessage-FedAppendedTags.txt"))
+	require.NoError(t, err)
+	defer f.Close()
+	file, err := NewReader(f).Read()
+	require.NoError(t, err)
+	require.Len(t, file.FEDWireMessages, 1)
+
+	ack, err := file.FEDWireMessages[0].Acknowledgement()
+	require.NoError(t, err)
+	receipt := time.Date(2019, time.May, 2, 12, 30, 0, 0, EasternTime)
+	require.Equal(t, &Acknowledgement{
+		Status:                           AcknowledgementAccepted,
+		MessageStatusIndicator:           MessageStatusSuccessfulValue,
+		Value:                            true,
+		ReceiptTime:                      &receipt,
+		ReceiptApplicationIdentification: "A123",
+		OMAD:                             "20190502Source0800000105021230B123",
+		ErrorCategory:                    ErrorCategoryData,
+		ErrorCode:                        "XYZ",
+		ErrorDescription:                 "Data Error",
+	}, ack)
+}
+
+func TestFEDWireMessage_AcknowledgementStatus(t *testing.T) {
+	cases := []struct {
+		indicator string
+		status    AcknowledgementStatus
+		value     bool
+		outgoing  bool
+	}{
+		{MessageStatusInProcess, AcknowledgementIntercepted, false, true},
+		{MessageStatusSuccessfulValue, AcknowledgementAccepted, true, true},
+		{MessageStatusRejected, AcknowledgementRejected, false, true},
+		{MessageStatusSuccessfulNonValue, AcknowledgementAccepted, false, true},
+		{MessageStatusIncomingValue, AcknowledgementAccepted, true, false},
+		{MessageStatusIncomingNonValue, AcknowledgementAccepted, false, false},
+	}
+	for _, tc := range cases {
+		fwm := mockCustomerTransferData()
+		fwm.MessageDisposition = NewMessageDisposition()
+		fwm.MessageDisposition.MessageStatusIndicator = tc.indicator
+
+		ack, err := fwm.Acknowledgement()
+		require.NoError(t, err)
+		require.Equal(t, tc.status, ack.Status, tc.indicator)
+		require.Equal(t, tc.value, ack.Value, tc.indicator)
+		require.Equal(t, tc.outgoing, fwm.IsAcknowledgement(), tc.indicator)
+		require.Nil(t, ack.ReceiptTime)
+		require.Empty(t, ack.OMAD)
+	}
+}
+
+func TestFEDWireMessage_AcknowledgementErrors(t *testing.T) {
+	fwm := mockCustomerTransferData()
+	_, err := fwm.Acknowledgement()
+	require.ErrorIs(t, err, ErrNoAcknowledgement)
+
+	fwm.MessageDisposition = NewMessageDisposition()
+	fwm.MessageDisposition.MessageStatusIndicator = "Z"
+	_, err = fwm.Acknowledgement()
+	require.ErrorIs(t, err, ErrMessageStatusIndicator)
+
+	fwm.MessageDisposition.MessageStatusIndicator = MessageStatusRejected
+	fwm.ReceiptTimeStamp = NewReceiptTimeStamp()
+	fwm.ReceiptTimeStamp.ReceiptDate = "1332"
+	fwm.ReceiptTimeStamp.ReceiptTime = "1230"
+	_, err = fwm.Acknowledgement()
+	require.ErrorIs(t, err, ErrValidDate)
+}
+
+func TestFEDWireMessage_AcknowledgementReceiptYear(t *testing.T) {
+	fwm := mockCustomerTransferData()
+	fwm.MessageDisposition = NewMessageDisposition()
+	fwm.MessageDisposition.MessageStatusIndicator = MessageStatusSuccessfulValue
+	fwm.ReceiptTimeStamp = NewReceiptTimeStamp()
+	fwm.ReceiptTimeStamp.ReceiptDate = "1231"
+	fwm.ReceiptTimeStamp.ReceiptTime = "2130"
+
+	// received the evening before the cycle date of the new year
+	fwm.InputMessageAccountabilityData.InputCycleDate = "20210104"
+	ack, err := fwm.Acknowledgement()
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2020, time.December, 31, 21, 30, 0, 0, EasternTime), *ack.ReceiptTime)
+
+	fwm.InputMessageAccountabilityData.InputCycleDate = "20201231"
+	ack, err = fwm.Acknowledgement()
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2020, time.December, 31, 21, 30, 0, 0, EasternTime), *ack.ReceiptTime)
+}
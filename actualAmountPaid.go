@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // ActualAmountPaid is the actual amount paid
@@ -101,19 +103,20 @@ func (aap *ActualAmountPaid) Format(options FormatOptions) string {
 // The first error encountered is returned and stops that parsing.
 // Currency Code and Amount are mandatory for each set of remittance data.
 func (aap *ActualAmountPaid) Validate() error {
+	var errs base.ErrorList
 	if err := aap.fieldInclusion(); err != nil {
-		return err
+		errs.Add(err)
 	}
 	if aap.tag != TagActualAmountPaid {
-		return fieldError("tag", ErrValidTagForType, aap.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, aap.tag))
 	}
 	if err := aap.isCurrencyCode(aap.RemittanceAmount.CurrencyCode); err != nil {
-		return fieldError("CurrencyCode", err, aap.RemittanceAmount.CurrencyCode)
+		errs.Add(fieldError("CurrencyCode", err, aap.RemittanceAmount.CurrencyCode))
 	}
 	if err := aap.isAmount(aap.RemittanceAmount.Amount); err != nil {
-		return fieldError("Amount", err, aap.RemittanceAmount.Amount)
+		errs.Add(fieldError("Amount", err, aap.RemittanceAmount.Amount))
 	}
-	return nil
+	return aap.collected(errs)
 }
 
 // fieldInclusion validate mandatory fields. If fields are
@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // Adjustment is adjustment
@@ -135,25 +137,26 @@ func (adj *Adjustment) Format(options FormatOptions) string {
 // The first error encountered is returned and stops that parsing.
 // Adjustment Reason, Credit Debit Indicator, Currency Code and Amount are mandatory.
 func (adj *Adjustment) Validate() error {
+	var errs base.ErrorList
 	if err := adj.fieldInclusion(); err != nil {
-		return err
+		errs.Add(err)
 	}
 	if adj.tag != TagAdjustment {
-		return fieldError("tag", ErrValidTagForType, adj.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, adj.tag))
 	}
 	if err := adj.isAdjustmentReasonCode(adj.AdjustmentReasonCode); err != nil {
-		return fieldError("AdjustmentReasonCode", err, adj.AdjustmentReasonCode)
+		errs.Add(fieldError("AdjustmentReasonCode", err, adj.AdjustmentReasonCode))
 	}
 	if err := adj.isCreditDebitIndicator(adj.CreditDebitIndicator); err != nil {
-		return fieldError("CreditDebitIndicator", err, adj.CreditDebitIndicator)
+		errs.Add(fieldError("CreditDebitIndicator", err, adj.CreditDebitIndicator))
 	}
 	if err := adj.isCurrencyCode(adj.RemittanceAmount.CurrencyCode); err != nil {
-		return fieldError("CurrencyCode", err, adj.RemittanceAmount.CurrencyCode)
+		errs.Add(fieldError("CurrencyCode", err, adj.RemittanceAmount.CurrencyCode))
 	}
 	if err := adj.isAmount(adj.RemittanceAmount.Amount); err != nil {
-		return fieldError("Amount", err, adj.RemittanceAmount.Amount)
+		errs.Add(fieldError("Amount", err, adj.RemittanceAmount.Amount))
 	}
-	return nil
+	return adj.collected(errs)
 }
 
 // fieldInclusion validate mandatory fields. If fields are
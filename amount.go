@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // Amount (up to a penny less than $10 billion) {2000}
@@ -73,16 +75,17 @@ func (a *Amount) String() string {
 // Validate performs WIRE format rule checks on Amount and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (a *Amount) Validate() error {
+	var errs base.ErrorList
 	if err := a.fieldInclusion(); err != nil {
-		return err
+		errs.Add(err)
 	}
 	if a.tag != TagAmount {
-		return fieldError("tag", ErrValidTagForType, a.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, a.tag))
 	}
 	if err := a.isAmountImplied(a.Amount); err != nil {
-		return fieldError("Amount", err, a.Amount)
+		errs.Add(fieldError("Amount", err, a.Amount))
 	}
-	return nil
+	return a.collected(errs)
 }
 
 // fieldInclusion validate mandatory fields. If fields are
@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // AmountNegotiatedDiscount is the amount negotiated discount
@@ -100,19 +102,20 @@ func (nd *AmountNegotiatedDiscount) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on AmountNegotiatedDiscount and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (nd *AmountNegotiatedDiscount) Validate() error {
+	var errs base.ErrorList
 	if err := nd.fieldInclusion(); err != nil {
-		return err
+		errs.Add(err)
 	}
 	if nd.tag != TagAmountNegotiatedDiscount {
-		return fieldError("tag", ErrValidTagForType, nd.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, nd.tag))
 	}
 	if err := nd.isCurrencyCode(nd.RemittanceAmount.CurrencyCode); err != nil {
-		return fieldError("CurrencyCode", err, nd.RemittanceAmount.CurrencyCode)
+		errs.Add(fieldError("CurrencyCode", err, nd.RemittanceAmount.CurrencyCode))
 	}
 	if err := nd.isAmount(nd.RemittanceAmount.Amount); err != nil {
-		return fieldError("Amount", err, nd.RemittanceAmount.Amount)
+		errs.Add(fieldError("Amount", err, nd.RemittanceAmount.Amount))
 	}
-	return nil
+	return nd.collected(errs)
 }
 
 // fieldInclusion validate mandatory fields. If fields are
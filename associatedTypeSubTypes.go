@@ -96,3 +96,32 @@ var svcTypeSubTypes = associatedTypeSubTypes{
 	SettlementTransfer + RefusalRequestCredit,
 	SettlementTransfer + SSIServiceMessage,
 }
+
+// typeSubTypesOf returns the types/subtypes associated with a BusinessFunctionCode
+func typeSubTypesOf(bfc string) associatedTypeSubTypes {
+	switch bfc {
+	case BankTransfer:
+		return btrTypeSubTypes
+	case CustomerTransfer:
+		return ctrTypeSubTypes
+	case CustomerTransferPlus:
+		return ctpTypeSubTypes
+	case CheckSameDaySettlement:
+		return cksTypeSubTypes
+	case DepositSendersAccount:
+		return depTypeSubTypes
+	case FEDFundsReturned:
+		return ffrTypeSubTypes
+	case FEDFundsSold:
+		return ffsTypeSubTypes
+	case DrawdownResponse:
+		return drwTypeSubTypes
+	case BankDrawDownRequest:
+		return drbTypeSubTypes
+	case CustomerCorporateDrawdownRequest:
+		return drcTypeSubTypes
+	case BFCServiceMessage:
+		return svcTypeSubTypes
+	}
+	return nil
+}
@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // Beneficiary is the beneficiary of the wire
@@ -131,12 +133,13 @@ func (ben *Beneficiary) Format(options FormatOptions) string {
 // The first error encountered is returned and stops that parsing.
 // If ID Code is present, Identifier is mandatory and vice versa.
 func (ben *Beneficiary) Validate() error {
+	var errs base.ErrorList
 	if ben.tag != TagBeneficiary {
-		return fieldError("tag", ErrValidTagForType, ben.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, ben.tag))
 	}
 
 	if err := ben.fieldInclusion(); err != nil {
-		return err
+		errs.Add(err)
 	}
 
 	// Per FAIM 3.0.6, Beneficiary ID code is optional.
@@ -145,27 +148,30 @@ func (ben *Beneficiary) Validate() error {
 	if ben.Personal.IdentificationCode != "" {
 		// If it is present, confirm it is a valid code
 		if err := ben.isIdentificationCode(ben.Personal.IdentificationCode); err != nil {
-			return fieldError("IdentificationCode", err, ben.Personal.IdentificationCode)
+			errs.Add(fieldError("IdentificationCode", err, ben.Personal.IdentificationCode))
 		}
 		// Identifier text must only contain allowed characters
 		if err := ben.isAlphanumeric(ben.Personal.Identifier); err != nil {
-			return fieldError("Identifier", err, ben.Personal.Identifier)
+			errs.Add(fieldError("Identifier", err, ben.Personal.Identifier))
+		}
+		if err := ben.isSWIFTIdentifier(ben.Personal.IdentificationCode, ben.Personal.Identifier); err != nil {
+			errs.Add(fieldError("Identifier", err, ben.Personal.Identifier))
 		}
 	}
 
 	if err := ben.isAlphanumeric(ben.Personal.Name); err != nil {
-		return fieldError("Name", err, ben.Personal.Name)
+		errs.Add(fieldError("Name", err, ben.Personal.Name))
 	}
 	if err := ben.isAlphanumeric(ben.Personal.Address.AddressLineOne); err != nil {
-		return fieldError("AddressLineOne", err, ben.Personal.Address.AddressLineOne)
+		errs.Add(fieldError("AddressLineOne", err, ben.Personal.Address.AddressLineOne))
 	}
 	if err := ben.isAlphanumeric(ben.Personal.Address.AddressLineTwo); err != nil {
-		return fieldError("AddressLineTwo", err, ben.Personal.Address.AddressLineTwo)
+		errs.Add(fieldError("AddressLineTwo", err, ben.Personal.Address.AddressLineTwo))
 	}
 	if err := ben.isAlphanumeric(ben.Personal.Address.AddressLineThree); err != nil {
-		return fieldError("AddressLineThree", err, ben.Personal.Address.AddressLineThree)
+		errs.Add(fieldError("AddressLineThree", err, ben.Personal.Address.AddressLineThree))
 	}
-	return nil
+	return ben.collected(errs)
 }
 
 // fieldInclusion validate mandatory fields. If fields are
@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // BeneficiaryCustomer is the beneficiary customer
@@ -136,31 +138,35 @@ func (bc *BeneficiaryCustomer) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on BeneficiaryCustomer and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (bc *BeneficiaryCustomer) Validate() error {
+	var errs base.ErrorList
 	if err := bc.fieldInclusion(); err != nil {
-		return err
+		errs.Add(err)
 	}
 	if bc.tag != TagBeneficiaryCustomer {
-		return fieldError("tag", ErrValidTagForType, bc.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, bc.tag))
 	}
 	if err := bc.isAlphanumeric(bc.CoverPayment.SwiftFieldTag); err != nil {
-		return fieldError("SwiftFieldTag", err, bc.CoverPayment.SwiftFieldTag)
+		errs.Add(fieldError("SwiftFieldTag", err, bc.CoverPayment.SwiftFieldTag))
 	}
 	if err := bc.isAlphanumeric(bc.CoverPayment.SwiftLineOne); err != nil {
-		return fieldError("SwiftLineOne", err, bc.CoverPayment.SwiftLineOne)
+		errs.Add(fieldError("SwiftLineOne", err, bc.CoverPayment.SwiftLineOne))
 	}
 	if err := bc.isAlphanumeric(bc.CoverPayment.SwiftLineTwo); err != nil {
-		return fieldError("SwiftLineTwo", err, bc.CoverPayment.SwiftLineTwo)
+		errs.Add(fieldError("SwiftLineTwo", err, bc.CoverPayment.SwiftLineTwo))
 	}
 	if err := bc.isAlphanumeric(bc.CoverPayment.SwiftLineThree); err != nil {
-		return fieldError("SwiftLineThree", err, bc.CoverPayment.SwiftLineThree)
+		errs.Add(fieldError("SwiftLineThree", err, bc.CoverPayment.SwiftLineThree))
 	}
 	if err := bc.isAlphanumeric(bc.CoverPayment.SwiftLineFour); err != nil {
-		return fieldError("SwiftLineFour", err, bc.CoverPayment.SwiftLineFour)
+		errs.Add(fieldError("SwiftLineFour", err, bc.CoverPayment.SwiftLineFour))
 	}
 	if err := bc.isAlphanumeric(bc.CoverPayment.SwiftLineFive); err != nil {
-		return fieldError("SwiftLineFive", err, bc.CoverPayment.SwiftLineFive)
+		errs.Add(fieldError("SwiftLineFive", err, bc.CoverPayment.SwiftLineFive))
 	}
-	return nil
+	if err := bc.validateSwiftParty(bc.CoverPayment); err != nil {
+		errs.Add(err)
+	}
+	return bc.collected(errs)
 }
 
 // fieldInclusion validate mandatory fields. If fields are
@@ -180,3 +180,31 @@ func TestStringBeneficiaryCustomerOptions(t *testing.T) {
 	require.Equal(t, "{7059}*", bc.Format(FormatOptions{VariableLengthFields: true}))
 	require.Equal(t, bc.String(), bc.Format(FormatOptions{VariableLengthFields: false}))
 }
+
+// TestBeneficiaryCustomerSwiftParty validates the BIC and IBAN of option A
+func TestBeneficiaryCustomerSwiftParty(t *testing.T) {
+	bc := mockBeneficiaryCustomer()
+	bc.CoverPayment = CoverPayment{
+		SwiftFieldTag: "59A",
+		SwiftLineOne:  "/DE89370400440532013000",
+		SwiftLineTwo:  "DEUTDEFF",
+	}
+	require.NoError(t, bc.Validate())
+
+	bc.CoverPayment.SwiftLineTwo = "Deutsche Bank"
+	err := bc.Validate()
+	require.ErrorIs(t, err, ErrBIC)
+	require.ErrorContains(t, err, "SwiftLineTwo")
+
+	bc.CoverPayment.SwiftLineTwo = "DEUTDEFF"
+	bc.CoverPayment.SwiftLineOne = "/DE88370400440532013000"
+	require.ErrorIs(t, bc.Validate(), ErrIBAN)
+
+	// other options only have their IBAN checked
+	bc.CoverPayment = CoverPayment{
+		SwiftFieldTag: "59",
+		SwiftLineOne:  "/12345678",
+		SwiftLineTwo:  "Name",
+	}
+	require.NoError(t, bc.Validate())
+}
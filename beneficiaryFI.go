@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // BeneficiaryFI is the financial institution of the beneficiary
@@ -128,15 +130,21 @@ func (bfi *BeneficiaryFI) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on BeneficiaryFI and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (bfi *BeneficiaryFI) Validate() error {
+	var errs base.ErrorList
 	if bfi.tag != TagBeneficiaryFI {
-		return fieldError("tag", ErrValidTagForType, bfi.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, bfi.tag))
 	}
 
 	if err := bfi.FinancialInstitution.Validate(); err != nil {
-		return err
+		errs.Add(err)
 	}
 
-	return nil
+	return bfi.FinancialInstitution.collected(errs)
+}
+
+// setValidateOpts sets the ValidateOpts the checks of the FinancialInstitution follow
+func (bfi *BeneficiaryFI) setValidateOpts(opts *ValidateOpts) {
+	bfi.FinancialInstitution.setValidateOpts(opts)
 }
 
 // IdentificationCodeField gets a string of the IdentificationCode field
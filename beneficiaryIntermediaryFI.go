@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // BeneficiaryIntermediaryFI {4000}
@@ -129,15 +131,21 @@ func (bifi *BeneficiaryIntermediaryFI) Format(options FormatOptions) string {
 // The first error encountered is returned and stops that parsing.
 // If ID Code is present, Identifier is mandatory and vice versa.
 func (bifi *BeneficiaryIntermediaryFI) Validate() error {
+	var errs base.ErrorList
 	if bifi.tag != TagBeneficiaryIntermediaryFI {
-		return fieldError("tag", ErrValidTagForType, bifi.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, bifi.tag))
 	}
 
 	if err := bifi.FinancialInstitution.Validate(); err != nil {
-		return err
+		errs.Add(err)
 	}
 
-	return nil
+	return bifi.FinancialInstitution.collected(errs)
+}
+
+// setValidateOpts sets the ValidateOpts the checks of the FinancialInstitution follow
+func (bifi *BeneficiaryIntermediaryFI) setValidateOpts(opts *ValidateOpts) {
+	bifi.FinancialInstitution.setValidateOpts(opts)
 }
 
 // IdentificationCodeField gets a string of the IdentificationCode field
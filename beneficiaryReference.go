@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // BeneficiaryReference is a reference for the beneficiary
@@ -92,13 +94,14 @@ func (br *BeneficiaryReference) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on BeneficiaryReference and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (br *BeneficiaryReference) Validate() error {
+	var errs base.ErrorList
 	if br.tag != TagBeneficiaryReference {
-		return fieldError("tag", ErrValidTagForType, br.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, br.tag))
 	}
 	if err := br.isAlphanumeric(br.BeneficiaryReference); err != nil {
-		return fieldError("BeneficiaryReference", err, br.BeneficiaryReference)
+		errs.Add(fieldError("BeneficiaryReference", err, br.BeneficiaryReference))
 	}
-	return nil
+	return br.collected(errs)
 }
 
 // BeneficiaryReferenceField gets a string of the BeneficiaryReference field
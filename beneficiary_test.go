@@ -195,3 +195,18 @@ func TestStringBeneficiaryOptions(t *testing.T) {
 	require.Equal(t, "{4200}31234*", ben.Format(FormatOptions{VariableLengthFields: true}))
 	require.Equal(t, ben.String(), ben.Format(FormatOptions{VariableLengthFields: false}))
 }
+
+// TestBeneficiarySWIFTIdentifier validates the BIC and IBAN of a SWIFT identifier
+func TestBeneficiarySWIFTIdentifier(t *testing.T) {
+	ben := mockBeneficiary()
+	ben.Personal.IdentificationCode = SWIFTBankIdentifierCode
+	ben.Personal.Identifier = "DEUTDEFF"
+	require.NoError(t, ben.Validate())
+
+	ben.Personal.Identifier = "1234"
+	require.ErrorIs(t, ben.Validate(), ErrBIC)
+
+	ben.Personal.IdentificationCode = SWIFTBICORBEIANDAccountNumber
+	ben.Personal.Identifier = "DEUTDEFF/DE88370400440532013000"
+	require.ErrorIs(t, ben.Validate(), ErrIBAN)
+}
@@ -0,0 +1,202 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"reflect"
+	"slices"
+)
+
+// Builder assembles a FEDWireMessage for one business function code. The NewX constructors fill in
+// SenderSupplied, TypeSubType, BusinessFunctionCode and the tags the business function code requires, the
+// Builder's methods add optional tags and Build checks the message before returning it.
+//
+// The IMAD has no sensible default, so it must be set with IMAD unless the message's ValidateOptions skip it.
+type Builder struct {
+	fwm FEDWireMessage
+}
+
+// newBuilder returns a Builder for a message of typeCode, subTypeCode and business function code bfc
+// between the sender and receiver routing numbers
+func newBuilder(typeCode, subTypeCode, bfc, sender, receiver, amount string) *Builder {
+	b := &Builder{}
+	b.fwm.SenderSupplied = NewSenderSupplied()
+
+	b.fwm.TypeSubType = NewTypeSubType()
+	b.fwm.TypeSubType.TypeCode = typeCode
+	b.fwm.TypeSubType.SubTypeCode = subTypeCode
+
+	b.fwm.Amount = NewAmount()
+	b.fwm.Amount.Amount = amount
+
+	b.fwm.SenderDepositoryInstitution = NewSenderDepositoryInstitution()
+	b.fwm.SenderDepositoryInstitution.SenderABANumber = sender
+	b.fwm.ReceiverDepositoryInstitution = NewReceiverDepositoryInstitution()
+	b.fwm.ReceiverDepositoryInstitution.ReceiverABANumber = receiver
+
+	b.fwm.BusinessFunctionCode = NewBusinessFunctionCode()
+	b.fwm.BusinessFunctionCode.BusinessFunctionCode = bfc
+	return b
+}
+
+// NewCustomerTransfer returns a Builder for a CustomerTransfer (CTR) of amount, in implied cents, from the
+// sender to the receiver routing number. originator is the {5000} Originator and beneficiary the {4200}
+// Beneficiary.
+func NewCustomerTransfer(sender, receiver, amount string, originator, beneficiary Personal) *Builder {
+	b := newBuilder(FundsTransfer, BasicFundsTransfer, CustomerTransfer, sender, receiver, amount)
+	b.fwm.Originator = NewOriginator()
+	b.fwm.Originator.Personal = originator
+	b.fwm.Beneficiary = NewBeneficiary()
+	b.fwm.Beneficiary.Personal = beneficiary
+	return b
+}
+
+// NewBankTransfer returns a Builder for a BankTransfer (BTR) of amount, in implied cents, from the sender to
+// the receiver routing number.
+func NewBankTransfer(sender, receiver, amount string) *Builder {
+	return newBuilder(FundsTransfer, BasicFundsTransfer, BankTransfer, sender, receiver, amount)
+}
+
+// NewDrawdownRequest returns a Builder for a CustomerCorporateDrawdownRequest (DRC), sent by the sender to
+// the receiver routing number asking for amount, in implied cents. debit is the {4400} AccountDebitedDrawdown
+// held at the receiver, creditAccount the {5400} account to credit and beneficiary the {4200} Beneficiary.
+func NewDrawdownRequest(sender, receiver, amount string, debit Personal, creditAccount string, beneficiary Personal) *Builder {
+	b := newBuilder(FundsTransfer, RequestCredit, CustomerCorporateDrawdownRequest, sender, receiver, amount)
+	b.fwm.AccountDebitedDrawdown = NewAccountDebitedDrawdown()
+	b.fwm.AccountDebitedDrawdown.IdentificationCode = debit.IdentificationCode
+	b.fwm.AccountDebitedDrawdown.Identifier = debit.Identifier
+	b.fwm.AccountDebitedDrawdown.Name = debit.Name
+	b.fwm.AccountDebitedDrawdown.Address = debit.Address
+	b.fwm.AccountCreditedDrawdown = NewAccountCreditedDrawdown()
+	b.fwm.AccountCreditedDrawdown.DrawdownCreditAccountNumber = creditAccount
+	b.fwm.Beneficiary = NewBeneficiary()
+	b.fwm.Beneficiary.Personal = beneficiary
+	return b
+}
+
+// NewBFCServiceMessage returns a Builder for a non-value BFCServiceMessage (SVC) from the sender to the
+// receiver routing number carrying the {9000} ServiceMessage lines. Like the business function code, it's
+// prefixed with BFC as NewServiceMessage returns the {9000} tag itself.
+func NewBFCServiceMessage(sender, receiver string, message ServiceMessage) *Builder {
+	b := newBuilder(FundsTransfer, SSIServiceMessage, BFCServiceMessage, sender, receiver, "000000000000")
+	message.tag = TagServiceMessage
+	b.fwm.ServiceMessage = &message
+	return b
+}
+
+// requireReplyTags returns an error unless orig has the tags a reply to it is built from
+func requireReplyTags(orig *FEDWireMessage) error {
+	switch {
+	case orig == nil:
+		return fieldError("FEDWireMessage", ErrFieldRequired)
+	case orig.TypeSubType == nil:
+		return fieldError("TypeSubType", ErrFieldRequired)
+	case orig.BusinessFunctionCode == nil:
+		return fieldError("BusinessFunctionCode", ErrFieldRequired)
+	case orig.Amount == nil:
+		return fieldError("Amount", ErrFieldRequired)
+	case orig.SenderDepositoryInstitution == nil:
+		return fieldError("SenderDepositoryInstitution", ErrFieldRequired)
+	case orig.ReceiverDepositoryInstitution == nil:
+		return fieldError("ReceiverDepositoryInstitution", ErrFieldRequired)
+	}
+	return nil
+}
+
+// reply returns a Builder for a message of business function code bfc and subType for orig's amount, sent
+// back from orig's receiver to its sender. Its {3500} PreviousMessageIdentifier is orig's IMAD, or OMAD when
+// orig has no IMAD.
+func reply(orig *FEDWireMessage, bfc, subType string) (*Builder, error) {
+	b := newBuilder(orig.TypeSubType.TypeCode, subType, bfc,
+		orig.ReceiverDepositoryInstitution.ReceiverABANumber, orig.SenderDepositoryInstitution.SenderABANumber,
+		orig.Amount.Amount)
+	b.ShortNames(orig.ReceiverDepositoryInstitution.ReceiverShortName, orig.SenderDepositoryInstitution.SenderShortName)
+	if ss := orig.SenderSupplied; ss != nil {
+		b.fwm.SenderSupplied.TestProductionCode = ss.TestProductionCode
+	}
+
+	b.fwm.PreviousMessageIdentifier = NewPreviousMessageIdentifier()
+	switch imad, omad := orig.InputMessageAccountabilityData.Reference(), orig.OutputMessageAccountabilityData; {
+	case imad != "":
+		b.fwm.PreviousMessageIdentifier.PreviousMessageIdentifier = imad
+	case omad != nil:
+		b.fwm.PreviousMessageIdentifier.PreviousMessageIdentifier = omad.OutputCycleDate + omad.OutputDestinationID + omad.OutputSequenceNumber
+	default:
+		return nil, fieldError("InputMessageAccountabilityData", ErrFieldRequired)
+	}
+	return b, nil
+}
+
+// IMAD sets the {1520} InputMessageAccountabilityData
+func (b *Builder) IMAD(cycleDate, source, sequenceNumber string) *Builder {
+	b.fwm.InputMessageAccountabilityData = NewInputMessageAccountabilityData()
+	b.fwm.InputMessageAccountabilityData.InputCycleDate = cycleDate
+	b.fwm.InputMessageAccountabilityData.InputSource = source
+	b.fwm.InputMessageAccountabilityData.InputSequenceNumber = sequenceNumber
+	return b
+}
+
+// UserRequestCorrelation sets the {1500} user request correlation
+func (b *Builder) UserRequestCorrelation(correlation string) *Builder {
+	b.fwm.SenderSupplied.UserRequestCorrelation = correlation
+	return b
+}
+
+// Test marks the message as a test rather than a production message
+func (b *Builder) Test() *Builder {
+	b.fwm.SenderSupplied.TestProductionCode = EnvironmentTest
+	return b
+}
+
+// ShortNames sets the {3100} and {3400} short names of the sender and receiver
+func (b *Builder) ShortNames(sender, receiver string) *Builder {
+	b.fwm.SenderDepositoryInstitution.SenderShortName = sender
+	b.fwm.ReceiverDepositoryInstitution.ReceiverShortName = receiver
+	return b
+}
+
+// SenderReference sets the {3320} SenderReference
+func (b *Builder) SenderReference(reference string) *Builder {
+	b.fwm.SenderReference = NewSenderReference()
+	b.fwm.SenderReference.SenderReference = reference
+	return b
+}
+
+// With calls fn with the message being built, to set tags the Builder has no method for
+func (b *Builder) With(fn func(fwm *FEDWireMessage)) *Builder {
+	fn(&b.fwm)
+	return b
+}
+
+// Build returns a copy of the message once it passes the checks of its business function code. The Builder
+// can go on being used without changing the messages it built.
+func (b *Builder) Build() (*FEDWireMessage, error) {
+	fwm := b.fwm.copy()
+	if err := fwm.verify(); err != nil {
+		return nil, err
+	}
+	return fwm, nil
+}
+
+// copy returns a copy of fwm sharing none of its tags or ValidateOptions
+func (fwm *FEDWireMessage) copy() *FEDWireMessage {
+	out := *fwm
+	v := reflect.ValueOf(&out).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Field(i)
+		if f.Kind() != reflect.Ptr || f.IsNil() {
+			continue
+		}
+		c := reflect.New(f.Type().Elem())
+		c.Elem().Set(f.Elem())
+		f.Set(c)
+	}
+	if opts := out.ValidateOptions; opts != nil {
+		opts.Rules = slices.Clone(opts.Rules)
+		opts.EnableRules = slices.Clone(opts.EnableRules)
+		opts.DisableRules = slices.Clone(opts.DisableRules)
+	}
+	return &out
+}
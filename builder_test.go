@@ -0,0 +1,119 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// requireWrites writes fwm into a file and reads it back
+func requireWrites(t *testing.T, fwm *FEDWireMessage) {
+	t.Helper()
+
+	file := NewFile()
+	file.AddFEDWireMessage(*fwm)
+
+	var buf bytes.Buffer
+	require.NoError(t, NewWriter(&buf).Write(file))
+
+	read, err := NewReader(&buf).Read()
+	require.NoError(t, err)
+	require.Len(t, read.FEDWireMessages, 1)
+}
+
+func TestBuilder_customerTransfer(t *testing.T) {
+	fwm, err := NewCustomerTransfer("121042882", "231380104", "000001234567", mockOriginator().Personal, mockBeneficiary().Personal).
+		IMAD("20190410", "Source08", "000001").
+		UserRequestCorrelation("User Req").
+		ShortNames("Wells Fargo NA", "Citadel").
+		SenderReference("Sender Reference").
+		Build()
+	require.NoError(t, err)
+
+	require.Equal(t, FundsTransfer+BasicFundsTransfer, fwm.TypeSubType.TypeCode+fwm.TypeSubType.SubTypeCode)
+	require.Equal(t, CustomerTransfer, fwm.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, EnvironmentProduction, fwm.SenderSupplied.TestProductionCode)
+	require.Equal(t, "User Req", fwm.SenderSupplied.UserRequestCorrelation)
+	require.Equal(t, "Citadel", fwm.ReceiverDepositoryInstitution.ReceiverShortName)
+	require.Equal(t, "Name", fwm.Originator.Personal.Name)
+	require.Equal(t, "Sender Reference", fwm.SenderReference.SenderReference)
+	requireWrites(t, fwm)
+}
+
+func TestBuilder_bankTransfer(t *testing.T) {
+	b := NewBankTransfer("121042882", "231380104", "000001234567").IMAD("20190410", "Source08", "000001").Test()
+	fwm, err := b.Build()
+	require.NoError(t, err)
+	require.Equal(t, BankTransfer, fwm.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, EnvironmentTest, fwm.SenderSupplied.TestProductionCode)
+	requireWrites(t, fwm)
+
+	// changes made after Build don't reach the built message, including those to its tags
+	b.SenderReference("Later").
+		ShortNames("Sender", "Receiver").
+		UserRequestCorrelation("Later")
+	b.With(func(fwm *FEDWireMessage) {
+		fwm.SenderSupplied.TestProductionCode = EnvironmentProduction
+	})
+	require.Nil(t, fwm.SenderReference)
+	require.Empty(t, fwm.SenderDepositoryInstitution.SenderShortName)
+	require.Empty(t, fwm.ReceiverDepositoryInstitution.ReceiverShortName)
+	require.Empty(t, fwm.SenderSupplied.UserRequestCorrelation)
+	require.Equal(t, EnvironmentTest, fwm.SenderSupplied.TestProductionCode)
+
+	// nor do those made to the built message reach the Builder
+	fwm.SenderSupplied.UserRequestCorrelation = "Built"
+	other, err := b.Build()
+	require.NoError(t, err)
+	require.Equal(t, "Later", other.SenderSupplied.UserRequestCorrelation)
+	require.Equal(t, "Sender", other.SenderDepositoryInstitution.SenderShortName)
+
+	// Build checks the tags the business function code prohibits
+	_, err = b.With(func(fwm *FEDWireMessage) {
+		fwm.Charges = mockCharges()
+	}).Build()
+	require.Error(t, err)
+}
+
+func TestBuilder_drawdownRequest(t *testing.T) {
+	debit := Personal{IdentificationCode: DemandDepositAccountNumber, Identifier: "123456789", Name: "debitDD Name"}
+	fwm, err := NewDrawdownRequest("121042882", "231380104", "000001234567", debit, "123456789", mockBeneficiary().Personal).
+		IMAD("20190410", "Source08", "000001").
+		Build()
+	require.NoError(t, err)
+
+	require.Equal(t, FundsTransfer+RequestCredit, fwm.TypeSubType.TypeCode+fwm.TypeSubType.SubTypeCode)
+	require.Equal(t, CustomerCorporateDrawdownRequest, fwm.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, "debitDD Name", fwm.AccountDebitedDrawdown.Name)
+	require.Equal(t, "123456789", fwm.AccountCreditedDrawdown.DrawdownCreditAccountNumber)
+	requireWrites(t, fwm)
+}
+
+func TestBuilder_serviceMessage(t *testing.T) {
+	fwm, err := NewBFCServiceMessage("121042882", "231380104", ServiceMessage{LineOne: "Line One", LineTwo: "Line Two"}).
+		IMAD("20190410", "Source08", "000001").
+		Build()
+	require.NoError(t, err)
+
+	require.Equal(t, FundsTransfer+SSIServiceMessage, fwm.TypeSubType.TypeCode+fwm.TypeSubType.SubTypeCode)
+	require.Equal(t, "000000000000", fwm.Amount.Amount)
+	require.NoError(t, fwm.ServiceMessage.Validate())
+	requireWrites(t, fwm)
+}
+
+func TestBuilder_missingIMAD(t *testing.T) {
+	b := NewBankTransfer("121042882", "231380104", "000001234567")
+	_, err := b.Build()
+	require.ErrorContains(t, err, "InputMessageAccountabilityData")
+
+	fwm, err := b.With(func(fwm *FEDWireMessage) {
+		fwm.ValidateOptions = &ValidateOpts{SkipMandatoryIMAD: true}
+	}).Build()
+	require.NoError(t, err)
+	require.Nil(t, fwm.InputMessageAccountabilityData)
+}
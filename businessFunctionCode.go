@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // BusinessFunctionCode {3600}
@@ -101,19 +103,20 @@ func (bfc *BusinessFunctionCode) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on BusinessFunctionCode and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (bfc *BusinessFunctionCode) Validate() error {
+	var errs base.ErrorList
 	if err := bfc.fieldInclusion(); err != nil {
-		return err
+		errs.Add(err)
 	}
 	if bfc.tag != TagBusinessFunctionCode {
-		return fieldError("tag", ErrValidTagForType, bfc.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, bfc.tag))
 	}
 	if err := bfc.isBusinessFunctionCode(bfc.BusinessFunctionCode); err != nil {
-		return fieldError("BusinessFunctionCode", err, bfc.BusinessFunctionCode)
+		errs.Add(fieldError("BusinessFunctionCode", err, bfc.BusinessFunctionCode))
 	}
 	if err := bfc.isTransactionTypeCode(bfc.TransactionTypeCode); err != nil {
-		return fieldError("TransactionTypeCode", err, bfc.TransactionTypeCode)
+		errs.Add(fieldError("TransactionTypeCode", err, bfc.TransactionTypeCode))
 	}
-	return nil
+	return bfc.collected(errs)
 }
 
 // fieldInclusion validate mandatory fields. If fields are
@@ -0,0 +1,113 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"time"
+
+	// the Funds Service runs on Eastern time, which must load without a system time zone database
+	_ "time/tzdata"
+
+	"github.com/rickar/cal/v2"
+	"github.com/rickar/cal/v2/us"
+)
+
+// CycleDateFormat is the layout of the cycle dates of IMAD and OMAD
+const CycleDateFormat = "20060102"
+
+// EasternTime is the time zone of the Fedwire Funds Service's operating hours and cycle dates
+var EasternTime = mustLoadLocation("America/New_York")
+
+// fedObserved moves a holiday falling on a Sunday to the Monday. Federal Reserve Banks are open the Friday before
+// a holiday falling on a Saturday.
+var fedObserved = []cal.AltDay{{Day: time.Sunday, Offset: 1}}
+
+// FedHolidays are the holidays the Federal Reserve Banks close for, see
+// https://www.frbservices.org/about/holiday-schedules
+var FedHolidays = []*cal.Holiday{
+	us.NewYear.Clone(&cal.Holiday{Observed: fedObserved}),
+	us.MlkDay,
+	us.PresidentsDay,
+	us.MemorialDay,
+	// first observed by the Federal Reserve Banks in 2022
+	us.Juneteenth.Clone(&cal.Holiday{StartYear: 2022, Observed: fedObserved}),
+	us.IndependenceDay.Clone(&cal.Holiday{Observed: fedObserved}),
+	us.LaborDay,
+	us.ColumbusDay,
+	us.VeteransDay.Clone(&cal.Holiday{Observed: fedObserved}),
+	us.ThanksgivingDay,
+	us.ChristmasDay.Clone(&cal.Holiday{Observed: fedObserved}),
+}
+
+var fedCalendar = func() *cal.Calendar {
+	c := &cal.Calendar{Name: "Federal Reserve Banks", Cacheable: true}
+	c.AddHoliday(FedHolidays...)
+	return c
+}()
+
+// IsBusinessDay returns true if the date of t is a business day of the Federal Reserve Banks, a weekday which
+// isn't one of the FedHolidays or the Monday they're observed on.
+func IsBusinessDay(t time.Time) bool {
+	if cal.IsWeekend(t) {
+		return false
+	}
+	actual, observed, _ := fedCalendar.IsHoliday(t)
+	return !actual && !observed
+}
+
+// NextBusinessDay returns the start of the first business day after the date of t, in t's location
+func NextBusinessDay(t time.Time) time.Time {
+	day := startOfDay(t).AddDate(0, 0, 1)
+	for !IsBusinessDay(day) {
+		day = day.AddDate(0, 0, 1)
+	}
+	return day
+}
+
+// NextCycleDate returns the cycle date, as the start of the day in EasternTime, of a message sent at now. It's
+// the current business day while the Funds Service is open, and the next business day once it's closed for
+// the day or when now isn't a business day. Customer transfers may have missed it, see Schedule.
+func NextCycleDate(now time.Time) time.Time {
+	return fedSchedule.NextCycleDate("", now)
+}
+
+// CycleDateRuleID is the ID of the Rule returned by CycleDateRule
+const CycleDateRuleID = "cycle-date"
+
+// CycleDateRule returns a Rule checking that the cycle date of a message's IMAD is no later than the
+// NextCycleDate of a message sent at now(). Validate checks a cycle date is a business day, but not whether
+// it's in the future as that depends on when it's checked, so the Rule must be registered with RegisterRule or
+// added to the ValidateOpts.Rules of the messages to check.
+func CycleDateRule(now func() time.Time) Rule {
+	return Rule{
+		ID: CycleDateRuleID,
+		Check: func(fwm *FEDWireMessage) []error {
+			imad := fwm.InputMessageAccountabilityData
+			if imad == nil {
+				return nil
+			}
+			// invalid dates are reported by Validate
+			date, err := time.ParseInLocation(CycleDateFormat, imad.InputCycleDate, EasternTime)
+			if err == nil && date.After(NextCycleDate(now())) {
+				return []error{fieldError("InputMessageAccountabilityData.InputCycleDate", ErrCycleDateFuture, imad.InputCycleDate)}
+			}
+			return nil
+		},
+	}
+}
+
+// startOfDay returns midnight of the date of t in t's location
+func startOfDay(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+}
+
+func mustLoadLocation(name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		panic(err)
+	}
+	return loc
+}
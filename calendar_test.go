@@ -0,0 +1,98 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func eastern(year int, month time.Month, day, hour int) time.Time {
+	return time.Date(year, month, day, hour, 0, 0, 0, EasternTime)
+}
+
+func TestIsBusinessDay(t *testing.T) {
+	cases := []struct {
+		date     time.Time
+		expected bool
+	}{
+		{eastern(2023, time.July, 5, 0), true},
+		{eastern(2023, time.July, 4, 0), false},      // Independence Day
+		{eastern(2023, time.July, 8, 0), false},      // Saturday
+		{eastern(2023, time.July, 9, 0), false},      // Sunday
+		{eastern(2023, time.November, 24, 0), true},  // the day after Thanksgiving
+		{eastern(2021, time.June, 18, 0), true},      // before Juneteenth was observed
+		{eastern(2022, time.June, 20, 0), false},     // Juneteenth on a Sunday, observed on the Monday
+		{eastern(2026, time.July, 3, 0), true},       // the Friday before Independence Day on a Saturday
+		{eastern(2027, time.December, 27, 0), true},  // the Monday after Christmas Day on a Saturday
+		{eastern(2023, time.October, 9, 0), false},   // Columbus Day
+		{eastern(2023, time.January, 2, 0), false},   // New Year's Day on a Sunday, observed on the Monday
+		{eastern(2023, time.November, 23, 0), false}, // Thanksgiving Day
+	}
+	for _, tc := range cases {
+		require.Equal(t, tc.expected, IsBusinessDay(tc.date), tc.date.String())
+	}
+}
+
+func TestNextBusinessDay(t *testing.T) {
+	require.Equal(t, eastern(2023, time.July, 5, 0), NextBusinessDay(eastern(2023, time.July, 3, 12)))
+	require.Equal(t, eastern(2023, time.July, 10, 0), NextBusinessDay(eastern(2023, time.July, 7, 0)))
+	require.Equal(t, eastern(2022, time.June, 21, 0), NextBusinessDay(eastern(2022, time.June, 17, 23)))
+}
+
+func TestNextCycleDate(t *testing.T) {
+	// open for the day
+	require.Equal(t, eastern(2023, time.July, 5, 0), NextCycleDate(eastern(2023, time.July, 5, 9)))
+	require.Equal(t, eastern(2023, time.July, 5, 0), NextCycleDate(eastern(2023, time.July, 5, 18)))
+	// closed for the day
+	require.Equal(t, eastern(2023, time.July, 6, 0), NextCycleDate(eastern(2023, time.July, 5, 19)))
+	require.Equal(t, eastern(2023, time.July, 10, 0), NextCycleDate(eastern(2023, time.July, 7, 21)))
+	// weekends and holidays
+	require.Equal(t, eastern(2023, time.July, 10, 0), NextCycleDate(eastern(2023, time.July, 8, 12)))
+	require.Equal(t, eastern(2023, time.July, 5, 0), NextCycleDate(eastern(2023, time.July, 4, 12)))
+	require.Equal(t, eastern(2023, time.July, 5, 0), NextCycleDate(eastern(2023, time.July, 3, 20)))
+	// other time zones are converted to Eastern time: 22:00 UTC is 18:00 EDT
+	require.Equal(t, eastern(2023, time.July, 5, 0), NextCycleDate(time.Date(2023, time.July, 5, 22, 0, 0, 0, time.UTC)))
+	require.Equal(t, eastern(2023, time.July, 6, 0), NextCycleDate(time.Date(2023, time.July, 5, 23, 0, 0, 0, time.UTC)))
+}
+
+func TestCycleDateRule(t *testing.T) {
+	now := eastern(2023, time.July, 5, 12)
+	fwm := mockCustomerTransferData()
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.Originator = mockOriginator()
+
+	// a cycle date must be a business day
+	fwm.InputMessageAccountabilityData.InputCycleDate = "20230708"
+	err := fwm.verify()
+	require.ErrorIs(t, err, ErrCycleDateNotBusinessDay)
+	var ve *ValidationError
+	require.ErrorAs(t, err, &ve)
+	require.Empty(t, ve.Rule)
+	require.Equal(t, "/fedWireMessage/inputMessageAccountabilityData/inputCycleDate", ve.Pointer)
+	fwm.InputMessageAccountabilityData.InputCycleDate = "20230704"
+	require.ErrorIs(t, fwm.verify(), ErrCycleDateNotBusinessDay)
+
+	// but is only checked not to be in the future once the rule is added
+	fwm.InputMessageAccountabilityData.InputCycleDate = "20230706"
+	require.NoError(t, fwm.verify())
+	fwm.ValidateOptions = &ValidateOpts{Rules: []Rule{CycleDateRule(func() time.Time { return now })}}
+	err = fwm.verify()
+	require.ErrorIs(t, err, ErrCycleDateFuture)
+	require.ErrorAs(t, err, &ve)
+	require.Equal(t, CycleDateRuleID, ve.Rule)
+	require.Equal(t, "/fedWireMessage/inputMessageAccountabilityData/inputCycleDate", ve.Pointer)
+
+	// earlier cycle dates are accepted, as are those of messages without an IMAD
+	fwm.InputMessageAccountabilityData.InputCycleDate = "20230705"
+	require.NoError(t, fwm.verify())
+	fwm.InputMessageAccountabilityData.InputCycleDate = "20230630"
+	require.NoError(t, fwm.verify())
+	fwm.InputMessageAccountabilityData = nil
+	fwm.ValidateOptions.SkipMandatoryIMAD = true
+	require.NoError(t, fwm.verify())
+}
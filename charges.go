@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // Charges is the Charges of the wire
@@ -138,37 +140,38 @@ func (c *Charges) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on Charges and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (c *Charges) Validate() error {
+	var errs base.ErrorList
 	if err := c.fieldInclusion(); err != nil {
-		return err
+		errs.Add(err)
 	}
 	if err := c.isChargeDetails(c.ChargeDetails); err != nil {
-		return fieldError("ChargeDetails", ErrChargeDetails, c.ChargeDetails)
+		errs.Add(fieldError("ChargeDetails", ErrChargeDetails, c.ChargeDetails))
 	}
 	if err := c.isAlphanumeric(c.SendersChargesOne); err != nil {
-		return fieldError("SendersChargesOne", err, c.SendersChargesOne)
+		errs.Add(fieldError("SendersChargesOne", err, c.SendersChargesOne))
 	}
 	/*	if err := c.validateCharges(c.SendersChargesOne); err != nil {
-		return fieldError("SendersChargesOne", err, c.SendersChargesOne)
+		errs.Add(fieldError("SendersChargesOne", err, c.SendersChargesOne))
 	}*/
 	if err := c.isAlphanumeric(c.SendersChargesTwo); err != nil {
-		return fieldError("SendersChargesTwo", err, c.SendersChargesTwo)
+		errs.Add(fieldError("SendersChargesTwo", err, c.SendersChargesTwo))
 	}
 	/*	if err := c.validateCharges(c.SendersChargesTwo); err != nil {
-		return fieldError("SendersChargesTwo", err, c.SendersChargesTwo)
+		errs.Add(fieldError("SendersChargesTwo", err, c.SendersChargesTwo))
 	}*/
 	if err := c.isAlphanumeric(c.SendersChargesThree); err != nil {
-		return fieldError("SendersChargesThree", err, c.SendersChargesThree)
+		errs.Add(fieldError("SendersChargesThree", err, c.SendersChargesThree))
 	}
 	/*	if err := c.validateCharges(c.SendersChargesThree); err != nil {
-		return fieldError("SendersChargesThree", err, c.SendersChargesThree)
+		errs.Add(fieldError("SendersChargesThree", err, c.SendersChargesThree))
 	}*/
 	if err := c.isAlphanumeric(c.SendersChargesFour); err != nil {
-		return fieldError("SendersChargesFour", err, c.SendersChargesFour)
+		errs.Add(fieldError("SendersChargesFour", err, c.SendersChargesFour))
 	}
 	/*	if err := c.validateCharges(c.SendersChargesFour); err != nil {
-		return fieldError("SendersChargesFour", err, c.SendersChargesFour)
+		errs.Add(fieldError("SendersChargesFour", err, c.SendersChargesFour))
 	}*/
-	return nil
+	return c.collected(errs)
 }
 
 // fieldInclusion validate mandatory fields. If fields are
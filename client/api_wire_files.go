@@ -34,7 +34,7 @@ type AddFEDWireMessageToFileOpts struct {
 
 /*
 AddFEDWireMessageToFile Add Fedwire message to file
-Add a Fedwire Message to the specified file.
+Append a Fedwire Message to the end of the specified file.
   - @param ctx _context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
   - @param fileID File ID
   - @param fedWireMessage
@@ -107,22 +107,143 @@ func (a *WireFilesApiService) AddFEDWireMessageToFile(ctx _context.Context, file
 	return localVarHTTPResponse, nil
 }
 
+// ApplyWireFileLifecycleMessagesOpts Optional parameters for the method 'ApplyWireFileLifecycleMessages'
+type ApplyWireFileLifecycleMessagesOpts struct {
+	XRequestID optional.String
+}
+
+/*
+ApplyWireFileLifecycleMessages Apply received messages to lifecycle of file
+Applies Fedwire Messages received from the Fed to the lifecycle of the messages of the file they're about.
+Acknowledgements, matched by IMAD, move a released message to acknowledged or rejected.
+Reversals (subtype 02 or 08), matched by the IMAD or OMAD their PreviousMessageIdentifier refers to, move an acknowledged message to reversed.
+No message changes state if one of them can't be applied.
+  - @param ctx _context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+  - @param fileID File ID
+  - @param body
+  - @param optional nil or *ApplyWireFileLifecycleMessagesOpts - Optional Parameters:
+  - @param "XRequestID" (optional.String) -  Optional Request ID allows application developer to trace requests through the system's logs
+
+@return []MessageLifecycle
+*/
+func (a *WireFilesApiService) ApplyWireFileLifecycleMessages(ctx _context.Context, fileID string, body string, localVarOptionals *ApplyWireFileLifecycleMessagesOpts) ([]MessageLifecycle, *_nethttp.Response, error) {
+	var (
+		localVarHTTPMethod   = _nethttp.MethodPost
+		localVarPostBody     interface{}
+		localVarFormFileName string
+		localVarFileName     string
+		localVarFileBytes    []byte
+		localVarReturnValue  []MessageLifecycle
+	)
+
+	// create path and map variables
+	localVarPath := a.client.cfg.BasePath + "/files/{fileID}/lifecycle/messages"
+	localVarPath = strings.Replace(localVarPath, "{"+"fileID"+"}", _neturl.QueryEscape(fmt.Sprintf("%v", fileID)), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := _neturl.Values{}
+	localVarFormParams := _neturl.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"text/plain"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	if localVarOptionals != nil && localVarOptionals.XRequestID.IsSet() {
+		localVarHeaderParams["X-Request-ID"] = parameterToString(localVarOptionals.XRequestID.Value(), "")
+	}
+	// body params
+	localVarPostBody = &body
+	r, err := a.client.prepareRequest(ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, localVarFormFileName, localVarFileName, localVarFileBytes)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(r)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := _ioutil.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 200 {
+			var v []MessageLifecycle
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 // CreateWireFileOpts Optional parameters for the method 'CreateWireFile'
 type CreateWireFileOpts struct {
 	XRequestID                 optional.String
 	SkipMandatoryIMAD          optional.Bool
 	AllowMissingSenderSupplied optional.Bool
+	SkipAll                    optional.Bool
+	SkipProhibitedTags         optional.Bool
+	SkipCharacterSet           optional.Bool
+	SkipRoutingNumberCheck     optional.Bool
+	AllowUnknownTags           optional.Bool
+	SkipRemittanceRules        optional.Bool
+	EnableRules                optional.Interface
+	DisableRules               optional.Interface
 }
 
 /*
 CreateWireFile Create file
-Upload a new Wire file, or create one from JSON. When uploading a file, query parameters can be used to configure the FedWireMessage validation options. For JSON requests, validation options are set in the  request body under fedWireMessage.validateOptions.
+Upload a new Wire file, or create one from JSON. When uploading a file, query parameters can be used to configure the FedWireMessage validation options. For JSON requests, validation options are set in the  request body under fedWireMessages[].validateOptions.
   - @param ctx _context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
   - @param wireFile Content of the Wire file (in json or raw text)
   - @param optional nil or *CreateWireFileOpts - Optional Parameters:
   - @param "XRequestID" (optional.String) -  Optional Request ID allows application developer to trace requests through the system's logs
   - @param "SkipMandatoryIMAD" (optional.Bool) -  Optional flag to skip mandatory IMAD validation
   - @param "AllowMissingSenderSupplied" (optional.Bool) -  Optional flag to allow SenderSupplied to be nil, which is generally the case in incoming files.
+  - @param "SkipAll" (optional.Bool) -  Optional flag to skip all validation of the file
+  - @param "SkipProhibitedTags" (optional.Bool) -  Optional flag to skip checking for tags the business function code does not permit
+  - @param "SkipCharacterSet" (optional.Bool) -  Optional flag to skip checking text fields only contain characters permitted by Fedwire
+  - @param "SkipRoutingNumberCheck" (optional.Bool) -  Optional flag to skip checking routing numbers are 9 digits with a valid check digit
+  - @param "AllowUnknownTags" (optional.Bool) -  Optional flag to skip unrecognized tags rather than rejecting the file
+  - @param "SkipRemittanceRules" (optional.Bool) -  Optional flag to skip the rules between the LocalInstrument of a CustomerTransferPlus and the addenda and remittance tags
+  - @param "EnableRules" (optional.Interface of []string) -  Optional IDs of disabled custom validation rules to run, such as cycle-date to check the IMAD cycle date is no later than the next cycle date
+  - @param "DisableRules" (optional.Interface of []string) -  Optional IDs of custom validation rules not to run
 
 @return WireFile
 */
@@ -149,6 +270,30 @@ func (a *WireFilesApiService) CreateWireFile(ctx _context.Context, wireFile Wire
 	if localVarOptionals != nil && localVarOptionals.AllowMissingSenderSupplied.IsSet() {
 		localVarQueryParams.Add("allowMissingSenderSupplied", parameterToString(localVarOptionals.AllowMissingSenderSupplied.Value(), ""))
 	}
+	if localVarOptionals != nil && localVarOptionals.SkipAll.IsSet() {
+		localVarQueryParams.Add("skipAll", parameterToString(localVarOptionals.SkipAll.Value(), ""))
+	}
+	if localVarOptionals != nil && localVarOptionals.SkipProhibitedTags.IsSet() {
+		localVarQueryParams.Add("skipProhibitedTags", parameterToString(localVarOptionals.SkipProhibitedTags.Value(), ""))
+	}
+	if localVarOptionals != nil && localVarOptionals.SkipCharacterSet.IsSet() {
+		localVarQueryParams.Add("skipCharacterSet", parameterToString(localVarOptionals.SkipCharacterSet.Value(), ""))
+	}
+	if localVarOptionals != nil && localVarOptionals.SkipRoutingNumberCheck.IsSet() {
+		localVarQueryParams.Add("skipRoutingNumberCheck", parameterToString(localVarOptionals.SkipRoutingNumberCheck.Value(), ""))
+	}
+	if localVarOptionals != nil && localVarOptionals.AllowUnknownTags.IsSet() {
+		localVarQueryParams.Add("allowUnknownTags", parameterToString(localVarOptionals.AllowUnknownTags.Value(), ""))
+	}
+	if localVarOptionals != nil && localVarOptionals.SkipRemittanceRules.IsSet() {
+		localVarQueryParams.Add("skipRemittanceRules", parameterToString(localVarOptionals.SkipRemittanceRules.Value(), ""))
+	}
+	if localVarOptionals != nil && localVarOptionals.EnableRules.IsSet() {
+		localVarQueryParams.Add("enableRules", parameterToString(localVarOptionals.EnableRules.Value(), "csv"))
+	}
+	if localVarOptionals != nil && localVarOptionals.DisableRules.IsSet() {
+		localVarQueryParams.Add("disableRules", parameterToString(localVarOptionals.DisableRules.Value(), "csv"))
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{"application/json", "text/plain"}
 
@@ -303,39 +448,48 @@ func (a *WireFilesApiService) DeleteWireFileByID(ctx _context.Context, fileID st
 	return localVarHTTPResponse, nil
 }
 
-// GetWireFileByIDOpts Optional parameters for the method 'GetWireFileByID'
-type GetWireFileByIDOpts struct {
+// DiffWireFilesOpts Optional parameters for the method 'DiffWireFiles'
+type DiffWireFilesOpts struct {
 	XRequestID optional.String
+	Format     optional.String
 }
 
 /*
-GetWireFileByID Retrieve file
-Get the details of an existing File using the unique File identifier that was returned upon creation.
+DiffWireFiles Compare files
+Compares the Fedwire Messages of two files tag by tag, each to the message of the other file at the same position.
+Differences of formatting alone, such as padding and delimiters, are ignored.
   - @param ctx _context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
   - @param fileID File ID
-  - @param optional nil or *GetWireFileByIDOpts - Optional Parameters:
+  - @param otherFileID ID of the File to compare to
+  - @param optional nil or *DiffWireFilesOpts - Optional Parameters:
   - @param "XRequestID" (optional.String) -  Optional Request ID allows application developer to trace requests through the system's logs
+  - @param "Format" (optional.String) -  Optional format of the changes, text for one change per line rather than JSON
 
-@return WireFile
+@return []MessageChange
 */
-func (a *WireFilesApiService) GetWireFileByID(ctx _context.Context, fileID string, localVarOptionals *GetWireFileByIDOpts) (WireFile, *_nethttp.Response, error) {
+func (a *WireFilesApiService) DiffWireFiles(ctx _context.Context, fileID string, otherFileID string, localVarOptionals *DiffWireFilesOpts) ([]MessageChange, *_nethttp.Response, error) {
 	var (
 		localVarHTTPMethod   = _nethttp.MethodGet
 		localVarPostBody     interface{}
 		localVarFormFileName string
 		localVarFileName     string
 		localVarFileBytes    []byte
-		localVarReturnValue  WireFile
+		localVarReturnValue  []MessageChange
 	)
 
 	// create path and map variables
-	localVarPath := a.client.cfg.BasePath + "/files/{fileID}"
+	localVarPath := a.client.cfg.BasePath + "/files/{fileID}/diff/{otherFileID}"
 	localVarPath = strings.Replace(localVarPath, "{"+"fileID"+"}", _neturl.QueryEscape(fmt.Sprintf("%v", fileID)), -1)
+	localVarPath = strings.Replace(localVarPath, "{"+"otherFileID"+"}", _neturl.QueryEscape(fmt.Sprintf("%v", otherFileID)), -1)
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := _neturl.Values{}
 	localVarFormParams := _neturl.Values{}
 
+	if localVarOptionals != nil && localVarOptionals.Format.IsSet() {
+		localVarQueryParams.Add("format", parameterToString(localVarOptionals.Format.Value(), ""))
+	}
+
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -346,7 +500,7 @@ func (a *WireFilesApiService) GetWireFileByID(ctx _context.Context, fileID strin
 	}
 
 	// to determine the Accept header
-	localVarHTTPHeaderAccepts := []string{"application/json"}
+	localVarHTTPHeaderAccepts := []string{"application/json", "text/plain"}
 
 	// set Accept header
 	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
@@ -378,7 +532,7 @@ func (a *WireFilesApiService) GetWireFileByID(ctx _context.Context, fileID strin
 			error: localVarHTTPResponse.Status,
 		}
 		if localVarHTTPResponse.StatusCode == 200 {
-			var v WireFile
+			var v []MessageChange
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.error = err.Error()
@@ -402,49 +556,39 @@ func (a *WireFilesApiService) GetWireFileByID(ctx _context.Context, fileID strin
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-// GetWireFileContentsOpts Optional parameters for the method 'GetWireFileContents'
-type GetWireFileContentsOpts struct {
+// GetFEDWireMessagesOpts Optional parameters for the method 'GetFEDWireMessages'
+type GetFEDWireMessagesOpts struct {
 	XRequestID optional.String
-	Format     optional.String
-	Newline    optional.Bool
 }
 
 /*
-GetWireFileContents Get file contents
-Assembles the existing file, computes sequence numbers and totals. Returns plaintext file.
+GetFEDWireMessages List Fedwire messages in file
+List each Fedwire Message of the specified file in the order they appear.
   - @param ctx _context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
   - @param fileID File ID
-  - @param optional nil or *GetWireFileContentsOpts - Optional Parameters:
+  - @param optional nil or *GetFEDWireMessagesOpts - Optional Parameters:
   - @param "XRequestID" (optional.String) -  Optional Request ID allows application developer to trace requests through the system's logs
-  - @param "Format" (optional.String) -  Optional file type to get file as fixed length or variable length type
-  - @param "Newline" (optional.Bool) -  Optional new line flag to have new line or no new line
 
-@return string
+@return []FedWireMessage
 */
-func (a *WireFilesApiService) GetWireFileContents(ctx _context.Context, fileID string, localVarOptionals *GetWireFileContentsOpts) (string, *_nethttp.Response, error) {
+func (a *WireFilesApiService) GetFEDWireMessages(ctx _context.Context, fileID string, localVarOptionals *GetFEDWireMessagesOpts) ([]FedWireMessage, *_nethttp.Response, error) {
 	var (
 		localVarHTTPMethod   = _nethttp.MethodGet
 		localVarPostBody     interface{}
 		localVarFormFileName string
 		localVarFileName     string
 		localVarFileBytes    []byte
-		localVarReturnValue  string
+		localVarReturnValue  []FedWireMessage
 	)
 
 	// create path and map variables
-	localVarPath := a.client.cfg.BasePath + "/files/{fileID}/contents"
+	localVarPath := a.client.cfg.BasePath + "/files/{fileID}/FEDWireMessage"
 	localVarPath = strings.Replace(localVarPath, "{"+"fileID"+"}", _neturl.QueryEscape(fmt.Sprintf("%v", fileID)), -1)
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := _neturl.Values{}
 	localVarFormParams := _neturl.Values{}
 
-	if localVarOptionals != nil && localVarOptionals.Format.IsSet() {
-		localVarQueryParams.Add("format", parameterToString(localVarOptionals.Format.Value(), ""))
-	}
-	if localVarOptionals != nil && localVarOptionals.Newline.IsSet() {
-		localVarQueryParams.Add("newline", parameterToString(localVarOptionals.Newline.Value(), ""))
-	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -455,7 +599,7 @@ func (a *WireFilesApiService) GetWireFileContents(ctx _context.Context, fileID s
 	}
 
 	// to determine the Accept header
-	localVarHTTPHeaderAccepts := []string{"text/plain"}
+	localVarHTTPHeaderAccepts := []string{"application/json"}
 
 	// set Accept header
 	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
@@ -487,7 +631,7 @@ func (a *WireFilesApiService) GetWireFileContents(ctx _context.Context, fileID s
 			error: localVarHTTPResponse.Status,
 		}
 		if localVarHTTPResponse.StatusCode == 200 {
-			var v string
+			var v []FedWireMessage
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.error = err.Error()
@@ -511,37 +655,48 @@ func (a *WireFilesApiService) GetWireFileContents(ctx _context.Context, fileID s
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-// GetWireFilesOpts Optional parameters for the method 'GetWireFiles'
-type GetWireFilesOpts struct {
-	XRequestID optional.String
+// GetScheduleOpts Optional parameters for the method 'GetSchedule'
+type GetScheduleOpts struct {
+	XRequestID           optional.String
+	BusinessFunctionCode optional.String
+	Time                 optional.Time
 }
 
 /*
-GetWireFiles List files
-List all Wire files created with the Wire service. These files are not persisted through multiple runs of the service.
+GetSchedule Get operating schedule
+Tells whether a Fedwire message can still be sent today, the next cycle date it makes and how long until its cutoff.
   - @param ctx _context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-  - @param optional nil or *GetWireFilesOpts - Optional Parameters:
+  - @param optional nil or *GetScheduleOpts - Optional Parameters:
   - @param "XRequestID" (optional.String) -  Optional Request ID allows application developer to trace requests through the system's logs
+  - @param "BusinessFunctionCode" (optional.String) -  Optional BusinessFunctionCode of the message, its cutoff may be earlier than the close of the Fedwire Funds Service
+  - @param "Time" (optional.Time) -  Optional RFC 3339 time the message is sent at, defaults to now
 
-@return []WireFile
+@return ScheduleStatus
 */
-func (a *WireFilesApiService) GetWireFiles(ctx _context.Context, localVarOptionals *GetWireFilesOpts) ([]WireFile, *_nethttp.Response, error) {
+func (a *WireFilesApiService) GetSchedule(ctx _context.Context, localVarOptionals *GetScheduleOpts) (ScheduleStatus, *_nethttp.Response, error) {
 	var (
 		localVarHTTPMethod   = _nethttp.MethodGet
 		localVarPostBody     interface{}
 		localVarFormFileName string
 		localVarFileName     string
 		localVarFileBytes    []byte
-		localVarReturnValue  []WireFile
+		localVarReturnValue  ScheduleStatus
 	)
 
 	// create path and map variables
-	localVarPath := a.client.cfg.BasePath + "/files"
+	localVarPath := a.client.cfg.BasePath + "/schedule"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := _neturl.Values{}
 	localVarFormParams := _neturl.Values{}
 
+	if localVarOptionals != nil && localVarOptionals.BusinessFunctionCode.IsSet() {
+		localVarQueryParams.Add("businessFunctionCode", parameterToString(localVarOptionals.BusinessFunctionCode.Value(), ""))
+	}
+	if localVarOptionals != nil && localVarOptionals.Time.IsSet() {
+		localVarQueryParams.Add("time", parameterToString(localVarOptionals.Time.Value(), ""))
+	}
+
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -584,13 +739,14 @@ func (a *WireFilesApiService) GetWireFiles(ctx _context.Context, localVarOptiona
 			error: localVarHTTPResponse.Status,
 		}
 		if localVarHTTPResponse.StatusCode == 200 {
-			var v []WireFile
+			var v ScheduleStatus
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.error = err.Error()
 				return localVarReturnValue, localVarHTTPResponse, newErr
 			}
 			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
 		}
 		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
@@ -607,22 +763,34 @@ func (a *WireFilesApiService) GetWireFiles(ctx _context.Context, localVarOptiona
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+// GetWireFileByIDOpts Optional parameters for the method 'GetWireFileByID'
+type GetWireFileByIDOpts struct {
+	XRequestID optional.String
+}
+
 /*
-Ping Ping Wire service
-Check if the Wire service is running.
+GetWireFileByID Retrieve file
+Get the details of an existing File using the unique File identifier that was returned upon creation.
   - @param ctx _context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+  - @param fileID File ID
+  - @param optional nil or *GetWireFileByIDOpts - Optional Parameters:
+  - @param "XRequestID" (optional.String) -  Optional Request ID allows application developer to trace requests through the system's logs
+
+@return WireFile
 */
-func (a *WireFilesApiService) Ping(ctx _context.Context) (*_nethttp.Response, error) {
+func (a *WireFilesApiService) GetWireFileByID(ctx _context.Context, fileID string, localVarOptionals *GetWireFileByIDOpts) (WireFile, *_nethttp.Response, error) {
 	var (
 		localVarHTTPMethod   = _nethttp.MethodGet
 		localVarPostBody     interface{}
 		localVarFormFileName string
 		localVarFileName     string
 		localVarFileBytes    []byte
+		localVarReturnValue  WireFile
 	)
 
 	// create path and map variables
-	localVarPath := a.client.cfg.BasePath + "/ping"
+	localVarPath := a.client.cfg.BasePath + "/files/{fileID}"
+	localVarPath = strings.Replace(localVarPath, "{"+"fileID"+"}", _neturl.QueryEscape(fmt.Sprintf("%v", fileID)), -1)
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := _neturl.Values{}
@@ -638,27 +806,30 @@ func (a *WireFilesApiService) Ping(ctx _context.Context) (*_nethttp.Response, er
 	}
 
 	// to determine the Accept header
-	localVarHTTPHeaderAccepts := []string{}
+	localVarHTTPHeaderAccepts := []string{"application/json"}
 
 	// set Accept header
 	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
+	if localVarOptionals != nil && localVarOptionals.XRequestID.IsSet() {
+		localVarHeaderParams["X-Request-ID"] = parameterToString(localVarOptionals.XRequestID.Value(), "")
+	}
 	r, err := a.client.prepareRequest(ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, localVarFormFileName, localVarFileName, localVarFileBytes)
 	if err != nil {
-		return nil, err
+		return localVarReturnValue, nil, err
 	}
 
 	localVarHTTPResponse, err := a.client.callAPI(r)
 	if err != nil || localVarHTTPResponse == nil {
-		return localVarHTTPResponse, err
+		return localVarReturnValue, localVarHTTPResponse, err
 	}
 
 	localVarBody, err := _ioutil.ReadAll(localVarHTTPResponse.Body)
 	localVarHTTPResponse.Body.Close()
 	if err != nil {
-		return localVarHTTPResponse, err
+		return localVarReturnValue, localVarHTTPResponse, err
 	}
 
 	if localVarHTTPResponse.StatusCode >= 300 {
@@ -666,45 +837,74 @@ func (a *WireFilesApiService) Ping(ctx _context.Context) (*_nethttp.Response, er
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
 		}
-		return localVarHTTPResponse, newErr
+		if localVarHTTPResponse.StatusCode == 200 {
+			var v WireFile
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
 	}
 
-	return localVarHTTPResponse, nil
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-// ValidateWireFileOpts Optional parameters for the method 'ValidateWireFile'
-type ValidateWireFileOpts struct {
+// GetWireFileContentsOpts Optional parameters for the method 'GetWireFileContents'
+type GetWireFileContentsOpts struct {
 	XRequestID optional.String
+	Format     optional.String
+	Newline    optional.Bool
 }
 
 /*
-ValidateWireFile Validate file
-Validates the existing file. You need only supply the unique File identifier that was returned upon creation.
+GetWireFileContents Get file contents
+Assembles the existing file, computes sequence numbers and totals. Returns plaintext file.
   - @param ctx _context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
   - @param fileID File ID
-  - @param optional nil or *ValidateWireFileOpts - Optional Parameters:
+  - @param optional nil or *GetWireFileContentsOpts - Optional Parameters:
   - @param "XRequestID" (optional.String) -  Optional Request ID allows application developer to trace requests through the system's logs
+  - @param "Format" (optional.String) -  Optional file type to get file as fixed length or variable length type
+  - @param "Newline" (optional.Bool) -  Optional new line flag to have new line or no new line
 
-@return WireFile
+@return string
 */
-func (a *WireFilesApiService) ValidateWireFile(ctx _context.Context, fileID string, localVarOptionals *ValidateWireFileOpts) (WireFile, *_nethttp.Response, error) {
+func (a *WireFilesApiService) GetWireFileContents(ctx _context.Context, fileID string, localVarOptionals *GetWireFileContentsOpts) (string, *_nethttp.Response, error) {
 	var (
 		localVarHTTPMethod   = _nethttp.MethodGet
 		localVarPostBody     interface{}
 		localVarFormFileName string
 		localVarFileName     string
 		localVarFileBytes    []byte
-		localVarReturnValue  WireFile
+		localVarReturnValue  string
 	)
 
 	// create path and map variables
-	localVarPath := a.client.cfg.BasePath + "/files/{fileID}/validate"
+	localVarPath := a.client.cfg.BasePath + "/files/{fileID}/contents"
 	localVarPath = strings.Replace(localVarPath, "{"+"fileID"+"}", _neturl.QueryEscape(fmt.Sprintf("%v", fileID)), -1)
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := _neturl.Values{}
 	localVarFormParams := _neturl.Values{}
 
+	if localVarOptionals != nil && localVarOptionals.Format.IsSet() {
+		localVarQueryParams.Add("format", parameterToString(localVarOptionals.Format.Value(), ""))
+	}
+	if localVarOptionals != nil && localVarOptionals.Newline.IsSet() {
+		localVarQueryParams.Add("newline", parameterToString(localVarOptionals.Newline.Value(), ""))
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -715,7 +915,7 @@ func (a *WireFilesApiService) ValidateWireFile(ctx _context.Context, fileID stri
 	}
 
 	// to determine the Accept header
-	localVarHTTPHeaderAccepts := []string{"application/json"}
+	localVarHTTPHeaderAccepts := []string{"text/plain"}
 
 	// set Accept header
 	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
@@ -747,7 +947,587 @@ func (a *WireFilesApiService) ValidateWireFile(ctx _context.Context, fileID stri
 			error: localVarHTTPResponse.Status,
 		}
 		if localVarHTTPResponse.StatusCode == 200 {
-			var v WireFile
+			var v string
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+// GetWireFileLifecycleOpts Optional parameters for the method 'GetWireFileLifecycle'
+type GetWireFileLifecycleOpts struct {
+	XRequestID optional.String
+}
+
+/*
+GetWireFileLifecycle Get lifecycle of file
+Lists the lifecycle of each Fedwire Message of the file, its current state and the history of its changes of state.
+Messages are tracked as drafts from the first lifecycle request after they're added to the file.
+  - @param ctx _context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+  - @param fileID File ID
+  - @param optional nil or *GetWireFileLifecycleOpts - Optional Parameters:
+  - @param "XRequestID" (optional.String) -  Optional Request ID allows application developer to trace requests through the system's logs
+
+@return []MessageLifecycle
+*/
+func (a *WireFilesApiService) GetWireFileLifecycle(ctx _context.Context, fileID string, localVarOptionals *GetWireFileLifecycleOpts) ([]MessageLifecycle, *_nethttp.Response, error) {
+	var (
+		localVarHTTPMethod   = _nethttp.MethodGet
+		localVarPostBody     interface{}
+		localVarFormFileName string
+		localVarFileName     string
+		localVarFileBytes    []byte
+		localVarReturnValue  []MessageLifecycle
+	)
+
+	// create path and map variables
+	localVarPath := a.client.cfg.BasePath + "/files/{fileID}/lifecycle"
+	localVarPath = strings.Replace(localVarPath, "{"+"fileID"+"}", _neturl.QueryEscape(fmt.Sprintf("%v", fileID)), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := _neturl.Values{}
+	localVarFormParams := _neturl.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	if localVarOptionals != nil && localVarOptionals.XRequestID.IsSet() {
+		localVarHeaderParams["X-Request-ID"] = parameterToString(localVarOptionals.XRequestID.Value(), "")
+	}
+	r, err := a.client.prepareRequest(ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, localVarFormFileName, localVarFileName, localVarFileBytes)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(r)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := _ioutil.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 200 {
+			var v []MessageLifecycle
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+// GetWireFilesOpts Optional parameters for the method 'GetWireFiles'
+type GetWireFilesOpts struct {
+	XRequestID optional.String
+}
+
+/*
+GetWireFiles List files
+List all Wire files created with the Wire service. These files are not persisted through multiple runs of the service.
+  - @param ctx _context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+  - @param optional nil or *GetWireFilesOpts - Optional Parameters:
+  - @param "XRequestID" (optional.String) -  Optional Request ID allows application developer to trace requests through the system's logs
+
+@return []WireFile
+*/
+func (a *WireFilesApiService) GetWireFiles(ctx _context.Context, localVarOptionals *GetWireFilesOpts) ([]WireFile, *_nethttp.Response, error) {
+	var (
+		localVarHTTPMethod   = _nethttp.MethodGet
+		localVarPostBody     interface{}
+		localVarFormFileName string
+		localVarFileName     string
+		localVarFileBytes    []byte
+		localVarReturnValue  []WireFile
+	)
+
+	// create path and map variables
+	localVarPath := a.client.cfg.BasePath + "/files"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := _neturl.Values{}
+	localVarFormParams := _neturl.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	if localVarOptionals != nil && localVarOptionals.XRequestID.IsSet() {
+		localVarHeaderParams["X-Request-ID"] = parameterToString(localVarOptionals.XRequestID.Value(), "")
+	}
+	r, err := a.client.prepareRequest(ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, localVarFormFileName, localVarFileName, localVarFileBytes)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(r)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := _ioutil.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 200 {
+			var v []WireFile
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.model = v
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+/*
+Ping Ping Wire service
+Check if the Wire service is running.
+  - @param ctx _context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+*/
+func (a *WireFilesApiService) Ping(ctx _context.Context) (*_nethttp.Response, error) {
+	var (
+		localVarHTTPMethod   = _nethttp.MethodGet
+		localVarPostBody     interface{}
+		localVarFormFileName string
+		localVarFileName     string
+		localVarFileBytes    []byte
+	)
+
+	// create path and map variables
+	localVarPath := a.client.cfg.BasePath + "/ping"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := _neturl.Values{}
+	localVarFormParams := _neturl.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	r, err := a.client.prepareRequest(ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, localVarFormFileName, localVarFileName, localVarFileBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(r)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarHTTPResponse, err
+	}
+
+	localVarBody, err := _ioutil.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	if err != nil {
+		return localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarHTTPResponse, newErr
+	}
+
+	return localVarHTTPResponse, nil
+}
+
+// ReleaseWireFileLifecycleOpts Optional parameters for the method 'ReleaseWireFileLifecycle'
+type ReleaseWireFileLifecycleOpts struct {
+	XRequestID optional.String
+}
+
+/*
+ReleaseWireFileLifecycle Release validated messages of file
+Moves the validated Fedwire Messages of the file to released, once they're sent to the Fed.
+  - @param ctx _context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+  - @param fileID File ID
+  - @param optional nil or *ReleaseWireFileLifecycleOpts - Optional Parameters:
+  - @param "XRequestID" (optional.String) -  Optional Request ID allows application developer to trace requests through the system's logs
+
+@return []MessageLifecycle
+*/
+func (a *WireFilesApiService) ReleaseWireFileLifecycle(ctx _context.Context, fileID string, localVarOptionals *ReleaseWireFileLifecycleOpts) ([]MessageLifecycle, *_nethttp.Response, error) {
+	var (
+		localVarHTTPMethod   = _nethttp.MethodPost
+		localVarPostBody     interface{}
+		localVarFormFileName string
+		localVarFileName     string
+		localVarFileBytes    []byte
+		localVarReturnValue  []MessageLifecycle
+	)
+
+	// create path and map variables
+	localVarPath := a.client.cfg.BasePath + "/files/{fileID}/lifecycle/release"
+	localVarPath = strings.Replace(localVarPath, "{"+"fileID"+"}", _neturl.QueryEscape(fmt.Sprintf("%v", fileID)), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := _neturl.Values{}
+	localVarFormParams := _neturl.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	if localVarOptionals != nil && localVarOptionals.XRequestID.IsSet() {
+		localVarHeaderParams["X-Request-ID"] = parameterToString(localVarOptionals.XRequestID.Value(), "")
+	}
+	r, err := a.client.prepareRequest(ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, localVarFormFileName, localVarFileName, localVarFileBytes)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(r)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := _ioutil.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 200 {
+			var v []MessageLifecycle
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+// ValidateWireFileOpts Optional parameters for the method 'ValidateWireFile'
+type ValidateWireFileOpts struct {
+	XRequestID   optional.String
+	EnableRules  optional.Interface
+	DisableRules optional.Interface
+}
+
+/*
+ValidateWireFile Validate file
+Validates the existing file. You need only supply the unique File identifier that was returned upon creation.
+  - @param ctx _context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+  - @param fileID File ID
+  - @param optional nil or *ValidateWireFileOpts - Optional Parameters:
+  - @param "XRequestID" (optional.String) -  Optional Request ID allows application developer to trace requests through the system's logs
+  - @param "EnableRules" (optional.Interface of []string) -  Optional IDs of disabled custom validation rules to run, such as cycle-date to check the IMAD cycle date is no later than the next cycle date
+  - @param "DisableRules" (optional.Interface of []string) -  Optional IDs of custom validation rules not to run
+
+@return WireFile
+*/
+func (a *WireFilesApiService) ValidateWireFile(ctx _context.Context, fileID string, localVarOptionals *ValidateWireFileOpts) (WireFile, *_nethttp.Response, error) {
+	var (
+		localVarHTTPMethod   = _nethttp.MethodGet
+		localVarPostBody     interface{}
+		localVarFormFileName string
+		localVarFileName     string
+		localVarFileBytes    []byte
+		localVarReturnValue  WireFile
+	)
+
+	// create path and map variables
+	localVarPath := a.client.cfg.BasePath + "/files/{fileID}/validate"
+	localVarPath = strings.Replace(localVarPath, "{"+"fileID"+"}", _neturl.QueryEscape(fmt.Sprintf("%v", fileID)), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := _neturl.Values{}
+	localVarFormParams := _neturl.Values{}
+
+	if localVarOptionals != nil && localVarOptionals.EnableRules.IsSet() {
+		localVarQueryParams.Add("enableRules", parameterToString(localVarOptionals.EnableRules.Value(), "csv"))
+	}
+	if localVarOptionals != nil && localVarOptionals.DisableRules.IsSet() {
+		localVarQueryParams.Add("disableRules", parameterToString(localVarOptionals.DisableRules.Value(), "csv"))
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	if localVarOptionals != nil && localVarOptionals.XRequestID.IsSet() {
+		localVarHeaderParams["X-Request-ID"] = parameterToString(localVarOptionals.XRequestID.Value(), "")
+	}
+	r, err := a.client.prepareRequest(ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, localVarFormFileName, localVarFileName, localVarFileBytes)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(r)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := _ioutil.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 200 {
+			var v WireFile
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+// ValidateWireFileLifecycleOpts Optional parameters for the method 'ValidateWireFileLifecycle'
+type ValidateWireFileLifecycleOpts struct {
+	XRequestID   optional.String
+	EnableRules  optional.Interface
+	DisableRules optional.Interface
+}
+
+/*
+ValidateWireFileLifecycle Validate draft messages of file
+Validates the draft Fedwire Messages of the file and moves them to validated. No message changes state if one of them is invalid.
+  - @param ctx _context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+  - @param fileID File ID
+  - @param optional nil or *ValidateWireFileLifecycleOpts - Optional Parameters:
+  - @param "XRequestID" (optional.String) -  Optional Request ID allows application developer to trace requests through the system's logs
+  - @param "EnableRules" (optional.Interface of []string) -  Optional IDs of disabled custom validation rules to run, such as cycle-date to check the IMAD cycle date is no later than the next cycle date
+  - @param "DisableRules" (optional.Interface of []string) -  Optional IDs of custom validation rules not to run
+
+@return []MessageLifecycle
+*/
+func (a *WireFilesApiService) ValidateWireFileLifecycle(ctx _context.Context, fileID string, localVarOptionals *ValidateWireFileLifecycleOpts) ([]MessageLifecycle, *_nethttp.Response, error) {
+	var (
+		localVarHTTPMethod   = _nethttp.MethodPost
+		localVarPostBody     interface{}
+		localVarFormFileName string
+		localVarFileName     string
+		localVarFileBytes    []byte
+		localVarReturnValue  []MessageLifecycle
+	)
+
+	// create path and map variables
+	localVarPath := a.client.cfg.BasePath + "/files/{fileID}/lifecycle/validate"
+	localVarPath = strings.Replace(localVarPath, "{"+"fileID"+"}", _neturl.QueryEscape(fmt.Sprintf("%v", fileID)), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := _neturl.Values{}
+	localVarFormParams := _neturl.Values{}
+
+	if localVarOptionals != nil && localVarOptionals.EnableRules.IsSet() {
+		localVarQueryParams.Add("enableRules", parameterToString(localVarOptionals.EnableRules.Value(), "csv"))
+	}
+	if localVarOptionals != nil && localVarOptionals.DisableRules.IsSet() {
+		localVarQueryParams.Add("disableRules", parameterToString(localVarOptionals.DisableRules.Value(), "csv"))
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	if localVarOptionals != nil && localVarOptionals.XRequestID.IsSet() {
+		localVarHeaderParams["X-Request-ID"] = parameterToString(localVarOptionals.XRequestID.Value(), "")
+	}
+	r, err := a.client.prepareRequest(ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, localVarFormFileName, localVarFileName, localVarFileBytes)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(r)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := _ioutil.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 200 {
+			var v []MessageLifecycle
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
 				newErr.error = err.Error()
@@ -0,0 +1,26 @@
+/*
+ * Wire API
+ *
+ * Moov Wire implements an HTTP API for creating, parsing, and validating Fedwire messages.
+ *
+ * API version: v1
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"time"
+)
+
+// LifecycleEvent struct for LifecycleEvent
+type LifecycleEvent struct {
+	From WireState `json:"from,omitempty"`
+	To   WireState `json:"to,omitempty"`
+	// Time the state changed
+	Time time.Time `json:"time,omitempty"`
+	// OMAD of the acknowledgement or IMAD of the reversal which changed the state
+	Reference string `json:"reference,omitempty"`
+	// Reason of the change, such as the ErrorWire of a rejection
+	Reason string `json:"reason,omitempty"`
+}
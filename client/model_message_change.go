@@ -0,0 +1,24 @@
+/*
+ * Wire API
+ *
+ * Moov Wire implements an HTTP API for creating, parsing, and validating Fedwire messages.
+ *
+ * API version: v1
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// MessageChange struct for MessageChange
+type MessageChange struct {
+	// Position of the Fedwire Message within the files
+	MessageIndex int32 `json:"messageIndex,omitempty"`
+	// Tag of the field
+	Tag string `json:"tag,omitempty"`
+	// Path of the field within the Fedwire Message
+	Field string `json:"field,omitempty"`
+	// Value of the field in the first file, empty if it isn't set
+	Old string `json:"old,omitempty"`
+	// Value of the field in the other file, empty if it isn't set
+	New string `json:"new,omitempty"`
+}
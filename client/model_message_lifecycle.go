@@ -0,0 +1,23 @@
+/*
+ * Wire API
+ *
+ * Moov Wire implements an HTTP API for creating, parsing, and validating Fedwire messages.
+ *
+ * API version: v1
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// MessageLifecycle struct for MessageLifecycle
+type MessageLifecycle struct {
+	// Position of the Fedwire Message within the file
+	MessageIndex int32 `json:"messageIndex,omitempty"`
+	// IMAD of the Fedwire Message
+	Imad  string    `json:"imad,omitempty"`
+	State WireState `json:"state,omitempty"`
+	// Changes of state, oldest first
+	History []LifecycleEvent `json:"history,omitempty"`
+	// OMAD the Fed acknowledged the message with
+	Omad string `json:"omad,omitempty"`
+}
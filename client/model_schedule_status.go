@@ -0,0 +1,34 @@
+/*
+ * Wire API
+ *
+ * Moov Wire implements an HTTP API for creating, parsing, and validating Fedwire messages.
+ *
+ * API version: v1
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+import (
+	"time"
+)
+
+// ScheduleStatus struct for ScheduleStatus
+type ScheduleStatus struct {
+	// BusinessFunctionCode of the message
+	BusinessFunctionCode string `json:"businessFunctionCode,omitempty"`
+	// Time the status is of
+	Time time.Time `json:"time,omitempty"`
+	// True if the message still makes today's cycle date
+	SendToday bool `json:"sendToday,omitempty"`
+	// True if the Fedwire Funds Service is accepting the message for cycleDate
+	Open bool `json:"open,omitempty"`
+	// Next cycle date the message makes, CCYYMMDD
+	CycleDate string `json:"cycleDate,omitempty"`
+	// Time the Fedwire Funds Service opens for cycleDate
+	Opens time.Time `json:"opens,omitempty"`
+	// Time the message must be sent by for cycleDate
+	Cutoff time.Time `json:"cutoff,omitempty"`
+	// Seconds left until cutoff
+	SecondsUntilCutoff int64 `json:"secondsUntilCutoff,omitempty"`
+}
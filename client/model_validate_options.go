@@ -15,4 +15,20 @@ type ValidateOptions struct {
 	SkipMandatoryIMAD bool `json:"skipMandatoryIMAD,omitempty"`
 	// Allow FedWireMessage.SenderSupplied to be nil
 	AllowMissingSenderSupplied bool `json:"allowMissingSenderSupplied,omitempty"`
+	// Skip all validation
+	SkipAll bool `json:"skipAll,omitempty"`
+	// Skip checking for tags the business function code does not permit
+	SkipProhibitedTags bool `json:"skipProhibitedTags,omitempty"`
+	// Skip checking text fields only contain characters permitted by Fedwire
+	SkipCharacterSet bool `json:"skipCharacterSet,omitempty"`
+	// Skip checking routing numbers are 9 digits with a valid check digit
+	SkipRoutingNumberCheck bool `json:"skipRoutingNumberCheck,omitempty"`
+	// Skip unrecognized tags when reading a file rather than rejecting it
+	AllowUnknownTags bool `json:"allowUnknownTags,omitempty"`
+	// Skip the rules between the LocalInstrument of a CustomerTransferPlus and the addenda and remittance tags
+	SkipRemittanceRules bool `json:"skipRemittanceRules,omitempty"`
+	// IDs of disabled custom validation rules to run
+	EnableRules []string `json:"enableRules,omitempty"`
+	// IDs of custom validation rules not to run, even if given in enableRules
+	DisableRules []string `json:"disableRules,omitempty"`
 }
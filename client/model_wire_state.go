@@ -0,0 +1,23 @@
+/*
+ * Wire API
+ *
+ * Moov Wire implements an HTTP API for creating, parsing, and validating Fedwire messages.
+ *
+ * API version: v1
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package openapi
+
+// WireState State of a Fedwire Message in its lifecycle
+type WireState string
+
+// List of WireState
+const (
+	DRAFT        WireState = "draft"
+	VALIDATED    WireState = "validated"
+	RELEASED     WireState = "released"
+	ACKNOWLEDGED WireState = "acknowledged"
+	REJECTED     WireState = "rejected"
+	REVERSED     WireState = "reversed"
+)
@@ -0,0 +1,96 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	moovhttp "github.com/moov-io/base/http"
+	"github.com/moov-io/base/log"
+	"github.com/moov-io/wire"
+)
+
+var errNoOtherFileId = errors.New("no File ID to compare to found")
+
+// messageChange is a wire.Change of a FEDWireMessage of a file
+type messageChange struct {
+	// MessageIndex is the position of the FEDWireMessage within the files
+	MessageIndex int `json:"messageIndex"`
+	wire.Change
+}
+
+// diffFiles compares the FEDWireMessages of two files, each to the message of the other file at the same
+// position. The changes are written as JSON, or as text with one change per line if the query param format is
+// text.
+func diffFiles(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if requestID := moovhttp.GetRequestID(r); requestID != "" {
+			logger = logger.Set("requestID", log.String(requestID))
+		}
+
+		w = wrapResponseWriter(logger, w, r)
+
+		fileId := getFileId(w, r)
+		if fileId == "" {
+			logger.LogError(errNoFileId)
+			return
+		}
+		otherId, ok := mux.Vars(r)["otherFileId"]
+		if !ok || otherId == "" {
+			moovhttp.Problem(w, errNoOtherFileId)
+			logger.LogError(errNoOtherFileId)
+			return
+		}
+		logger = logger.Set("fileID", log.String(fileId)).Set("otherFileID", log.String(otherId))
+
+		var files [2]*wire.File
+		for i, id := range []string{fileId, otherId} {
+			file, err := repo.getFile(id)
+			if err != nil {
+				err = logger.LogErrorf("error retrieving file: %v", err).Err()
+				moovhttp.Problem(w, err)
+				return
+			}
+			if file == nil {
+				logger.Logf("file %s not found", id)
+				http.NotFound(w, r)
+				return
+			}
+			files[i] = file
+		}
+
+		changes := []messageChange{}
+		for i := 0; i < max(len(files[0].FEDWireMessages), len(files[1].FEDWireMessages)); i++ {
+			var a, b *wire.FEDWireMessage
+			if i < len(files[0].FEDWireMessages) {
+				a = &files[0].FEDWireMessages[i]
+			}
+			if i < len(files[1].FEDWireMessages) {
+				b = &files[1].FEDWireMessages[i]
+			}
+			for _, c := range wire.Diff(a, b) {
+				changes = append(changes, messageChange{MessageIndex: i, Change: c})
+			}
+		}
+
+		logger.Logf("found %d changes", len(changes))
+		w.Header().Set("X-Total-Count", fmt.Sprintf("%d", len(changes)))
+		if r.URL.Query().Get("format") == "text" {
+			w.Header().Set("Content-Type", "text/plain")
+			w.WriteHeader(http.StatusOK)
+			for _, c := range changes {
+				fmt.Fprintf(w, "%d %s\n", c.MessageIndex, c.Change)
+			}
+			return
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(changes)
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/moov-io/base/log"
+	"github.com/moov-io/wire"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFiles_diffFiles(t *testing.T) {
+	a, err := readFile("fedWireMessage-CustomerTransfer.txt")
+	require.NoError(t, err)
+	a.ID = "a"
+	b, err := readFile("fedWireMessage-CustomerTransfer.txt")
+	require.NoError(t, err)
+	b.ID = "b"
+	b.FEDWireMessages[0].Amount.Amount = "000000000100"
+	b.AddFEDWireMessage(b.FEDWireMessages[0])
+
+	repo := &memoryWireFileRepository{
+		files: map[string]*wire.File{"a": a, "b": b},
+	}
+	router := mux.NewRouter()
+	addFileRoutes(log.NewNopLogger(), router, repo, newLifecycleRepository())
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", "/files/a/diff/b", nil))
+	w.Flush()
+	require.Equal(t, http.StatusOK, w.Code, w.Body)
+
+	var changes []messageChange
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&changes))
+	require.Equal(t, messageChange{
+		MessageIndex: 0,
+		Change: wire.Change{
+			Tag:   wire.TagAmount,
+			Field: "Amount.Amount",
+			Old:   "000001234567",
+			New:   "000000000100",
+		},
+	}, changes[0])
+	// the second message of b is compared to none
+	require.Greater(t, len(changes), 1)
+	for _, c := range changes[1:] {
+		require.Equal(t, 1, c.MessageIndex)
+		require.Empty(t, c.Old)
+	}
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", "/files/a/diff/a?format=text", nil))
+	w.Flush()
+	require.Equal(t, http.StatusOK, w.Code, w.Body)
+	require.Empty(t, w.Body.String())
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", "/files/a/diff/b?format=text", nil))
+	w.Flush()
+	require.Contains(t, w.Body.String(), "0 {2000} Amount.Amount: \"000001234567\" => \"000000000100\"\n")
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", "/files/a/diff/c", nil))
+	w.Flush()
+	require.Equal(t, http.StatusNotFound, w.Code, w.Body)
+}
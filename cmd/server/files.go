@@ -11,6 +11,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -36,15 +37,20 @@ var (
 
 	errNoFileId           = errors.New("no File ID found")
 	errNoFEDWireMessageID = errors.New("no FEDWireMessage ID found")
+
+	// logRedaction masks the sensitive field values of the errors logged, they're logged as they are if it's nil
+	logRedaction *wire.RedactionPolicy
 )
 
-func addFileRoutes(logger log.Logger, r *mux.Router, repo WireFileRepository) {
+func addFileRoutes(logger log.Logger, r *mux.Router, repo WireFileRepository, lifecycles *lifecycleRepository) {
 	r.Methods("GET").Path("/files").HandlerFunc(getFiles(logger, repo))
 	r.Methods("POST").Path("/files/create").HandlerFunc(createFile(logger, repo))
 	r.Methods("GET").Path("/files/{fileId}").HandlerFunc(getFile(logger, repo))
-	r.Methods("DELETE").Path("/files/{fileId}").HandlerFunc(deleteFile(logger, repo))
+	r.Methods("DELETE").Path("/files/{fileId}").HandlerFunc(deleteFile(logger, repo, lifecycles))
 	r.Methods("GET").Path("/files/{fileId}/contents").HandlerFunc(getFileContents(logger, repo))
 	r.Methods("GET").Path("/files/{fileId}/validate").HandlerFunc(validateFile(logger, repo))
+	r.Methods("GET").Path("/files/{fileId}/diff/{otherFileId}").HandlerFunc(diffFiles(logger, repo))
+	r.Methods("GET").Path("/files/{fileId}/FEDWireMessage").HandlerFunc(getFEDWireMessages(logger, repo))
 	r.Methods("POST").Path("/files/{fileId}/FEDWireMessage").HandlerFunc(addFEDWireMessageToFile(logger, repo))
 }
 
@@ -106,15 +112,15 @@ func createFile(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
 			}
 
 			if err := file.Validate(); err != nil {
-				err = logger.LogErrorf("file validation failed: %v", err).Err()
-				moovhttp.Problem(w, err)
+				logger.LogErrorf("file validation failed: %v", logRedaction.RedactError(err))
+				moovhttp.Problem(w, fmt.Errorf("file validation failed: %v", err))
 				return
 			}
 		} else {
 			f, err := wire.NewReader(r.Body).ReadWithOpts(validateOptsFromQuery(r.URL.Query()))
 			if err != nil {
-				err = logger.LogErrorf("error reading file: %v", err).Err()
-				moovhttp.Problem(w, err)
+				logger.LogErrorf("error reading file: %v", logRedaction.RedactError(err))
+				moovhttp.Problem(w, fmt.Errorf("error reading file: %v", err))
 				return
 			}
 			file = &f
@@ -176,7 +182,7 @@ func getFile(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
 	}
 }
 
-func deleteFile(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
+func deleteFile(logger log.Logger, repo WireFileRepository, lifecycles *lifecycleRepository) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if requestID := moovhttp.GetRequestID(r); requestID != "" {
 			logger = logger.Set("requestID", log.String(requestID))
@@ -196,6 +202,7 @@ func deleteFile(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
 			moovhttp.Problem(w, err)
 			return
 		}
+		lifecycles.forget(fileId)
 		logger.Log("deleted file")
 
 		filesDeleted.Add(1)
@@ -284,9 +291,16 @@ func validateFile(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
 			return
 		}
 
-		if err := file.Create(); err != nil { // Create calls Validate
-			err = logger.LogErrorf("file was invalid: %v", err).Err()
-			moovhttp.Problem(w, err)
+		// custom rules can be enabled or disabled for this request alone, the stored file is left as is
+		enable, disable := ruleIDsFromQuery(r.URL.Query(), "enableRules"), ruleIDsFromQuery(r.URL.Query(), "disableRules")
+		if len(enable) > 0 || len(disable) > 0 {
+			file = withRules(file, enable, disable)
+		}
+
+		// report every error of the file, not just the first, so it can be fixed in one go
+		if err := file.ValidateAll(); err != nil {
+			logger.LogErrorf("file was invalid: %v", logRedaction.RedactError(err))
+			validationProblem(w, err)
 			return
 		}
 
@@ -302,6 +316,72 @@ func validateFile(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
 	}
 }
 
+// problemDetails is an RFC 7807 problem details response for an invalid file
+type problemDetails struct {
+	Type   string `json:"type"`
+	Title  string `json:"title"`
+	Status int    `json:"status"`
+	Detail string `json:"detail"`
+
+	// Error is the same as Detail, it's kept for clients reading moovhttp.Problem responses
+	Error string `json:"error"`
+	// Errors are each of the validation errors found
+	Errors []problemError `json:"errors"`
+}
+
+// problemError is a validation error of one FEDWireMessage in a file
+type problemError struct {
+	// MessageIndex is the position of the FEDWireMessage within the file, nil for errors of the file itself
+	MessageIndex *int          `json:"messageIndex,omitempty"`
+	Tag          string        `json:"tag,omitempty"`
+	Field        string        `json:"field,omitempty"`
+	Pointer      string        `json:"pointer"`
+	Code         string        `json:"code"`
+	Value        interface{}   `json:"value,omitempty"`
+	Severity     wire.Severity `json:"severity"`
+	Rule         string        `json:"rule,omitempty"`
+	Message      string        `json:"message"`
+}
+
+// validationProblem writes err, as returned by File.ValidateAll, as problem details
+func validationProblem(w http.ResponseWriter, err error) {
+	errs, ok := err.(base.ErrorList)
+	if !ok {
+		errs = base.ErrorList{err}
+	}
+	problem := problemDetails{
+		Type:   "about:blank",
+		Title:  "file validation failed",
+		Status: http.StatusBadRequest,
+		Detail: err.Error(),
+		Error:  err.Error(),
+		Errors: make([]problemError, 0, len(errs)),
+	}
+	for i := range errs {
+		// errors not of a FEDWireMessage are about the whole file, pointed to by an empty pointer
+		pe := problemError{
+			Code:     "invalid",
+			Severity: wire.SeverityError,
+			Message:  errs[i].Error(),
+		}
+		var msgErr *wire.MessageError
+		if errors.As(errs[i], &msgErr) {
+			pe.MessageIndex = &msgErr.Index
+			pe.Message = msgErr.Err.Error()
+		}
+		var ve *wire.ValidationError
+		if errors.As(errs[i], &ve) {
+			pe.Tag, pe.Field, pe.Pointer, pe.Code, pe.Value, pe.Severity, pe.Rule = ve.Tag, ve.Field, ve.Pointer, ve.Code, ve.Value, ve.Severity, ve.Rule
+			pe.Message = ve.Err.Error()
+		}
+		problem.Errors = append(problem.Errors, pe)
+	}
+
+	w.Header().Set("Content-Type", "application/problem+json; charset=utf-8")
+	w.WriteHeader(http.StatusBadRequest)
+	json.NewEncoder(w).Encode(problem)
+}
+
 func addFEDWireMessageToFile(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if requestID := moovhttp.GetRequestID(r); requestID != "" {
@@ -337,7 +417,7 @@ func addFEDWireMessageToFile(logger log.Logger, repo WireFileRepository) http.Ha
 			return
 		}
 
-		file.FEDWireMessage = file.AddFEDWireMessage(req)
+		file.AddFEDWireMessage(req)
 		if err := repo.saveFile(file); err != nil {
 			err = logger.LogErrorf("error saving file: %v", err).Err()
 			moovhttp.Problem(w, err)
@@ -351,6 +431,47 @@ func addFEDWireMessageToFile(logger log.Logger, repo WireFileRepository) http.Ha
 	}
 }
 
+func getFEDWireMessages(logger log.Logger, repo WireFileRepository) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if requestID := moovhttp.GetRequestID(r); requestID != "" {
+			logger = logger.Set("requestID", log.String(requestID))
+		}
+
+		w = wrapResponseWriter(logger, w, r)
+
+		fileId := getFileId(w, r)
+		if fileId == "" {
+			logger.LogError(errNoFileId)
+			return
+		}
+		logger = logger.Set("fileID", log.String(fileId))
+
+		file, err := repo.getFile(fileId)
+		if err != nil {
+			err = logger.LogErrorf("error retrieving file: %v", err).Err()
+			moovhttp.Problem(w, err)
+			return
+		}
+
+		if file == nil {
+			logger.Log("file not found")
+			http.NotFound(w, r)
+			return
+		}
+
+		messages := file.FEDWireMessages
+		if messages == nil {
+			messages = []wire.FEDWireMessage{}
+		}
+
+		logger.Logf("found %d FEDWireMessages", len(messages))
+		w.Header().Set("X-Total-Count", fmt.Sprintf("%d", len(messages)))
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(messages)
+	}
+}
+
 // GetWriter returns a new Writer based on request param `type` that writes to w.
 // query param `format`=variable - we set VariableLengthFields to `true`
 // query param `newline`=false - we set NewlineCharacter to ""
@@ -400,13 +521,25 @@ func validateOptsFromQuery(query url.Values) (opts *wire.ValidateOpts) {
 	}
 
 	const (
+		skipAll                    = "skipAll"
 		skipMandatoryIMAD          = "skipMandatoryIMAD"
 		allowMissingSenderSupplied = "allowMissingSenderSupplied"
+		skipProhibitedTags         = "skipProhibitedTags"
+		skipCharacterSet           = "skipCharacterSet"
+		skipRoutingNumberCheck     = "skipRoutingNumberCheck"
+		allowUnknownTags           = "allowUnknownTags"
+		skipRemittanceRules        = "skipRemittanceRules"
 	)
 
 	validationNames := []string{
+		skipAll,
 		skipMandatoryIMAD,
 		allowMissingSenderSupplied,
+		skipProhibitedTags,
+		skipCharacterSet,
+		skipRoutingNumberCheck,
+		allowUnknownTags,
+		skipRemittanceRules,
 	}
 
 	for _, param := range validationNames {
@@ -416,13 +549,64 @@ func validateOptsFromQuery(query url.Values) (opts *wire.ValidateOpts) {
 			}
 
 			switch param {
+			case skipAll:
+				opts.SkipAll = true
 			case skipMandatoryIMAD:
 				opts.SkipMandatoryIMAD = true
 			case allowMissingSenderSupplied:
 				opts.AllowMissingSenderSupplied = true
+			case skipProhibitedTags:
+				opts.SkipProhibitedTags = true
+			case skipCharacterSet:
+				opts.SkipCharacterSet = true
+			case skipRoutingNumberCheck:
+				opts.SkipRoutingNumberCheck = true
+			case allowUnknownTags:
+				opts.AllowUnknownTags = true
+			case skipRemittanceRules:
+				opts.SkipRemittanceRules = true
 			}
 		}
 	}
 
+	enable, disable := ruleIDsFromQuery(query, "enableRules"), ruleIDsFromQuery(query, "disableRules")
+	if len(enable) > 0 || len(disable) > 0 {
+		if opts == nil {
+			opts = &wire.ValidateOpts{}
+		}
+		opts.EnableRules, opts.DisableRules = enable, disable
+	}
+
 	return opts
 }
+
+// ruleIDsFromQuery returns the IDs of custom rules given in the query param, either repeated or as a comma
+// separated list
+func ruleIDsFromQuery(query url.Values, param string) []string {
+	var ids []string
+	for _, v := range query[param] {
+		for _, id := range strings.Split(v, ",") {
+			if id = strings.TrimSpace(id); id != "" {
+				ids = append(ids, id)
+			}
+		}
+	}
+	return ids
+}
+
+// withRules returns a copy of file whose messages also enable and disable the custom rules of the given IDs
+func withRules(file *wire.File, enable, disable []string) *wire.File {
+	out := *file
+	out.FEDWireMessages = make([]wire.FEDWireMessage, len(file.FEDWireMessages))
+	for i, fwm := range file.FEDWireMessages {
+		var opts wire.ValidateOpts
+		if fwm.ValidateOptions != nil {
+			opts = *fwm.ValidateOptions
+		}
+		opts.EnableRules = append(slices.Clip(opts.EnableRules), enable...)
+		opts.DisableRules = append(slices.Clip(opts.DisableRules), disable...)
+		fwm.ValidateOptions = &opts
+		out.FEDWireMessages[i] = fwm
+	}
+	return &out
+}
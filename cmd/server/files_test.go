@@ -17,6 +17,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/moov-io/base"
@@ -49,7 +50,7 @@ func TestFiles_getFiles(t *testing.T) {
 		},
 	}
 	router := mux.NewRouter()
-	addFileRoutes(log.NewNopLogger(), router, repo)
+	addFileRoutes(log.NewNopLogger(), router, repo, newLifecycleRepository())
 	req := httptest.NewRequest("GET", "/files", nil)
 
 	t.Run("retrieves file", func(t *testing.T) {
@@ -87,10 +88,10 @@ func readFile(filename string) (*wire.File, error) {
 func TestFiles_createWithInterfaceData(t *testing.T) {
 	router := mux.NewRouter()
 	repo := &testWireFileRepository{}
-	addFileRoutes(log.NewTestLogger(), router, repo)
+	addFileRoutes(log.NewTestLogger(), router, repo, newLifecycleRepository())
 
 	w := httptest.NewRecorder()
-	raw := `FTI0811 XFT811  {1500}30        T {1510}1000{1520}20220128DOVTAL3C000001{2000}000000010000{3100}123456789DOVETAIL BANK US F*{3320}XX22012800000051*{3400}021000089CITIBANK NYC*{3600}CTP{3620}3*3AC4C307-0FFB-4028-BD8E-53D55BDB90E1*{3700}SUSD0,*{4200}D000100002*{5000}T000100011*DRESDEFFXXX*`
+	raw := `FTI0811 XFT811  {1500}30        T {1510}1000{1520}20220128DOVTAL3C000001{2000}000000010000{3100}123456780DOVETAIL BANK US F*{3320}XX22012800000051*{3400}021000089CITIBANK NYC*{3600}CTP{3620}3*3AC4C307-0FFB-4028-BD8E-53D55BDB90E1*{3700}SUSD0,*{4200}D000100002*{5000}D000100011*DRESDEFFXXX*`
 	req, err := http.NewRequest(http.MethodPost, "/files/create", bytes.NewReader([]byte(raw)))
 	require.NoError(t, err)
 
@@ -130,7 +131,7 @@ func TestFiles_createFile(t *testing.T) {
 	req := httptest.NewRequest("POST", "/files/create", bytes.NewReader(bs))
 	repo := &testWireFileRepository{}
 	router := mux.NewRouter()
-	addFileRoutes(log.NewNopLogger(), router, repo)
+	addFileRoutes(log.NewNopLogger(), router, repo, newLifecycleRepository())
 
 	t.Run("creates file", func(t *testing.T) {
 		w := httptest.NewRecorder()
@@ -142,7 +143,7 @@ func TestFiles_createFile(t *testing.T) {
 		var resp wire.File
 		require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
 		assert.NotEmpty(t, resp.ID)
-		assert.NotNil(t, resp.FEDWireMessage.FIAdditionalFIToFI)
+		assert.NotNil(t, resp.FEDWireMessages[0].FIAdditionalFIToFI)
 	})
 
 	t.Run("repo error", func(t *testing.T) {
@@ -159,7 +160,7 @@ func TestFiles_createFile(t *testing.T) {
 func TestFiles_createFileJSON(t *testing.T) {
 	repo := &testWireFileRepository{}
 	router := mux.NewRouter()
-	addFileRoutes(log.NewNopLogger(), router, repo)
+	addFileRoutes(log.NewNopLogger(), router, repo, newLifecycleRepository())
 
 	t.Run("creates file from JSON", func(t *testing.T) {
 		w := httptest.NewRecorder()
@@ -176,7 +177,7 @@ func TestFiles_createFileJSON(t *testing.T) {
 		var resp wire.File
 		require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
 		assert.NotEmpty(t, resp.ID)
-		assert.NotNil(t, resp.FEDWireMessage.FIAdditionalFIToFI)
+		assert.NotNil(t, resp.FEDWireMessages[0].FIAdditionalFIToFI)
 	})
 
 	t.Run("creates file from JSON", func(t *testing.T) {
@@ -194,8 +195,8 @@ func TestFiles_createFileJSON(t *testing.T) {
 		var resp wire.File
 		require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
 		assert.NotEmpty(t, resp.ID)
-		assert.NotEmpty(t, resp.FEDWireMessage)
-		assert.Nil(t, resp.FEDWireMessage.ValidateOptions)
+		assert.NotEmpty(t, resp.FEDWireMessages)
+		assert.Nil(t, resp.FEDWireMessages[0].ValidateOptions)
 	})
 
 	t.Run("invalid JSON", func(t *testing.T) {
@@ -213,7 +214,7 @@ func TestFiles_createFileJSON(t *testing.T) {
 func TestFiles_createFile_missingSenderSupplied(t *testing.T) {
 	repo := &testWireFileRepository{}
 	router := mux.NewRouter()
-	addFileRoutes(log.NewNopLogger(), router, repo)
+	addFileRoutes(log.NewNopLogger(), router, repo, newLifecycleRepository())
 
 	// set up a message with no SenderSupplied field
 	fwm := mockFEDWireMessage()
@@ -228,21 +229,21 @@ func TestFiles_createFile_missingSenderSupplied(t *testing.T) {
 	require.Contains(t, resp.Body.String(), "SenderSupplied")
 
 	// create from JSON, using validation options, should succeed without sender supplied
-	file.FEDWireMessage.ValidateOptions = &wire.ValidateOpts{
+	file.FEDWireMessages[0].ValidateOptions = &wire.ValidateOpts{
 		AllowMissingSenderSupplied: true,
 	}
 	resp, uploaded := routerUploadJSON(t, router, file)
 	require.Equal(t, http.StatusCreated, resp.Code, resp.Body)
 	assert.NotEmpty(t, uploaded.ID)
-	assert.Nil(t, uploaded.FEDWireMessage.SenderSupplied)
+	assert.Nil(t, uploaded.FEDWireMessages[0].SenderSupplied)
 
 	// make sure the file was saved
 	resp, found := routerGetFile(t, router, uploaded.ID)
 	require.Equal(t, http.StatusOK, resp.Code, resp.Body)
 	assert.Equal(t, uploaded.ID, found.ID)
-	assert.Nil(t, found.FEDWireMessage.SenderSupplied)
-	assert.NotNil(t, found.FEDWireMessage.ValidateOptions)
-	assert.True(t, found.FEDWireMessage.ValidateOptions.AllowMissingSenderSupplied)
+	assert.Nil(t, found.FEDWireMessages[0].SenderSupplied)
+	assert.NotNil(t, found.FEDWireMessages[0].ValidateOptions)
+	assert.True(t, found.FEDWireMessages[0].ValidateOptions.AllowMissingSenderSupplied)
 
 	// get file contents calls Validate()
 	// if isIncoming was passed properly, then the file should be valid
@@ -256,15 +257,15 @@ func TestFiles_createFile_missingSenderSupplied(t *testing.T) {
 	)
 	require.Equal(t, http.StatusCreated, resp.Code, resp.Body)
 	assert.NotEmpty(t, rawUpload.ID)
-	assert.Nil(t, rawUpload.FEDWireMessage.SenderSupplied)
-	assert.NotNil(t, rawUpload.FEDWireMessage.ValidateOptions)
-	assert.True(t, rawUpload.FEDWireMessage.ValidateOptions.AllowMissingSenderSupplied)
+	assert.Nil(t, rawUpload.FEDWireMessages[0].SenderSupplied)
+	assert.NotNil(t, rawUpload.FEDWireMessages[0].ValidateOptions)
+	assert.True(t, rawUpload.FEDWireMessages[0].ValidateOptions.AllowMissingSenderSupplied)
 
 	// get new file
 	resp, found = routerGetFile(t, router, rawUpload.ID)
 	require.Equal(t, http.StatusOK, resp.Code, resp.Body)
 	assert.Equal(t, rawUpload.ID, found.ID)
-	assert.Nil(t, found.FEDWireMessage.SenderSupplied)
+	assert.Nil(t, found.FEDWireMessages[0].SenderSupplied)
 
 	// get new file contents
 	resp = routerGetFileContents(t, router, rawUpload.ID)
@@ -350,7 +351,7 @@ func TestFiles_getFile(t *testing.T) {
 		},
 	}
 	router := mux.NewRouter()
-	addFileRoutes(log.NewNopLogger(), router, repo)
+	addFileRoutes(log.NewNopLogger(), router, repo, newLifecycleRepository())
 
 	t.Run("gets file", func(t *testing.T) {
 		w := httptest.NewRecorder()
@@ -389,8 +390,10 @@ func TestFiles_getFile(t *testing.T) {
 func TestFiles_deleteFile(t *testing.T) {
 	req := httptest.NewRequest("DELETE", "/files/foo", nil)
 	repo := &testWireFileRepository{}
+	lifecycles := newLifecycleRepository()
+	lifecycles.lifecycles["foo"] = []*wire.Lifecycle{wire.NewLifecycle(time.Now())}
 	router := mux.NewRouter()
-	addFileRoutes(log.NewNopLogger(), router, repo)
+	addFileRoutes(log.NewNopLogger(), router, repo, lifecycles)
 
 	t.Run("deletes file", func(t *testing.T) {
 		w := httptest.NewRecorder()
@@ -400,6 +403,7 @@ func TestFiles_deleteFile(t *testing.T) {
 
 		assert.Equal(t, http.StatusOK, w.Code, w.Body)
 		assert.Contains(t, w.Body.String(), `{"error":null}`)
+		assert.NotContains(t, lifecycles.lifecycles, "foo")
 	})
 
 	t.Run("repo error", func(t *testing.T) {
@@ -418,12 +422,12 @@ func TestFiles_getFileContents(t *testing.T) {
 	fwm := mockFEDWireMessage()
 	repo := &testWireFileRepository{
 		file: &wire.File{
-			ID:             base.ID(),
-			FEDWireMessage: fwm,
+			ID:              base.ID(),
+			FEDWireMessages: []wire.FEDWireMessage{fwm},
 		},
 	}
 	router := mux.NewRouter()
-	addFileRoutes(log.NewNopLogger(), router, repo)
+	addFileRoutes(log.NewNopLogger(), router, repo, newLifecycleRepository())
 
 	t.Run("gets file contents", func(t *testing.T) {
 		w := httptest.NewRecorder()
@@ -461,12 +465,12 @@ func TestFiles_getFileContentsWithFormatAndNewLineQueryParams(t *testing.T) {
 	fwm := mockFEDWireMessage()
 	repo := &testWireFileRepository{
 		file: &wire.File{
-			ID:             base.ID(),
-			FEDWireMessage: fwm,
+			ID:              base.ID(),
+			FEDWireMessages: []wire.FEDWireMessage{fwm},
 		},
 	}
 	router := mux.NewRouter()
-	addFileRoutes(log.NewNopLogger(), router, repo)
+	addFileRoutes(log.NewNopLogger(), router, repo, newLifecycleRepository())
 
 	// test with no format no newline=false
 	req := httptest.NewRequest("GET", "/files/foo/contents", nil)
@@ -539,7 +543,7 @@ func TestFiles_validateFile(t *testing.T) {
 	require.NoError(t, err)
 	repo := &testWireFileRepository{file: f}
 	router := mux.NewRouter()
-	addFileRoutes(log.NewNopLogger(), router, repo)
+	addFileRoutes(log.NewNopLogger(), router, repo, newLifecycleRepository())
 
 	t.Run("validates file", func(t *testing.T) {
 		w := httptest.NewRecorder()
@@ -550,6 +554,88 @@ func TestFiles_validateFile(t *testing.T) {
 		assert.Contains(t, w.Body.String(), `{"error":null}`)
 	})
 
+	t.Run("returns every error", func(t *testing.T) {
+		invalid, err := readFile("fedWireMessage-CustomerTransfer.txt")
+		require.NoError(t, err)
+		invalid.FEDWireMessages[0].Amount = nil
+		invalid.FEDWireMessages[0].Originator = nil
+		repo := &testWireFileRepository{file: invalid}
+		router := mux.NewRouter()
+		addFileRoutes(log.NewNopLogger(), router, repo, newLifecycleRepository())
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		w.Flush()
+
+		assert.Equal(t, http.StatusBadRequest, w.Code, w.Body)
+		assert.Contains(t, w.Header().Get("Content-Type"), "application/problem+json")
+		var resp problemDetails
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+		assert.Equal(t, http.StatusBadRequest, resp.Status)
+		require.Len(t, resp.Errors, 2)
+		require.NotNil(t, resp.Errors[0].MessageIndex)
+		assert.Equal(t, 0, *resp.Errors[0].MessageIndex)
+		assert.Equal(t, wire.TagAmount, resp.Errors[0].Tag)
+		assert.Equal(t, "/fedWireMessages/0/amount", resp.Errors[0].Pointer)
+		assert.Equal(t, "field_required", resp.Errors[0].Code)
+		assert.Equal(t, wire.SeverityError, resp.Errors[0].Severity)
+		assert.Equal(t, "Originator is a required field", resp.Errors[1].Message)
+		assert.Contains(t, resp.Error, resp.Errors[1].Message)
+	})
+
+	t.Run("file errors", func(t *testing.T) {
+		repo := &testWireFileRepository{file: wire.NewFile()}
+		router := mux.NewRouter()
+		addFileRoutes(log.NewNopLogger(), router, repo, newLifecycleRepository())
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		w.Flush()
+
+		assert.Equal(t, http.StatusBadRequest, w.Code, w.Body)
+		assert.NotContains(t, w.Body.String(), "messageIndex")
+		var resp problemDetails
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+		require.Len(t, resp.Errors, 1)
+		assert.Nil(t, resp.Errors[0].MessageIndex)
+		assert.Equal(t, "file_no_messages", resp.Errors[0].Code)
+	})
+
+	t.Run("custom rules", func(t *testing.T) {
+		rule := wire.Rule{
+			ID: "no-ctr",
+			Check: func(fwm *wire.FEDWireMessage) []error {
+				if fwm.BusinessFunctionCode.BusinessFunctionCode == wire.CustomerTransfer {
+					return []error{errors.New("customer transfers are not sent")}
+				}
+				return nil
+			},
+			Disabled: true,
+		}
+		require.NoError(t, wire.RegisterRule(rule))
+		t.Cleanup(func() { wire.UnregisterRule(rule.ID) })
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusOK, w.Code, w.Body)
+
+		w = httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest("GET", "/files/foo/validate?enableRules=no-ctr", nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code, w.Body)
+		var resp problemDetails
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+		require.Len(t, resp.Errors, 1)
+		assert.Equal(t, "no-ctr", resp.Errors[0].Rule)
+		assert.Equal(t, "rule", resp.Errors[0].Code)
+
+		w = httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest("GET", "/files/foo/validate?enableRules=no-ctr&disableRules=no-ctr", nil))
+		assert.Equal(t, http.StatusOK, w.Code, w.Body)
+
+		// the stored file is unchanged
+		assert.Nil(t, repo.file.FEDWireMessages[0].ValidateOptions)
+	})
+
 	t.Run("repo error", func(t *testing.T) {
 		w := httptest.NewRecorder()
 		repo.err = errors.New("bad error")
@@ -572,13 +658,36 @@ func TestFiles_validateFile(t *testing.T) {
 	})
 }
 
+func TestFiles_validateFileRedactsLogs(t *testing.T) {
+	logRedaction = wire.DefaultRedactionPolicy("key")
+	t.Cleanup(func() { logRedaction = nil })
+
+	f, err := readFile("fedWireMessage-CustomerTransfer.txt")
+	require.NoError(t, err)
+	f.FEDWireMessages[0].Beneficiary.Personal.Identifier = "123456789®"
+	repo := &testWireFileRepository{file: f}
+	buf, logger := log.NewBufferLogger()
+	router := mux.NewRouter()
+	addFileRoutes(logger, router, repo, newLifecycleRepository())
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", "/files/foo/validate", nil))
+	w.Flush()
+	require.Equal(t, http.StatusBadRequest, w.Code, w.Body)
+
+	// the response keeps the values, the logs mask them
+	require.Contains(t, w.Body.String(), "123456789®")
+	require.Contains(t, buf.String(), "XXXXXX789®")
+	require.NotContains(t, buf.String(), "123456789®")
+}
+
 func TestFiles_addFEDWireMessageToFile(t *testing.T) {
 	f, err := readFile("fedWireMessage-NoMessage.txt")
 	require.Contains(t, err.Error(), "file validation failed")
 	fwm := mockFEDWireMessage()
 	repo := &testWireFileRepository{file: f}
 	router := mux.NewRouter()
-	addFileRoutes(log.NewNopLogger(), router, repo)
+	addFileRoutes(log.NewNopLogger(), router, repo, newLifecycleRepository())
 
 	t.Run("adds message to file", func(t *testing.T) {
 		w := httptest.NewRecorder()
@@ -592,7 +701,24 @@ func TestFiles_addFEDWireMessageToFile(t *testing.T) {
 		assert.Equal(t, http.StatusOK, w.Code, w.Body)
 		var out wire.File
 		require.NoError(t, json.NewDecoder(w.Body).Decode(&out))
-		assert.NotNil(t, out.FEDWireMessage.SenderSupplied)
+		require.Len(t, out.FEDWireMessages, 1)
+		assert.NotNil(t, out.FEDWireMessages[0].SenderSupplied)
+	})
+
+	t.Run("appends second message", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		var buf bytes.Buffer
+		require.NoError(t, json.NewEncoder(&buf).Encode(fwm))
+		req := httptest.NewRequest("POST", "/files/foo/FEDWireMessage", &buf)
+
+		router.ServeHTTP(w, req)
+		w.Flush()
+
+		assert.Equal(t, http.StatusOK, w.Code, w.Body)
+		var out wire.File
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&out))
+		require.Len(t, out.FEDWireMessages, 2)
+		require.NoError(t, out.Validate())
 	})
 
 	t.Run("repo error", func(t *testing.T) {
@@ -623,6 +749,50 @@ func TestFiles_addFEDWireMessageToFile(t *testing.T) {
 	})
 }
 
+func TestFiles_getFEDWireMessages(t *testing.T) {
+	req := httptest.NewRequest("GET", "/files/foo/FEDWireMessage", nil)
+	f, err := readFile("fedWireMessage-Batch.txt")
+	require.NoError(t, err)
+	repo := &testWireFileRepository{file: f}
+	router := mux.NewRouter()
+	addFileRoutes(log.NewNopLogger(), router, repo, newLifecycleRepository())
+
+	t.Run("lists messages", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+		w.Flush()
+
+		assert.Equal(t, http.StatusOK, w.Code, w.Body)
+		assert.Equal(t, "3", w.Header().Get("X-Total-Count"))
+		var messages []wire.FEDWireMessage
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&messages))
+		require.Len(t, messages, 3)
+		assert.Equal(t, wire.BankTransfer, messages[1].BusinessFunctionCode.BusinessFunctionCode)
+	})
+
+	t.Run("repo error", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		repo.err = errors.New("bad error")
+
+		router.ServeHTTP(w, req)
+		w.Flush()
+
+		assert.Equal(t, http.StatusBadRequest, w.Code, w.Body)
+	})
+
+	t.Run("file not found", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		repo.file = nil
+		repo.err = nil
+
+		router.ServeHTTP(w, req)
+		w.Flush()
+
+		assert.Equal(t, http.StatusNotFound, w.Code, w.Body)
+	})
+}
+
 /*func TestFiles_removeFEDWireMessageFromFile(t *testing.T) {
 	f, err := readFile("fedWireMessage-CustomerTransfer.txt")
 	if err != nil {
@@ -637,7 +807,7 @@ func TestFiles_addFEDWireMessageToFile(t *testing.T) {
 	req := httptest.NewRequest("DELETE", fmt.Sprintf("/files/foo/FEDWireMessage/%s", FEDWireMessageID), nil)
 
 	router := mux.NewRouter()
-	addFileRoutes(log.NewNopLogger(), router, repo)
+	addFileRoutes(log.NewNopLogger(), router, repo, newLifecycleRepository())
 	router.ServeHTTP(w, req)
 	w.Flush()
 
@@ -656,3 +826,34 @@ func TestFiles_addFEDWireMessageToFile(t *testing.T) {
 		t.Errorf("bogus HTTP status: %d: %v", w.Code, w.Body.String())
 	}
 }*/
+
+func TestFiles_validateOptsFromQuery(t *testing.T) {
+	require.Nil(t, validateOptsFromQuery(url.Values{}))
+	require.Nil(t, validateOptsFromQuery(url.Values{"skipAll": []string{"false"}}))
+
+	opts := validateOptsFromQuery(url.Values{
+		"skipAll":                []string{"true"},
+		"skipProhibitedTags":     []string{"true"},
+		"skipCharacterSet":       []string{"1"},
+		"skipRoutingNumberCheck": []string{"true"},
+		"allowUnknownTags":       []string{"true"},
+		"skipRemittanceRules":    []string{"true"},
+	})
+	require.Equal(t, &wire.ValidateOpts{
+		SkipAll:                true,
+		SkipProhibitedTags:     true,
+		SkipCharacterSet:       true,
+		SkipRoutingNumberCheck: true,
+		AllowUnknownTags:       true,
+		SkipRemittanceRules:    true,
+	}, opts)
+
+	opts = validateOptsFromQuery(url.Values{
+		"enableRules":  []string{"a, b", "c"},
+		"disableRules": []string{"d"},
+	})
+	require.Equal(t, &wire.ValidateOpts{
+		EnableRules:  []string{"a", "b", "c"},
+		DisableRules: []string{"d"},
+	}, opts)
+}
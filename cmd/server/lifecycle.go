@@ -0,0 +1,233 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/moov-io/base"
+	moovhttp "github.com/moov-io/base/http"
+	"github.com/moov-io/base/log"
+	"github.com/moov-io/wire"
+)
+
+// lifecycleRepository keeps the wire.Lifecycle of each FEDWireMessage of the files, by file ID and message index
+type lifecycleRepository struct {
+	mu         sync.Mutex
+	lifecycles map[string][]*wire.Lifecycle
+}
+
+func newLifecycleRepository() *lifecycleRepository {
+	return &lifecycleRepository{
+		lifecycles: make(map[string][]*wire.Lifecycle),
+	}
+}
+
+// update calls fn with the Lifecycles of the messages of file, tracking messages not seen before as drafts
+// created at now. The changes fn makes are kept only if it returns no error.
+func (r *lifecycleRepository) update(file *wire.File, now time.Time, fn func([]*wire.Lifecycle) error) ([]*wire.Lifecycle, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// Lifecycles are copied before fn changes them, so those returned earlier are never written to
+	var out []*wire.Lifecycle
+	for _, l := range r.lifecycles[file.ID] {
+		c := *l
+		c.History = slices.Clone(l.History)
+		out = append(out, &c)
+	}
+	for len(out) < len(file.FEDWireMessages) {
+		out = append(out, wire.NewLifecycle(now))
+	}
+	if fn != nil {
+		if err := fn(out); err != nil {
+			return nil, err
+		}
+	}
+	r.lifecycles[file.ID] = out
+	return out, nil
+}
+
+// forget drops the Lifecycles of a file
+func (r *lifecycleRepository) forget(fileId string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.lifecycles, fileId)
+}
+
+// messageLifecycle is the JSON of the wire.Lifecycle of a FEDWireMessage
+type messageLifecycle struct {
+	// MessageIndex is the position of the FEDWireMessage within the file
+	MessageIndex int    `json:"messageIndex"`
+	IMAD         string `json:"imad,omitempty"`
+	*wire.Lifecycle
+}
+
+func addLifecycleRoutes(logger log.Logger, r *mux.Router, repo WireFileRepository, lifecycles *lifecycleRepository) {
+	r.Methods("GET").Path("/files/{fileId}/lifecycle").HandlerFunc(lifecycleHandler(logger, repo, lifecycles, nil))
+	r.Methods("POST").Path("/files/{fileId}/lifecycle/validate").HandlerFunc(lifecycleHandler(logger, repo, lifecycles, validateLifecycles))
+	r.Methods("POST").Path("/files/{fileId}/lifecycle/release").HandlerFunc(lifecycleHandler(logger, repo, lifecycles, releaseLifecycles))
+	r.Methods("POST").Path("/files/{fileId}/lifecycle/messages").HandlerFunc(lifecycleHandler(logger, repo, lifecycles, applyLifecycleMessages))
+}
+
+// lifecycleAction changes the Lifecycles of the messages of file as requested by r
+type lifecycleAction func(r *http.Request, file *wire.File, lifecycles []*wire.Lifecycle, now time.Time) error
+
+// lifecycleHandler applies action, if any, to the Lifecycles of the messages of a file and responds with them
+func lifecycleHandler(logger log.Logger, repo WireFileRepository, lifecycles *lifecycleRepository, action lifecycleAction) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if requestID := moovhttp.GetRequestID(r); requestID != "" {
+			logger = logger.Set("requestID", log.String(requestID))
+		}
+
+		w = wrapResponseWriter(logger, w, r)
+
+		fileId := getFileId(w, r)
+		if fileId == "" {
+			logger.LogError(errNoFileId)
+			return
+		}
+		logger = logger.Set("fileID", log.String(fileId))
+
+		file, err := repo.getFile(fileId)
+		if err != nil {
+			err = logger.LogErrorf("error retrieving file: %v", err).Err()
+			moovhttp.Problem(w, err)
+			return
+		}
+
+		if file == nil {
+			lifecycles.forget(fileId)
+			logger.Log("file not found")
+			http.NotFound(w, r)
+			return
+		}
+
+		now := time.Now()
+		var fn func([]*wire.Lifecycle) error
+		if action != nil {
+			fn = func(ls []*wire.Lifecycle) error {
+				return action(r, file, ls, now)
+			}
+		}
+		out, err := lifecycles.update(file, now, fn)
+		if err != nil {
+			logger.LogErrorf("error updating lifecycle: %v", logRedaction.RedactError(err))
+			if errs, ok := err.(base.ErrorList); ok {
+				validationProblem(w, errs)
+				return
+			}
+			moovhttp.Problem(w, err)
+			return
+		}
+
+		resp := make([]messageLifecycle, len(out))
+		for i := range out {
+			resp[i] = messageLifecycle{
+				MessageIndex: i,
+				IMAD:         file.FEDWireMessages[i].InputMessageAccountabilityData.Reference(),
+				Lifecycle:    out[i],
+			}
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(resp)
+	}
+}
+
+// validateLifecycles moves the draft messages of file to validated, unless one of them is invalid. Custom rules
+// can be enabled or disabled for this request alone, as when validating the file.
+func validateLifecycles(r *http.Request, file *wire.File, lifecycles []*wire.Lifecycle, now time.Time) error {
+	enable, disable := ruleIDsFromQuery(r.URL.Query(), "enableRules"), ruleIDsFromQuery(r.URL.Query(), "disableRules")
+	if len(enable) > 0 || len(disable) > 0 {
+		file = withRules(file, enable, disable)
+	}
+
+	var errs base.ErrorList
+	for i, l := range lifecycles {
+		if l.State != wire.WireDraft {
+			continue
+		}
+		err := l.Validate(&file.FEDWireMessages[i], now)
+		if el, ok := err.(base.ErrorList); ok {
+			for _, err := range el {
+				errs.Add(wire.NewMessageError(i, err))
+			}
+		} else if err != nil {
+			errs.Add(wire.NewMessageError(i, err))
+		}
+	}
+	if errs.Empty() {
+		return nil
+	}
+	return errs
+}
+
+// releaseLifecycles moves the validated messages of file to released, unless one of them is still a draft
+func releaseLifecycles(r *http.Request, file *wire.File, lifecycles []*wire.Lifecycle, now time.Time) error {
+	for i, l := range lifecycles {
+		if l.State == wire.WireDraft {
+			return fmt.Errorf("message %d: %w", i, wire.ErrInvalidTransition)
+		}
+	}
+	for _, l := range lifecycles {
+		if l.State == wire.WireValidated {
+			if err := l.Release(now); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// errNoLifecycleMessage is the error given when a message received matches no message of the file
+var errNoLifecycleMessage = errors.New("matches no FEDWireMessage of the file")
+
+// applyLifecycleMessages applies the messages received of the request body, in Fedwire format, to the messages
+// of file they're about: acknowledgements by IMAD, reversals by their PreviousMessageIdentifier referring to an
+// IMAD or the OMAD of an acknowledged message.
+func applyLifecycleMessages(r *http.Request, file *wire.File, lifecycles []*wire.Lifecycle, now time.Time) error {
+	received, err := wire.NewReader(r.Body).ReadWithOpts(validateOptsFromQuery(r.URL.Query()))
+	if err != nil {
+		return fmt.Errorf("error reading messages: %w", err)
+	}
+
+	for i := range received.FEDWireMessages {
+		fwm := &received.FEDWireMessages[i]
+		var reference string
+		if fwm.IsAcknowledgement() {
+			reference = fwm.InputMessageAccountabilityData.Reference()
+		} else if fwm.IsReversal() && fwm.PreviousMessageIdentifier != nil {
+			reference = fwm.PreviousMessageIdentifier.PreviousMessageIdentifier
+		}
+
+		idx := slices.IndexFunc(lifecycles, func(l *wire.Lifecycle) bool {
+			return reference != "" && strings.HasPrefix(l.OMAD, reference)
+		})
+		for j := range file.FEDWireMessages {
+			if idx >= 0 {
+				break
+			}
+			if reference == file.FEDWireMessages[j].InputMessageAccountabilityData.Reference() {
+				idx = j
+			}
+		}
+		if reference == "" || idx < 0 {
+			return fmt.Errorf("message %d: %s %w", i, reference, errNoLifecycleMessage)
+		}
+		if err := lifecycles[idx].Apply(fwm, now); err != nil {
+			return fmt.Errorf("message %d: %w", i, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/moov-io/base/log"
+	"github.com/moov-io/wire"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLifecycle_routes(t *testing.T) {
+	file, err := readFile("fedWireMessage-CustomerTransfer.txt")
+	require.NoError(t, err)
+	file.ID = "foo"
+
+	repo := &testWireFileRepository{file: file}
+	router := mux.NewRouter()
+	addLifecycleRoutes(log.NewNopLogger(), router, repo, newLifecycleRepository())
+
+	do := func(method, path string, body []byte) ([]messageLifecycle, *httptest.ResponseRecorder) {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(method, path, bytes.NewReader(body)))
+		w.Flush()
+
+		var out []messageLifecycle
+		if w.Code == http.StatusOK {
+			require.NoError(t, json.NewDecoder(w.Body).Decode(&out))
+		}
+		return out, w
+	}
+
+	out, w := do("GET", "/files/foo/lifecycle", nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body)
+	require.Len(t, out, 1)
+	require.Equal(t, "20190410Source08000001", out[0].IMAD)
+	require.Equal(t, wire.WireDraft, out[0].State)
+
+	// a draft can't be released
+	_, w = do("POST", "/files/foo/lifecycle/release", nil)
+	require.Equal(t, http.StatusBadRequest, w.Code, w.Body)
+
+	out, w = do("POST", "/files/foo/lifecycle/validate", nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body)
+	require.Equal(t, wire.WireValidated, out[0].State)
+
+	out, w = do("POST", "/files/foo/lifecycle/release", nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body)
+	require.Equal(t, wire.WireReleased, out[0].State)
+
+	// the Fed's acknowledgement of another message is refused
+	ack := file.FEDWireMessages[0]
+	ack.MessageDisposition = wire.NewMessageDisposition()
+	ack.MessageDisposition.MessageStatusIndicator = wire.MessageStatusSuccessfulValue
+	imad := *ack.InputMessageAccountabilityData
+	imad.InputSequenceNumber = "000002"
+	ack.InputMessageAccountabilityData = &imad
+	_, w = do("POST", "/files/foo/lifecycle/messages", writeMessages(t, ack))
+	require.Equal(t, http.StatusBadRequest, w.Code, w.Body)
+
+	imad.InputSequenceNumber = "000001"
+	out, w = do("POST", "/files/foo/lifecycle/messages", writeMessages(t, ack))
+	require.Equal(t, http.StatusOK, w.Code, w.Body)
+	require.Equal(t, wire.WireAcknowledged, out[0].State)
+	require.Len(t, out[0].History, 4)
+
+	// state is kept between requests
+	out, w = do("GET", "/files/foo/lifecycle", nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body)
+	require.Equal(t, wire.WireAcknowledged, out[0].State)
+	for i, event := range out[0].History[1:] {
+		require.Equal(t, out[0].History[i].To, event.From)
+		require.False(t, event.Time.Before(out[0].History[i].Time))
+	}
+
+	repo.file = nil
+	_, w = do("GET", "/files/foo/lifecycle", nil)
+	require.Equal(t, http.StatusNotFound, w.Code, w.Body)
+}
+
+// writeMessages returns msgs in Fedwire format
+func writeMessages(t *testing.T, msgs ...wire.FEDWireMessage) []byte {
+	t.Helper()
+
+	file := wire.NewFile()
+	for _, fwm := range msgs {
+		file.AddFEDWireMessage(fwm)
+	}
+	var buf bytes.Buffer
+	require.NoError(t, wire.NewWriter(&buf).Write(file))
+	return buf.Bytes()
+}
@@ -17,6 +17,7 @@ import (
 	"time"
 
 	"github.com/gorilla/mux"
+	"github.com/moov-io/base"
 	"github.com/moov-io/base/log"
 
 	"github.com/moov-io/base/admin"
@@ -30,6 +31,7 @@ var (
 	adminAddr = flag.String("admin.addr", bind.Admin("wire"), "Admin HTTP listen address")
 
 	flagLogFormat = flag.String("log.format", "", "Format for log lines (Options: json, plain")
+	flagLogRedact = flag.Bool("log.redact", true, "Mask sensitive field values, such as account numbers and names, in log lines")
 )
 
 func main() {
@@ -42,8 +44,21 @@ func main() {
 		logger = log.NewDefaultLogger()
 	}
 	logger = logger.Set("package", log.String("main"))
+	if *flagLogRedact {
+		// names are hashed with a key of their own for each run, so they can't be looked up across runs
+		logRedaction = wire.DefaultRedactionPolicy(base.ID())
+	}
 	logger.Logf("Starting wire server version %s", wire.Version)
 
+	// whether an IMAD cycle date is in the future depends on when it's checked, so it's only checked by
+	// requests enabling the rule
+	cycleDate := wire.CycleDateRule(time.Now)
+	cycleDate.Disabled = true
+	if err := wire.RegisterRule(cycleDate); err != nil {
+		logger.LogError(err)
+		return
+	}
+
 	// Channel for errors
 	errs := make(chan error)
 
@@ -83,7 +98,10 @@ func main() {
 	router := mux.NewRouter()
 	moovhttp.AddCORSHandler(router)
 	addPingRoute(router)
-	addFileRoutes(logger, router, repo)
+	lifecycles := newLifecycleRepository()
+	addFileRoutes(logger, router, repo, lifecycles)
+	addScheduleRoutes(logger, router, wire.DefaultSchedule())
+	addLifecycleRoutes(logger, router, repo, lifecycles)
 
 	// Start business HTTP server
 	readTimeout, _ := time.ParseDuration("30s")
@@ -0,0 +1,77 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	moovhttp "github.com/moov-io/base/http"
+	"github.com/moov-io/base/log"
+	"github.com/moov-io/wire"
+)
+
+// scheduleStatus is the JSON of a wire.ScheduleStatus
+type scheduleStatus struct {
+	BusinessFunctionCode string    `json:"businessFunctionCode,omitempty"`
+	Time                 time.Time `json:"time"`
+	SendToday            bool      `json:"sendToday"`
+	Open                 bool      `json:"open"`
+	CycleDate            string    `json:"cycleDate"`
+	Opens                time.Time `json:"opens"`
+	Cutoff               time.Time `json:"cutoff"`
+	SecondsUntilCutoff   int64     `json:"secondsUntilCutoff"`
+}
+
+func addScheduleRoutes(logger log.Logger, r *mux.Router, schedule *wire.Schedule) {
+	r.Methods("GET").Path("/schedule").HandlerFunc(getSchedule(logger, schedule))
+}
+
+func getSchedule(logger log.Logger, schedule *wire.Schedule) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if requestID := moovhttp.GetRequestID(r); requestID != "" {
+			logger = logger.Set("requestID", log.String(requestID))
+		}
+
+		w = wrapResponseWriter(logger, w, r)
+
+		query := r.URL.Query()
+		code := query.Get("businessFunctionCode")
+		if code != "" {
+			bfc := wire.NewBusinessFunctionCode()
+			bfc.BusinessFunctionCode = code
+			if err := bfc.Validate(); err != nil {
+				moovhttp.Problem(w, err)
+				return
+			}
+		}
+		now := time.Now()
+		if v := query.Get("time"); v != "" {
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				moovhttp.Problem(w, fmt.Errorf("invalid time: %v", err))
+				return
+			}
+			now = t
+		}
+
+		status := schedule.Status(code, now)
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(scheduleStatus{
+			BusinessFunctionCode: status.BusinessFunctionCode,
+			Time:                 status.Time,
+			SendToday:            status.SendToday,
+			Open:                 status.Open,
+			CycleDate:            status.CycleDate.Format(wire.CycleDateFormat),
+			Opens:                status.Opens,
+			Cutoff:               status.Cutoff,
+			SecondsUntilCutoff:   int64(status.UntilCutoff / time.Second),
+		})
+	}
+}
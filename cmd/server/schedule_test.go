@@ -0,0 +1,91 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/moov-io/base/log"
+	"github.com/moov-io/wire"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchedule_getSchedule(t *testing.T) {
+	router := mux.NewRouter()
+	addScheduleRoutes(log.NewNopLogger(), router, wire.DefaultSchedule())
+
+	t.Run("customer transfer after cutoff", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", "/schedule?businessFunctionCode=CTR&time=2023-07-05T18:30:00-04:00", nil)
+
+		router.ServeHTTP(w, req)
+		w.Flush()
+
+		require.Equal(t, http.StatusOK, w.Code, w.Body)
+		var status scheduleStatus
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&status))
+		require.Equal(t, "CTR", status.BusinessFunctionCode)
+		require.False(t, status.SendToday)
+		require.False(t, status.Open)
+		require.Equal(t, "20230706", status.CycleDate)
+		require.True(t, status.Cutoff.Equal(time.Date(2023, time.July, 6, 22, 0, 0, 0, time.UTC)))
+		require.Equal(t, int64(23*60*60+30*60), status.SecondsUntilCutoff)
+	})
+
+	t.Run("bank transfer before close", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", "/schedule?businessFunctionCode=BTR&time=2023-07-05T18:30:00-04:00", nil)
+
+		router.ServeHTTP(w, req)
+		w.Flush()
+
+		require.Equal(t, http.StatusOK, w.Code, w.Body)
+		var status scheduleStatus
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&status))
+		require.True(t, status.SendToday)
+		require.True(t, status.Open)
+		require.Equal(t, "20230705", status.CycleDate)
+		require.Equal(t, int64(30*60), status.SecondsUntilCutoff)
+	})
+
+	t.Run("now", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", "/schedule", nil)
+
+		router.ServeHTTP(w, req)
+		w.Flush()
+
+		require.Equal(t, http.StatusOK, w.Code, w.Body)
+		var status scheduleStatus
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&status))
+		require.Equal(t, wire.NextCycleDate(status.Time).Format(wire.CycleDateFormat), status.CycleDate)
+	})
+
+	t.Run("invalid business function code", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", "/schedule?businessFunctionCode=ZZZ", nil)
+
+		router.ServeHTTP(w, req)
+		w.Flush()
+
+		assert.Equal(t, http.StatusBadRequest, w.Code, w.Body)
+	})
+
+	t.Run("invalid time", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", "/schedule?time=2023-07-05", nil)
+
+		router.ServeHTTP(w, req)
+		w.Flush()
+
+		assert.Equal(t, http.StatusBadRequest, w.Code, w.Body)
+	})
+}
@@ -147,6 +147,36 @@ const (
 	// MessageDuplicationResend designates a resend of a message
 	MessageDuplicationResend = "P"
 
+	// MessageStatusIndicator
+
+	// MessageStatusInProcess is an outgoing message in process or intercepted
+	MessageStatusInProcess = "0"
+	// MessageStatusSuccessfulValue is an outgoing message successful with accounting (value)
+	MessageStatusSuccessfulValue = "2"
+	// MessageStatusRejected is an outgoing message rejected due to an error condition
+	MessageStatusRejected = "3"
+	// MessageStatusSuccessfulNonValue is an outgoing message successful without accounting (non-value)
+	MessageStatusSuccessfulNonValue = "7"
+	// MessageStatusIncomingValue is an incoming message successful with accounting (value)
+	MessageStatusIncomingValue = "N"
+	// MessageStatusIncomingNonValue is an incoming message successful without accounting (non-value)
+	MessageStatusIncomingNonValue = "S"
+
+	// ErrorCategory
+
+	// ErrorCategoryData is a data error
+	ErrorCategoryData = "E"
+	// ErrorCategoryInsufficientBalance is an insufficient balance
+	ErrorCategoryInsufficientBalance = "F"
+	// ErrorCategoryAccountability is an accountability error
+	ErrorCategoryAccountability = "H"
+	// ErrorCategoryInProcess is a message in process or intercepted
+	ErrorCategoryInProcess = "I"
+	// ErrorCategoryCutoffHour is a cutoff hour error
+	ErrorCategoryCutoffHour = "W"
+	// ErrorCategoryDuplicateIMAD is a duplicate IMAD
+	ErrorCategoryDuplicateIMAD = "X"
+
 	// TypeCode
 
 	// FundsTransfer is SenderSuppliedInformation {1510} TypeCode which designates a funds transfer in which the
@@ -4,6 +4,8 @@
 
 package wire
 
+import "strings"
+
 // CoverPayment is cover payment data
 type CoverPayment struct {
 	// SwiftFieldTag
@@ -21,3 +23,40 @@ type CoverPayment struct {
 	// SwiftLineSix
 	SwiftLineSix string `json:"swiftLineSix,omitempty"`
 }
+
+// validateSwiftParty checks the party identifier and BIC of the SWIFT field of a cover payment. A party
+// identifier line, starting with /, is checked when it ends in an IBAN and the BIC of option A, such as 52A, must
+// be a valid BIC.
+func (v *validator) validateSwiftParty(cp CoverPayment) error {
+	optionA := false
+	if tag := strings.Trim(cp.SwiftFieldTag, ": "); len(tag) == 3 && tag[2] == 'A' {
+		optionA = tag[0] >= '0' && tag[0] <= '9' && tag[1] >= '0' && tag[1] <= '9'
+	}
+	lines := []struct{ name, value string }{
+		{"SwiftLineOne", cp.SwiftLineOne},
+		{"SwiftLineTwo", cp.SwiftLineTwo},
+		{"SwiftLineThree", cp.SwiftLineThree},
+		{"SwiftLineFour", cp.SwiftLineFour},
+		{"SwiftLineFive", cp.SwiftLineFive},
+	}
+	for _, line := range lines {
+		value := strings.TrimSpace(line.value)
+		switch {
+		case value == "":
+		case strings.HasPrefix(value, "/"):
+			// e.g. /DE89370400440532013000 or /D/DE89370400440532013000
+			if account := value[strings.LastIndex(value, "/")+1:]; looksLikeIBAN(account) {
+				if err := v.isIBAN(account); err != nil {
+					return fieldError(line.name, err, line.value)
+				}
+			}
+		case optionA:
+			// the BIC follows the party identifier and is the last line of option A
+			if err := v.isBIC(value); err != nil {
+				return fieldError(line.name, err, line.value)
+			}
+			return nil
+		}
+	}
+	return nil
+}
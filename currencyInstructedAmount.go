@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // CurrencyInstructedAmount is the currency instructed amount
@@ -103,16 +105,17 @@ func (cia *CurrencyInstructedAmount) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on CurrencyInstructedAmount and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (cia *CurrencyInstructedAmount) Validate() error {
+	var errs base.ErrorList
 	if cia.tag != TagCurrencyInstructedAmount {
-		return fieldError("tag", ErrValidTagForType, cia.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, cia.tag))
 	}
 	if err := cia.isAlphanumeric(cia.SwiftFieldTag); err != nil {
-		return fieldError("SwiftFieldTag", err, cia.SwiftFieldTag)
+		errs.Add(fieldError("SwiftFieldTag", err, cia.SwiftFieldTag))
 	}
 	if err := cia.isAmount(cia.Amount); err != nil {
-		return fieldError("Amount", err, cia.Amount)
+		errs.Add(fieldError("Amount", err, cia.Amount))
 	}
-	return nil
+	return cia.collected(errs)
 }
 
 // SwiftFieldTagField gets a string of the SwiftFieldTag field
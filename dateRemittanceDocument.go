@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // DateRemittanceDocument is the date of remittance document
@@ -70,16 +72,17 @@ func (drd *DateRemittanceDocument) String() string {
 // Validate performs WIRE format rule checks on DateRemittanceDocument and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (drd *DateRemittanceDocument) Validate() error {
+	var errs base.ErrorList
 	if err := drd.fieldInclusion(); err != nil {
-		return err
+		errs.Add(err)
 	}
 	if drd.tag != TagDateRemittanceDocument {
-		return fieldError("tag", ErrValidTagForType, drd.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, drd.tag))
 	}
 	if err := drd.validateDate(drd.DateRemittanceDocument); err != nil {
-		return err
+		errs.Add(err)
 	}
-	return nil
+	return drd.collected(errs)
 }
 
 // fieldInclusion validate mandatory fields. If fields are
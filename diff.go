@@ -0,0 +1,95 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Change is a field whose value differs between two FEDWireMessages
+type Change struct {
+	// Tag is the tag of the field, e.g. {4200}
+	Tag string `json:"tag"`
+	// Field is the path of the Go field within the FEDWireMessage, e.g. Beneficiary.Personal.Name
+	Field string `json:"field"`
+	// Old is the value of the field in the first message, empty if it isn't set
+	Old string `json:"old"`
+	// New is the value of the field in the second message, empty if it isn't set
+	New string `json:"new"`
+}
+
+// String returns the Change as a line of text, e.g. {4200} Beneficiary.Personal.Name: "Jane" => "John"
+func (c Change) String() string {
+	return fmt.Sprintf("%s %s: %q => %q", c.Tag, c.Field, c.Old, c.New)
+}
+
+// Diff compares a to b tag by tag, returning the fields whose values differ in the order of the tags in a
+// FEDWireMessage. Differences of formatting alone, such as the padding of fixed-length fields and the *
+// delimiters of variable-length ones, are ignored, as is a tag missing from one message but empty in the other.
+// A nil message is compared as one without tags.
+func Diff(a, b *FEDWireMessage) []Change {
+	if a == nil {
+		a = &FEDWireMessage{}
+	}
+	if b == nil {
+		b = &FEDWireMessage{}
+	}
+
+	var changes []Change
+	va, vb := reflect.ValueOf(a).Elem(), reflect.ValueOf(b).Elem()
+	t := va.Type()
+	for i := 0; i < t.NumField(); i++ {
+		tag, ok := messageTags[t.Field(i).Name]
+		if !ok {
+			continue
+		}
+		diffValues(va.Field(i), vb.Field(i), t.Field(i).Name, func(field, before, after string) {
+			changes = append(changes, Change{Tag: tag, Field: field, Old: before, New: after})
+		})
+	}
+	return changes
+}
+
+// diffValues calls changed with the path and values of each exported field within a and b, of the same type
+// and at path, whose value differs once formatting is removed
+func diffValues(a, b reflect.Value, path string, changed func(field, before, after string)) {
+	for a.Kind() == reflect.Ptr {
+		if a.IsNil() && b.IsNil() {
+			return
+		}
+		// a missing tag or field is compared as an empty one
+		if a.IsNil() {
+			a = reflect.New(a.Type().Elem())
+		}
+		if b.IsNil() {
+			b = reflect.New(b.Type().Elem())
+		}
+		a, b = a.Elem(), b.Elem()
+	}
+
+	if a.Kind() == reflect.Struct {
+		t := a.Type()
+		for i := 0; i < t.NumField(); i++ {
+			if !t.Field(i).IsExported() {
+				continue
+			}
+			diffValues(a.Field(i), b.Field(i), path+"."+t.Field(i).Name, changed)
+		}
+		return
+	}
+
+	before, after := diffValue(a), diffValue(b)
+	if before != after {
+		changed(path, before, after)
+	}
+}
+
+// diffValue returns v without the padding and delimiters of the Fedwire format
+func diffValue(v reflect.Value) string {
+	s := fmt.Sprint(v.Interface())
+	return strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(s), "*"))
+}
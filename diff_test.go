@@ -0,0 +1,63 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiff(t *testing.T) {
+	fd, err := os.Open(filepath.Join("test", "testdata", "fedWireMessage-CustomerTransfer.txt"))
+	require.NoError(t, err)
+	defer fd.Close()
+	file, err := NewReader(fd).Read()
+	require.NoError(t, err)
+	a := &file.FEDWireMessages[0]
+
+	// the same message written with variable-length fields has padding and delimiters of its own
+	var buf bytes.Buffer
+	require.NoError(t, NewWriter(&buf, VariableLengthFields(true)).Write(&file))
+	echoed, err := NewReader(&buf).Read()
+	require.NoError(t, err)
+	require.Empty(t, Diff(a, &echoed.FEDWireMessages[0]))
+	require.Empty(t, Diff(a, a))
+
+	b := echoed.FEDWireMessages[0]
+	beneficiary := *b.Beneficiary
+	beneficiary.Personal.Name = "John Doe"
+	b.Beneficiary = &beneficiary
+	b.SenderReference = nil
+
+	changes := Diff(a, &b)
+	require.Equal(t, []Change{
+		{Tag: TagSenderReference, Field: "SenderReference.SenderReference", Old: "Sender Reference"},
+		{Tag: TagBeneficiary, Field: "Beneficiary.Personal.Name", Old: a.Beneficiary.Personal.Name, New: "John Doe"},
+	}, changes)
+	require.Equal(t, `{3320} SenderReference.SenderReference: "Sender Reference" => ""`, changes[0].String())
+
+	bs, err := json.Marshal(changes[1])
+	require.NoError(t, err)
+	require.JSONEq(t, `{"tag":"{4200}","field":"Beneficiary.Personal.Name","old":"Name","new":"John Doe"}`, string(bs))
+}
+
+func TestDiff_Nil(t *testing.T) {
+	fwm := mockCustomerTransferData()
+	require.Empty(t, Diff(nil, nil))
+	require.Empty(t, Diff(nil, &FEDWireMessage{}))
+
+	changes := Diff(nil, &fwm)
+	require.NotEmpty(t, changes)
+	require.Equal(t, TagSenderSupplied, changes[0].Tag)
+	for _, c := range changes {
+		require.Empty(t, c.Old)
+		require.NotEmpty(t, c.New)
+	}
+}
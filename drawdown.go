@@ -0,0 +1,123 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+// BuildDrawdownPayment returns a Builder for the DrawdownResponse (DRW, sub type 32) honoring req, a received
+// CustomerCorporateDrawdownRequest or BankDrawDownRequest (sub type 31). The payment goes back from req's
+// receiver to its sender for req's amount and its {3500} PreviousMessageIdentifier is req's IMAD, or OMAD when
+// req has no IMAD.
+//
+// The {4400} AccountDebitedDrawdown of req becomes the payment's {5000} Originator. req's {4200} Beneficiary,
+// or a demand deposit account of its {5400} AccountCreditedDrawdown when there's none, becomes the
+// beneficiary. req's beneficiary financial institutions, {4320} BeneficiaryReference and {6000}
+// OriginatorToBeneficiary are carried over.
+//
+// As with the NewX constructors the IMAD of the payment itself must be set before calling Build.
+func BuildDrawdownPayment(req *FEDWireMessage) (*Builder, error) {
+	if err := checkDrawdownRequest(req); err != nil {
+		return nil, err
+	}
+	b, err := reply(req, DrawdownResponse, FundsTransferRequestCredit)
+	if err != nil {
+		return nil, err
+	}
+
+	debit := req.AccountDebitedDrawdown
+	b.fwm.Originator = NewOriginator()
+	b.fwm.Originator.Personal = Personal{
+		IdentificationCode: debit.IdentificationCode,
+		Identifier:         debit.Identifier,
+		Name:               debit.Name,
+		Address:            debit.Address,
+	}
+	b.fwm.Beneficiary = NewBeneficiary()
+	if ben := req.Beneficiary; ben != nil {
+		b.fwm.Beneficiary.Personal = ben.Personal
+	} else {
+		b.fwm.Beneficiary.Personal = Personal{
+			IdentificationCode: DemandDepositAccountNumber,
+			Identifier:         req.AccountCreditedDrawdown.DrawdownCreditAccountNumber,
+		}
+	}
+
+	if fi := req.BeneficiaryIntermediaryFI; fi != nil {
+		bifi := *fi
+		b.fwm.BeneficiaryIntermediaryFI = &bifi
+	}
+	if fi := req.BeneficiaryFI; fi != nil {
+		bfi := *fi
+		b.fwm.BeneficiaryFI = &bfi
+	}
+	if ref := req.BeneficiaryReference; ref != nil {
+		br := *ref
+		b.fwm.BeneficiaryReference = &br
+	}
+	if ob := req.OriginatorToBeneficiary; ob != nil {
+		obi := *ob
+		b.fwm.OriginatorToBeneficiary = &obi
+	}
+	return b, nil
+}
+
+// BuildDrawdownRefusal returns a Builder for the refusal (sub type 33) of req, a received
+// CustomerCorporateDrawdownRequest or BankDrawDownRequest (sub type 31). The refusal has req's business
+// function code, amount, {4400} AccountDebitedDrawdown, {5400} AccountCreditedDrawdown and {4200} Beneficiary,
+// goes back from req's receiver to its sender and identifies req in {3500} PreviousMessageIdentifier. reason,
+// if any, is written to {6500} FIAdditionalFIToFI.
+func BuildDrawdownRefusal(req *FEDWireMessage, reason string) (*Builder, error) {
+	if err := checkDrawdownRequest(req); err != nil {
+		return nil, err
+	}
+	b, err := reply(req, req.BusinessFunctionCode.BusinessFunctionCode, RefusalRequestCredit)
+	if err != nil {
+		return nil, err
+	}
+
+	debit := *req.AccountDebitedDrawdown
+	b.fwm.AccountDebitedDrawdown = &debit
+	credit := *req.AccountCreditedDrawdown
+	b.fwm.AccountCreditedDrawdown = &credit
+	if ben := req.Beneficiary; ben != nil {
+		b.fwm.Beneficiary = NewBeneficiary()
+		b.fwm.Beneficiary.Personal = ben.Personal
+	}
+
+	lines, err := reasonLines("FIAdditionalFIToFI", reason, 6)
+	if err != nil {
+		return nil, err
+	}
+	if lines != nil {
+		fi := NewFIAdditionalFIToFI()
+		fi.AdditionalFIToFI = AdditionalFIToFI{
+			LineOne: lines[0], LineTwo: lines[1], LineThree: lines[2],
+			LineFour: lines[3], LineFive: lines[4], LineSix: lines[5],
+		}
+		b.fwm.FIAdditionalFIToFI = fi
+	}
+	return b, nil
+}
+
+// checkDrawdownRequest returns an error unless req is a drawdown request with the accounts a response is
+// built from
+func checkDrawdownRequest(req *FEDWireMessage) error {
+	if err := requireReplyTags(req); err != nil {
+		return err
+	}
+	switch bfc := req.BusinessFunctionCode.BusinessFunctionCode; bfc {
+	case CustomerCorporateDrawdownRequest, BankDrawDownRequest:
+	default:
+		return fieldError("BusinessFunctionCode", ErrNotDrawdownRequest, bfc)
+	}
+	if req.TypeSubType.SubTypeCode != RequestCredit {
+		return fieldError("TypeSubType", ErrNotDrawdownRequest, req.TypeSubType.TypeCode+req.TypeSubType.SubTypeCode)
+	}
+	if req.AccountDebitedDrawdown == nil {
+		return fieldError("AccountDebitedDrawdown", ErrFieldRequired)
+	}
+	if req.AccountCreditedDrawdown == nil {
+		return fieldError("AccountCreditedDrawdown", ErrFieldRequired)
+	}
+	return nil
+}
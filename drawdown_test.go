@@ -0,0 +1,99 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// requireValidFile checks fwm passes File.Validate
+func requireValidFile(t *testing.T, fwm *FEDWireMessage) {
+	t.Helper()
+
+	file := NewFile()
+	file.AddFEDWireMessage(*fwm)
+	require.NoError(t, file.Validate())
+}
+
+func TestBuildDrawdownPayment(t *testing.T) {
+	req := readTestMessage(t, "fedWireMessage-CustomerCorporateDrawDownRequest.txt")
+
+	b, err := BuildDrawdownPayment(req)
+	require.NoError(t, err)
+	fwm, err := b.IMAD("20190410", "Source08", "000002").Build()
+	require.NoError(t, err)
+
+	require.Equal(t, FundsTransfer+FundsTransferRequestCredit, fwm.TypeSubType.TypeCode+fwm.TypeSubType.SubTypeCode)
+	require.Equal(t, DrawdownResponse, fwm.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, req.Amount.Amount, fwm.Amount.Amount)
+	require.Equal(t, "231380104", fwm.SenderDepositoryInstitution.SenderABANumber)
+	require.Equal(t, "121042882", fwm.ReceiverDepositoryInstitution.ReceiverABANumber)
+	require.Equal(t, "20190410Source08000001", fwm.PreviousMessageIdentifier.PreviousMessageIdentifier)
+	require.Equal(t, "debitDD Name", fwm.Originator.Personal.Name)
+	require.Equal(t, "123456789", fwm.Originator.Personal.Identifier)
+	require.Equal(t, req.Beneficiary.Personal, fwm.Beneficiary.Personal)
+	require.Equal(t, req.BeneficiaryFI.FinancialInstitution, fwm.BeneficiaryFI.FinancialInstitution)
+	require.Equal(t, "LineOne", fwm.OriginatorToBeneficiary.LineOne)
+	require.Nil(t, fwm.AccountDebitedDrawdown)
+	requireValidFile(t, fwm)
+
+	// the payment doesn't share tags with the request
+	fwm.OriginatorToBeneficiary.LineOne = "Changed"
+	require.Equal(t, "LineOne", req.OriginatorToBeneficiary.LineOne)
+}
+
+func TestBuildDrawdownPayment_bankDrawdown(t *testing.T) {
+	req := readTestMessage(t, "fedWireMessage-BankDrawDownRequest.txt")
+	req.Beneficiary = nil
+	req.BeneficiaryIntermediaryFI = nil
+
+	b, err := BuildDrawdownPayment(req)
+	require.NoError(t, err)
+	fwm, err := b.IMAD("20190410", "Source08", "000002").Build()
+	require.NoError(t, err)
+
+	require.Equal(t, SettlementTransfer+FundsTransferRequestCredit, fwm.TypeSubType.TypeCode+fwm.TypeSubType.SubTypeCode)
+	require.Equal(t, Personal{IdentificationCode: DemandDepositAccountNumber, Identifier: "123456789"}, fwm.Beneficiary.Personal)
+	requireValidFile(t, fwm)
+}
+
+func TestBuildDrawdownRefusal(t *testing.T) {
+	for _, name := range []string{"fedWireMessage-CustomerCorporateDrawDownRequest.txt", "fedWireMessage-BankDrawDownRequest.txt"} {
+		req := readTestMessage(t, name)
+
+		b, err := BuildDrawdownRefusal(req, "Insufficient funds")
+		require.NoError(t, err)
+		fwm, err := b.IMAD("20190410", "Source08", "000002").Build()
+		require.NoError(t, err, name)
+
+		require.Equal(t, req.TypeSubType.TypeCode+RefusalRequestCredit, fwm.TypeSubType.TypeCode+fwm.TypeSubType.SubTypeCode)
+		require.Equal(t, req.BusinessFunctionCode.BusinessFunctionCode, fwm.BusinessFunctionCode.BusinessFunctionCode)
+		require.Equal(t, "20190410Source08000001", fwm.PreviousMessageIdentifier.PreviousMessageIdentifier)
+		require.Equal(t, "debitDD Name", fwm.AccountDebitedDrawdown.Name)
+		require.Equal(t, "123456789", fwm.AccountCreditedDrawdown.DrawdownCreditAccountNumber)
+		require.Equal(t, "Insufficient funds", fwm.FIAdditionalFIToFI.AdditionalFIToFI.LineOne)
+		requireValidFile(t, fwm)
+	}
+}
+
+func TestBuildDrawdown_notRequest(t *testing.T) {
+	_, err := BuildDrawdownPayment(readTestMessage(t, "fedWireMessage-CustomerTransfer.txt"))
+	require.ErrorIs(t, err, ErrNotDrawdownRequest)
+
+	_, err = BuildDrawdownRefusal(readTestMessage(t, "fedWireMessage-DrawdownResponse.txt"), "")
+	require.ErrorIs(t, err, ErrNotDrawdownRequest)
+
+	req := readTestMessage(t, "fedWireMessage-CustomerCorporateDrawDownRequest.txt")
+	req.TypeSubType.SubTypeCode = RefusalRequestCredit
+	_, err = BuildDrawdownRefusal(req, "")
+	require.ErrorIs(t, err, ErrNotDrawdownRequest)
+
+	req = readTestMessage(t, "fedWireMessage-CustomerCorporateDrawDownRequest.txt")
+	req.AccountDebitedDrawdown = nil
+	_, err = BuildDrawdownPayment(req)
+	require.ErrorIs(t, err, ErrFieldRequired)
+}
@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // ErrorWire is a wire error with the fedwire message
@@ -22,7 +24,7 @@ type ErrorWire struct {
 	ErrorDescription string `json:"errorDescription,omitempty"`
 
 	// validator is composed for data validation
-	// validator
+	validator
 	// converters is composed for WIRE to GoLang Converters
 	converters
 }
@@ -116,8 +118,20 @@ func (ew *ErrorWire) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on ErrorWire and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (ew *ErrorWire) Validate() error {
-	// Currently no validation as the FED is responsible for the values
-	return nil
+	var errs base.ErrorList
+	if ew.tag != TagErrorWire {
+		errs.Add(fieldError("tag", ErrValidTagForType, ew.tag))
+	}
+	if err := ew.isErrorCategory(ew.ErrorCategory); err != nil {
+		errs.Add(fieldError("ErrorCategory", err, ew.ErrorCategory))
+	}
+	if err := ew.isAlphanumeric(ew.ErrorCode); err != nil {
+		errs.Add(fieldError("ErrorCode", err, ew.ErrorCode))
+	}
+	if err := ew.isAlphanumeric(ew.ErrorDescription); err != nil {
+		errs.Add(fieldError("ErrorDescription", err, ew.ErrorDescription))
+	}
+	return ew.collected(errs)
 }
 
 // ErrorCategoryField gets a string of the ErrorCategory field
@@ -0,0 +1,202 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"io"
+	"sort"
+	"sync"
+)
+
+var (
+	// ErrErrorWireCode is the error given when registering an ErrorWire code without a code or twice
+	ErrErrorWireCode = errors.New("must be a unique error code of its category")
+	// ErrResendGuidance is the error given when registering an ErrorWire code whose MessageDuplicationCode
+	// contradicts ResendAllowed
+	ErrResendGuidance = errors.New("must be set if and only if a resend is allowed")
+)
+
+// ErrorWireDetails describes an ErrorWire appended by the Fed to a rejected or intercepted message, and how the
+// sender can answer it
+type ErrorWireDetails struct {
+	// ErrorCategory is the category of the error, see ErrorCategoryData
+	ErrorCategory string `json:"errorCategory"`
+	// CategoryDescription describes the ErrorCategory
+	CategoryDescription string `json:"categoryDescription"`
+	// ErrorCode is the code of the error within its category
+	ErrorCode string `json:"errorCode,omitempty"`
+	// Description describes the error
+	Description string `json:"description,omitempty"`
+	// ResendAllowed is true if the message may be sent again once corrected
+	ResendAllowed bool `json:"resendAllowed"`
+	// MessageDuplicationCode is the MessageDuplicationCode to send the message again with, an original for a
+	// corrected message. It's empty if ResendAllowed is false.
+	MessageDuplicationCode string `json:"messageDuplicationCode,omitempty"`
+}
+
+// errorCategories are the details of each ErrorCategory, which apply to its codes unless registered otherwise
+var errorCategories = map[string]ErrorWireDetails{
+	ErrorCategoryData: {
+		CategoryDescription:    "Data error, the message failed the edits of the Fedwire Funds Service",
+		ResendAllowed:          true,
+		MessageDuplicationCode: MessageDuplicationOriginal,
+	},
+	ErrorCategoryInsufficientBalance: {
+		CategoryDescription:    "Insufficient balance, the sender's account couldn't cover the message",
+		ResendAllowed:          true,
+		MessageDuplicationCode: MessageDuplicationOriginal,
+	},
+	ErrorCategoryAccountability: {
+		CategoryDescription:    "Accountability error, the IMAD or sender of the message is invalid",
+		ResendAllowed:          true,
+		MessageDuplicationCode: MessageDuplicationOriginal,
+	},
+	ErrorCategoryInProcess: {
+		CategoryDescription: "In process or intercepted, the message is held and may still be processed",
+		ResendAllowed:       false,
+	},
+	ErrorCategoryCutoffHour: {
+		CategoryDescription:    "Cutoff hour error, the message arrived after the cutoff of its business function",
+		ResendAllowed:          true,
+		MessageDuplicationCode: MessageDuplicationOriginal,
+	},
+	ErrorCategoryDuplicateIMAD: {
+		CategoryDescription:    "Duplicate IMAD, a message with the same IMAD was already received",
+		ResendAllowed:          true,
+		MessageDuplicationCode: MessageDuplicationOriginal,
+	},
+}
+
+var (
+	errorWireCodesMu sync.RWMutex
+	errorWireCodes   = make(map[string]ErrorWireDetails)
+)
+
+// defaultErrorWireCodes are the ErrorWireDetails of the codes of the Fedwire Funds Service's error code list,
+// registered when the package loads. The list is kept in errorWireCodes.json, in the format read by
+// LoadErrorWireCodes, so that it can be updated as the Fed publishes changes to it.
+//
+//go:embed errorWireCodes.json
+var defaultErrorWireCodes []byte
+
+func init() {
+	if err := LoadErrorWireCodes(bytes.NewReader(defaultErrorWireCodes)); err != nil {
+		panic(err)
+	}
+}
+
+// RegisterErrorWireCode adds the details of an ErrorCode of an ErrorCategory to the catalog, such as the codes
+// of the Fedwire Funds Service's error code list. CategoryDescription is taken from the catalog.
+func RegisterErrorWireCode(details ErrorWireDetails) error {
+	errorWireCodesMu.Lock()
+	defer errorWireCodesMu.Unlock()
+	return registerErrorWireCode(details)
+}
+
+// LoadErrorWireCodes registers the ErrorWireDetails of the JSON array read from r, such as the Fedwire Funds
+// Service's error code list, with the same checks as RegisterErrorWireCode. No code is registered unless they
+// all are valid and new.
+func LoadErrorWireCodes(r io.Reader) error {
+	var codes []ErrorWireDetails
+	if err := json.NewDecoder(r).Decode(&codes); err != nil {
+		return err
+	}
+
+	errorWireCodesMu.Lock()
+	defer errorWireCodesMu.Unlock()
+	for i := range codes {
+		if err := registerErrorWireCode(codes[i]); err != nil {
+			for _, details := range codes[:i] {
+				delete(errorWireCodes, details.ErrorCategory+details.ErrorCode)
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// registerErrorWireCode adds details to the catalog, errorWireCodesMu being held
+func registerErrorWireCode(details ErrorWireDetails) error {
+	var v validator
+	if err := v.isErrorCategory(details.ErrorCategory); err != nil {
+		return fieldError("ErrorCategory", err, details.ErrorCategory)
+	}
+	if details.ErrorCode == "" {
+		return fieldError("ErrorCode", ErrErrorWireCode, details.ErrorCode)
+	}
+	if details.ResendAllowed != (details.MessageDuplicationCode != "") {
+		return fieldError("MessageDuplicationCode", ErrResendGuidance, details.MessageDuplicationCode)
+	}
+	if details.ResendAllowed {
+		if err := v.isMessageDuplicationCode(details.MessageDuplicationCode); err != nil {
+			return fieldError("MessageDuplicationCode", err, details.MessageDuplicationCode)
+		}
+	}
+	details.CategoryDescription = errorCategories[details.ErrorCategory].CategoryDescription
+
+	key := details.ErrorCategory + details.ErrorCode
+	if _, ok := errorWireCodes[key]; ok {
+		return fieldError("ErrorCode", ErrErrorWireCode, details.ErrorCode)
+	}
+	errorWireCodes[key] = details
+	return nil
+}
+
+// UnregisterErrorWireCode removes the details of an ErrorCode of an ErrorCategory from the catalog, if any
+func UnregisterErrorWireCode(category, code string) {
+	errorWireCodesMu.Lock()
+	defer errorWireCodesMu.Unlock()
+	delete(errorWireCodes, category+code)
+}
+
+// ErrorWireCode returns the registered details of an ErrorCode of an ErrorCategory, if any
+func ErrorWireCode(category, code string) (ErrorWireDetails, bool) {
+	errorWireCodesMu.RLock()
+	defer errorWireCodesMu.RUnlock()
+	details, ok := errorWireCodes[category+code]
+	return details, ok
+}
+
+// ErrorWireCatalog returns the details of every ErrorCategory and registered ErrorCode, ordered by category and
+// code with each category before its codes
+func ErrorWireCatalog() []ErrorWireDetails {
+	out := make([]ErrorWireDetails, 0, len(errorCategories))
+	for category, details := range errorCategories {
+		details.ErrorCategory = category
+		out = append(out, details)
+	}
+	errorWireCodesMu.RLock()
+	for _, details := range errorWireCodes {
+		out = append(out, details)
+	}
+	errorWireCodesMu.RUnlock()
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].ErrorCategory != out[j].ErrorCategory {
+			return out[i].ErrorCategory < out[j].ErrorCategory
+		}
+		return out[i].ErrorCode < out[j].ErrorCode
+	})
+	return out
+}
+
+// Details returns the catalog's details of the ErrorWire: those registered for its ErrorCode, or else those
+// of its ErrorCategory with the ErrorDescription appended by the Fed
+func (ew *ErrorWire) Details() (ErrorWireDetails, error) {
+	if err := ew.isErrorCategory(ew.ErrorCategory); err != nil {
+		return ErrorWireDetails{}, fieldError("ErrorCategory", err, ew.ErrorCategory)
+	}
+	if details, ok := ErrorWireCode(ew.ErrorCategory, ew.ErrorCode); ok {
+		return details, nil
+	}
+	details := errorCategories[ew.ErrorCategory]
+	details.ErrorCategory = ew.ErrorCategory
+	details.ErrorCode = ew.ErrorCode
+	details.Description = ew.ErrorDescription
+	return details, nil
+}
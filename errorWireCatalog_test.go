@@ -0,0 +1,155 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorWire_Details(t *testing.T) {
+	ew := mockErrorWire()
+
+	details, err := ew.Details()
+	require.NoError(t, err)
+	require.Equal(t, ErrorWireDetails{
+		ErrorCategory:          ErrorCategoryData,
+		CategoryDescription:    "Data error, the message failed the edits of the Fedwire Funds Service",
+		ErrorCode:              "XYZ",
+		Description:            "Data Error",
+		ResendAllowed:          true,
+		MessageDuplicationCode: MessageDuplicationOriginal,
+	}, details)
+
+	ew.ErrorCategory = ErrorCategoryInProcess
+	details, err = ew.Details()
+	require.NoError(t, err)
+	require.False(t, details.ResendAllowed)
+	require.Empty(t, details.MessageDuplicationCode)
+
+	ew.ErrorCategory = "1"
+	_, err = ew.Details()
+	require.ErrorIs(t, err, ErrErrorCategory)
+}
+
+func TestErrorWire_DetailsRegisteredCode(t *testing.T) {
+	require.NoError(t, RegisterErrorWireCode(ErrorWireDetails{
+		ErrorCategory: ErrorCategoryData,
+		ErrorCode:     "XYZ",
+		Description:   "Made up error",
+		ResendAllowed: false,
+	}))
+	t.Cleanup(func() { UnregisterErrorWireCode(ErrorCategoryData, "XYZ") })
+
+	details, err := mockErrorWire().Details()
+	require.NoError(t, err)
+	require.Equal(t, "Made up error", details.Description)
+	require.Equal(t, "Data error, the message failed the edits of the Fedwire Funds Service", details.CategoryDescription)
+	require.False(t, details.ResendAllowed)
+
+	// other categories don't share the code
+	ew := mockErrorWire()
+	ew.ErrorCategory = ErrorCategoryCutoffHour
+	details, err = ew.Details()
+	require.NoError(t, err)
+	require.Equal(t, "Data Error", details.Description)
+	require.True(t, details.ResendAllowed)
+
+	catalog := ErrorWireCatalog()
+	require.Len(t, catalog, 7)
+	require.Equal(t, ErrorCategoryData, catalog[0].ErrorCategory)
+	require.Empty(t, catalog[0].ErrorCode)
+	require.Equal(t, "XYZ", catalog[1].ErrorCode)
+	require.Equal(t, ErrorCategoryDuplicateIMAD, catalog[6].ErrorCategory)
+
+	for _, details := range catalog {
+		require.Equal(t, details.ResendAllowed, details.MessageDuplicationCode != "", details.ErrorCategory+details.ErrorCode)
+	}
+}
+
+func TestRegisterErrorWireCode(t *testing.T) {
+	details := ErrorWireDetails{
+		ErrorCategory:          ErrorCategoryData,
+		ErrorCode:              "ABC",
+		ResendAllowed:          true,
+		MessageDuplicationCode: MessageDuplicationOriginal,
+	}
+	require.NoError(t, RegisterErrorWireCode(details))
+	t.Cleanup(func() { UnregisterErrorWireCode(ErrorCategoryData, "ABC") })
+	require.ErrorIs(t, RegisterErrorWireCode(details), ErrErrorWireCode)
+
+	invalid := details
+	invalid.ErrorCategory = "1"
+	require.ErrorIs(t, RegisterErrorWireCode(invalid), ErrErrorCategory)
+
+	invalid = details
+	invalid.ErrorCode = ""
+	require.ErrorIs(t, RegisterErrorWireCode(invalid), ErrErrorWireCode)
+
+	invalid = details
+	invalid.ErrorCode = "DEF"
+	invalid.MessageDuplicationCode = "Z"
+	require.ErrorIs(t, RegisterErrorWireCode(invalid), ErrMessageDuplicationCode)
+
+	// the resend guidance must agree with ResendAllowed
+	invalid = details
+	invalid.ErrorCode = "DEF"
+	invalid.ResendAllowed = false
+	require.ErrorIs(t, RegisterErrorWireCode(invalid), ErrResendGuidance)
+
+	invalid.ResendAllowed = true
+	invalid.MessageDuplicationCode = ""
+	require.ErrorIs(t, RegisterErrorWireCode(invalid), ErrResendGuidance)
+}
+
+func TestDefaultErrorWireCodes(t *testing.T) {
+	var codes []ErrorWireDetails
+	require.NoError(t, json.Unmarshal(defaultErrorWireCodes, &codes))
+	for _, code := range codes {
+		details, ok := ErrorWireCode(code.ErrorCategory, code.ErrorCode)
+		require.True(t, ok, code.ErrorCategory+code.ErrorCode)
+		require.Equal(t, code.Description, details.Description)
+		require.NotEmpty(t, details.CategoryDescription)
+	}
+}
+
+func TestLoadErrorWireCodes(t *testing.T) {
+	codes := `[
+		{"errorCategory": "I", "errorCode": "DEF", "description": "Held for review"},
+		{"errorCategory": "E", "errorCode": "DEF", "description": "Invalid amount", "resendAllowed": true, "messageDuplicationCode": " "}
+	]`
+	require.NoError(t, LoadErrorWireCodes(strings.NewReader(codes)))
+	t.Cleanup(func() {
+		UnregisterErrorWireCode(ErrorCategoryInProcess, "DEF")
+		UnregisterErrorWireCode(ErrorCategoryData, "DEF")
+	})
+
+	details, ok := ErrorWireCode(ErrorCategoryInProcess, "DEF")
+	require.True(t, ok)
+	require.Equal(t, "Held for review", details.Description)
+	require.Equal(t, "In process or intercepted, the message is held and may still be processed", details.CategoryDescription)
+	require.False(t, details.ResendAllowed)
+
+	ew := mockErrorWire()
+	ew.ErrorCode = "DEF"
+	details, err := ew.Details()
+	require.NoError(t, err)
+	require.Equal(t, "Invalid amount", details.Description)
+	require.Equal(t, MessageDuplicationOriginal, details.MessageDuplicationCode)
+
+	// none of the codes are registered if one is invalid
+	codes = `[
+		{"errorCategory": "E", "errorCode": "GHI", "resendAllowed": true, "messageDuplicationCode": " "},
+		{"errorCategory": "E", "errorCode": "DEF", "resendAllowed": true, "messageDuplicationCode": " "}
+	]`
+	require.ErrorIs(t, LoadErrorWireCodes(strings.NewReader(codes)), ErrErrorWireCode)
+	_, ok = ErrorWireCode(ErrorCategoryData, "GHI")
+	require.False(t, ok)
+
+	require.Error(t, LoadErrorWireCodes(strings.NewReader("{")))
+}
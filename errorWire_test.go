@@ -27,21 +27,21 @@ func TestMockErrorWire(t *testing.T) {
 
 // TestParseErrorWire parses a known ErrorWire  record string
 func TestParseErrorWire(t *testing.T) {
-	var line = "{1130}1XYZData Error                         *"
+	var line = "{1130}EXYZData Error                         *"
 	r := NewReader(strings.NewReader(line))
 	r.line = line
 
 	require.NoError(t, r.parseErrorWire())
 	record := r.currentFEDWireMessage.ErrorWire
 
-	assert.Equal(t, "1", record.ErrorCategory)
+	assert.Equal(t, "E", record.ErrorCategory)
 	assert.Equal(t, "XYZ", record.ErrorCode)
 	assert.Equal(t, "Data Error", record.ErrorDescription)
 }
 
 // TestWriteErrorWire writes a ErrorWire record string
 func TestWriteErrorWire(t *testing.T) {
-	var line = "{1130}1XYZData Error                         *"
+	var line = "{1130}EXYZData Error                         *"
 	r := NewReader(strings.NewReader(line))
 	r.line = line
 	require.NoError(t, r.parseErrorWire())
@@ -57,23 +57,23 @@ func TestStringErrorWireAmountVariableLength(t *testing.T) {
 	r.line = line
 
 	err := r.parseErrorWire()
-	require.NoError(t, err)
+	require.ErrorContains(t, err, ErrErrorCategory.Error())
 
-	line = "{1130}1XYZData Error                         NNN"
+	line = "{1130}EXYZData Error                         NNN"
 	r = NewReader(strings.NewReader(line))
 	r.line = line
 
 	err = r.parseErrorWire()
 	require.ErrorContains(t, err, ErrRequireDelimiter.Error())
 
-	line = "{1130}1XYZData Error***"
+	line = "{1130}EXYZData Error***"
 	r = NewReader(strings.NewReader(line))
 	r.line = line
 
 	err = r.parseErrorWire()
 	require.ErrorContains(t, err, r.parseError(NewTagMaxLengthErr(errors.New(""))).Error())
 
-	line = "{1130}1XYZData Error*"
+	line = "{1130}EXYZData Error*"
 	r = NewReader(strings.NewReader(line))
 	r.line = line
 
@@ -83,7 +83,7 @@ func TestStringErrorWireAmountVariableLength(t *testing.T) {
 
 // TestStringErrorWireOptions validates Format() formatted according to the FormatOptions
 func TestStringErrorWireOptions(t *testing.T) {
-	var line = "{1130}1XYZData Error*"
+	var line = "{1130}EXYZData Error*"
 	r := NewReader(strings.NewReader(line))
 	r.line = line
 
@@ -91,7 +91,35 @@ func TestStringErrorWireOptions(t *testing.T) {
 	require.NoError(t, err)
 
 	record := r.currentFEDWireMessage.ErrorWire
-	require.Equal(t, "{1130}1XYZData Error                         *", record.String())
-	require.Equal(t, "{1130}1XYZData Error*", record.Format(FormatOptions{VariableLengthFields: true}))
+	require.Equal(t, "{1130}EXYZData Error                         *", record.String())
+	require.Equal(t, "{1130}EXYZData Error*", record.Format(FormatOptions{VariableLengthFields: true}))
 	require.Equal(t, record.String(), record.Format(FormatOptions{VariableLengthFields: false}))
 }
+
+// TestErrorWireErrorCategory validates ErrorWire ErrorCategory
+func TestErrorWireErrorCategory(t *testing.T) {
+	ew := mockErrorWire()
+	for _, category := range []string{"E", "F", "H", "I", "W", "X"} {
+		ew.ErrorCategory = category
+		require.NoError(t, ew.Validate())
+	}
+
+	ew.ErrorCategory = "1"
+	require.EqualError(t, ew.Validate(), fieldError("ErrorCategory", ErrErrorCategory, ew.ErrorCategory).Error())
+}
+
+// TestErrorWireTagError validates ErrorWire tag
+func TestErrorWireTagError(t *testing.T) {
+	ew := mockErrorWire()
+	ew.tag = "{9999}"
+
+	require.EqualError(t, ew.Validate(), fieldError("tag", ErrValidTagForType, ew.tag).Error())
+}
+
+// TestErrorWireErrorDescriptionAlphaNumeric validates ErrorWire ErrorDescription is alphanumeric
+func TestErrorWireErrorDescriptionAlphaNumeric(t *testing.T) {
+	ew := mockErrorWire()
+	ew.ErrorDescription = "®"
+
+	require.EqualError(t, ew.Validate(), fieldError("ErrorDescription", ErrNonAlphanumeric, ew.ErrorDescription).Error())
+}
@@ -32,7 +32,7 @@ func main() {
 
 	// InputMessageAccountabilityData
 	imad := wire.NewInputMessageAccountabilityData()
-	imad.InputCycleDate = time.Now().Format("20060102")
+	imad.InputCycleDate = wire.NextCycleDate(time.Now()).Format(wire.CycleDateFormat)
 	imad.InputSource = "Source08"
 	imad.InputSequenceNumber = "000001"
 	fwm.InputMessageAccountabilityData = imad
@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // ExchangeRate is the ExchangeRate of the wire
@@ -97,13 +99,14 @@ func (eRate *ExchangeRate) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on ExchangeRate and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (eRate *ExchangeRate) Validate() error {
+	var errs base.ErrorList
 	if eRate.tag != TagExchangeRate {
-		return fieldError("tag", ErrValidTagForType, eRate.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, eRate.tag))
 	}
 	if err := eRate.isAmount(eRate.ExchangeRate); err != nil {
-		return fieldError("ExchangeRate", err, eRate.ExchangeRate)
+		errs.Add(fieldError("ExchangeRate", err, eRate.ExchangeRate))
 	}
-	return nil
+	return eRate.collected(errs)
 }
 
 // ExchangeRateField gets a string of the ExchangeRate field
@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // FIBeneficiaryFIAdvice is the financial institution beneficiary financial institution
@@ -138,31 +140,32 @@ func (fibfia *FIBeneficiaryFIAdvice) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on FIBeneficiaryFIAdvice and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (fibfia *FIBeneficiaryFIAdvice) Validate() error {
+	var errs base.ErrorList
 	if fibfia.tag != TagFIBeneficiaryFIAdvice {
-		return fieldError("tag", ErrValidTagForType, fibfia.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, fibfia.tag))
 	}
 	if err := fibfia.isAdviceCode(fibfia.Advice.AdviceCode); err != nil {
-		return fieldError("AdviceCode", err, fibfia.Advice.AdviceCode)
+		errs.Add(fieldError("AdviceCode", err, fibfia.Advice.AdviceCode))
 	}
 	if err := fibfia.isAlphanumeric(fibfia.Advice.LineOne); err != nil {
-		return fieldError("LineOne", err, fibfia.Advice.LineOne)
+		errs.Add(fieldError("LineOne", err, fibfia.Advice.LineOne))
 	}
 	if err := fibfia.isAlphanumeric(fibfia.Advice.LineTwo); err != nil {
-		return fieldError("LineTwo", err, fibfia.Advice.LineTwo)
+		errs.Add(fieldError("LineTwo", err, fibfia.Advice.LineTwo))
 	}
 	if err := fibfia.isAlphanumeric(fibfia.Advice.LineThree); err != nil {
-		return fieldError("LineThree", err, fibfia.Advice.LineThree)
+		errs.Add(fieldError("LineThree", err, fibfia.Advice.LineThree))
 	}
 	if err := fibfia.isAlphanumeric(fibfia.Advice.LineFour); err != nil {
-		return fieldError("LineFour", err, fibfia.Advice.LineFour)
+		errs.Add(fieldError("LineFour", err, fibfia.Advice.LineFour))
 	}
 	if err := fibfia.isAlphanumeric(fibfia.Advice.LineFive); err != nil {
-		return fieldError("LineFive", err, fibfia.Advice.LineFive)
+		errs.Add(fieldError("LineFive", err, fibfia.Advice.LineFive))
 	}
 	if err := fibfia.isAlphanumeric(fibfia.Advice.LineSix); err != nil {
-		return fieldError("LineSix", err, fibfia.Advice.LineSix)
+		errs.Add(fieldError("LineSix", err, fibfia.Advice.LineSix))
 	}
-	return nil
+	return fibfia.collected(errs)
 }
 
 // AdviceCodeField gets a string of the AdviceCode field
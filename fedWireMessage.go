@@ -4,7 +4,12 @@
 
 package wire
 
-import "strings"
+import (
+	"errors"
+	"strings"
+
+	"github.com/moov-io/base"
+)
 
 // FEDWireMessage is a FedWire Message
 type FEDWireMessage struct {
@@ -135,75 +140,343 @@ type FEDWireMessage struct {
 	ValidateOptions *ValidateOpts `json:"validateOptions,omitempty"`
 }
 
-func (fwm *FEDWireMessage) requireSenderSupplied() bool {
-	opts := &ValidateOpts{}
+// validation returns the message's ValidateOpts, or the defaults when it has none
+func (fwm *FEDWireMessage) validation() *ValidateOpts {
 	if fwm != nil && fwm.ValidateOptions != nil {
-		opts = fwm.ValidateOptions
+		return fwm.ValidateOptions
+	}
+	return &ValidateOpts{}
+}
+
+// validateTag returns tag's Validate error, skipping the checks the message's ValidateOpts skip. The error is
+// located within the message when tag is one of its tags.
+func (fwm *FEDWireMessage) validateTag(tag tagValidator) error {
+	err := fwm.validation().validateTag(tag)
+	if err == nil {
+		return nil
+	}
+	for _, t := range fwm.presentTags() {
+		if t.tag == tag {
+			return newValidationError(t.field, err)
+		}
 	}
-	return !opts.AllowMissingSenderSupplied
+	return err
+}
+
+func (fwm *FEDWireMessage) requireSenderSupplied() bool {
+	return !fwm.validation().AllowMissingSenderSupplied
 }
 
 // verify checks basic WIRE rules. Assumes properly parsed records. Each validation func should
 // check for the expected relationships between fields within a FedWireMessage.
 func (fwm *FEDWireMessage) verify() error {
-
-	if err := fwm.mandatoryFields(); err != nil {
-		return err
+	if errs := fwm.validate(false); !errs.Empty() {
+		return errs[0]
 	}
+	return nil
+}
 
-	if err := fwm.otherTransferInformation(); err != nil {
-		return err
-	}
-	if err := fwm.validateBeneficiaryIntermediaryFI(); err != nil {
-		return err
-	}
-	if err := fwm.validateBeneficiaryFI(); err != nil {
-		return err
-	}
-	if err := fwm.validateOriginatorFI(); err != nil {
-		return err
-	}
-	if err := fwm.validateInstructingFI(); err != nil {
-		return err
-	}
-	if err := fwm.validateOriginatorToBeneficiary(); err != nil {
-		return err
-	}
-	if err := fwm.validateFIIntermediaryFI(); err != nil {
-		return err
-	}
-	if err := fwm.validateFIIntermediaryFIAdvice(); err != nil {
-		return err
-	}
-	if err := fwm.validateFIBeneficiaryFI(); err != nil {
-		return err
+// ValidateAll checks the message against the same rules as verify, but rather than stopping at the first
+// error it returns a base.ErrorList of every one found: the fields of each tag present, missing mandatory
+// tags, the rules of the business function code, the relationships between tags and, once the mandatory tags
+// are valid, the custom rules of the message's ValidateOptions.
+//
+// Each error is a *ValidationError locating it within the message. ValidateAll returns nil when the message
+// is valid.
+func (fwm *FEDWireMessage) ValidateAll() error {
+	if errs := fwm.validate(true); !errs.Empty() {
+		return errs
 	}
-	if err := fwm.validateFIBeneficiaryFIAdvice(); err != nil {
-		return err
+	return nil
+}
+
+// validate runs the checks of verify and ValidateAll, returning the errors found as ValidationErrors: every
+// one when all is true, or else the first one
+func (fwm *FEDWireMessage) validate(all bool) base.ErrorList {
+	opts := fwm.validation()
+	if opts.SkipAll {
+		return nil
 	}
-	if err := fwm.validateFIBeneficiary(); err != nil {
-		return err
+	tagOpts := opts
+	if all {
+		c := *opts
+		c.allErrors = true
+		tagOpts = &c
+	}
+
+	var errs base.ErrorList
+	seen := make(map[string]bool)
+	// add adds err, found in the FEDWireMessage field named field, unless it was already, and returns true
+	// if validation goes on
+	add := func(field string, err error) bool {
+		if err == nil {
+			return true
+		}
+		var el base.ErrorList
+		if !errors.As(err, &el) {
+			el = base.ErrorList{err}
+		}
+		for _, err := range el {
+			ve := newValidationError(field, err)
+			// the rules call a tag's Validate too, its errors are reported once where they were found
+			key := ve.Tag + ve.Pointer + ve.Code + ve.Rule
+			if ve.Field == "" {
+				key += ve.Error()
+			}
+			if !seen[key] {
+				seen[key] = true
+				errs.Add(ve)
+			}
+		}
+		return all
 	}
-	if err := fwm.validateFIBeneficiaryAdvice(); err != nil {
-		return err
+
+	for _, t := range fwm.presentTags() {
+		if !add(t.field, tagOpts.validateTag(t.tag)) {
+			return errs
+		}
 	}
-	if err := fwm.validateFIPaymentMethodToBeneficiary(); err != nil {
-		return err
+	for _, check := range fwm.mandatoryChecks() {
+		if !add("", check()) {
+			return errs
+		}
 	}
-	if err := fwm.validateUnstructuredAddenda(); err != nil {
-		return err
+	// custom rules rely on the mandatory tags
+	mandatoryValid := errs.Empty()
+	// the remaining rules depend on the business function code
+	if fwm.BusinessFunctionCode != nil {
+		for _, check := range fwm.tagChecks() {
+			if !add("", check()) {
+				return errs
+			}
+		}
 	}
-
-	if err := fwm.validateRelatedRemittance(); err != nil {
-		return err
+	if mandatoryValid {
+		for _, err := range fwm.runRules() {
+			if !add("", err) {
+				return errs
+			}
+		}
 	}
-
-	if err := fwm.isRemittanceValid(); err != nil {
-		return err
+	return errs
+}
+
+// tagChecks returns the checks of the optional tags of a FEDWireMessage, and of the tags they require, in
+// the order verify runs them. They expect BusinessFunctionCode to be set.
+func (fwm *FEDWireMessage) tagChecks() []func() error {
+	checks := []func() error{
+		// other transfer information
+		fwm.validateLocalInstrumentCode,
+		fwm.validateCharges,
+		fwm.validateInstructedAmount,
+		fwm.validateExchangeRate,
+
+		fwm.validateBeneficiaryIntermediaryFI,
+		fwm.validateBeneficiaryFI,
+		fwm.validateOriginatorFI,
+		fwm.validateInstructingFI,
+		fwm.validateOriginatorToBeneficiary,
+		fwm.validateFIIntermediaryFI,
+		fwm.validateFIIntermediaryFIAdvice,
+		fwm.validateFIBeneficiaryFI,
+		fwm.validateFIBeneficiaryFIAdvice,
+		fwm.validateFIBeneficiary,
+		fwm.validateFIBeneficiaryAdvice,
+		fwm.validateFIPaymentMethodToBeneficiary,
+	}
+	if fwm.validation().SkipRemittanceRules {
+		return append(checks, fwm.validateRemittanceFields)
+	}
+	return append(checks,
+		fwm.validateUnstructuredAddenda,
+		fwm.validateRelatedRemittance,
+
+		// remittance
+		fwm.validateRemittanceOriginator,
+		fwm.validateRemittanceBeneficiary,
+		fwm.validatePrimaryRemittanceDocument,
+		fwm.validateActualAmountPaid,
+		fwm.validateGrossAmountRemittanceDocument,
+		fwm.validateAdjustment,
+		fwm.validateDateRemittanceDocument,
+		fwm.validateRemittanceFreeText,
+	)
+}
+
+// remittanceFields are the tags whose presence depends on the LocalInstrument of a CustomerTransferPlus
+var remittanceFields = map[string]bool{
+	"UnstructuredAddenda":           true,
+	"RelatedRemittance":             true,
+	"RemittanceOriginator":          true,
+	"RemittanceBeneficiary":         true,
+	"PrimaryRemittanceDocument":     true,
+	"ActualAmountPaid":              true,
+	"GrossAmountRemittanceDocument": true,
+	"Adjustment":                    true,
+	"DateRemittanceDocument":        true,
+	"RemittanceFreeText":            true,
+}
+
+// validateRemittanceFields validates the remittanceFields tags present, without the rules between them and
+// the LocalInstrument which ValidateOpts.SkipRemittanceRules skips
+func (fwm *FEDWireMessage) validateRemittanceFields() error {
+	for _, t := range fwm.presentTags() {
+		if !remittanceFields[t.field] {
+			continue
+		}
+		if err := fwm.validateTag(t.tag); err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
+// tagValidator is implemented by every tag of a FEDWireMessage
+type tagValidator interface {
+	Validate() error
+}
+
+// presentTag is a tag set on a FEDWireMessage
+type presentTag struct {
+	field string // the FEDWireMessage field holding the tag, e.g. Beneficiary
+	tag   tagValidator
+}
+
+// presentTags returns the tags set on fwm in the order they're declared
+func (fwm *FEDWireMessage) presentTags() []presentTag {
+	var tags []presentTag
+	add := func(present bool, field string, tag tagValidator) {
+		if present {
+			tags = append(tags, presentTag{field: field, tag: tag})
+		}
+	}
+	add(fwm.MessageDisposition != nil, "MessageDisposition", fwm.MessageDisposition)
+	add(fwm.ReceiptTimeStamp != nil, "ReceiptTimeStamp", fwm.ReceiptTimeStamp)
+	add(fwm.OutputMessageAccountabilityData != nil, "OutputMessageAccountabilityData", fwm.OutputMessageAccountabilityData)
+	add(fwm.ErrorWire != nil, "ErrorWire", fwm.ErrorWire)
+	add(fwm.SenderSupplied != nil, "SenderSupplied", fwm.SenderSupplied)
+	add(fwm.TypeSubType != nil, "TypeSubType", fwm.TypeSubType)
+	add(fwm.InputMessageAccountabilityData != nil, "InputMessageAccountabilityData", fwm.InputMessageAccountabilityData)
+	add(fwm.Amount != nil, "Amount", fwm.Amount)
+	add(fwm.SenderDepositoryInstitution != nil, "SenderDepositoryInstitution", fwm.SenderDepositoryInstitution)
+	add(fwm.ReceiverDepositoryInstitution != nil, "ReceiverDepositoryInstitution", fwm.ReceiverDepositoryInstitution)
+	add(fwm.BusinessFunctionCode != nil, "BusinessFunctionCode", fwm.BusinessFunctionCode)
+	add(fwm.SenderReference != nil, "SenderReference", fwm.SenderReference)
+	add(fwm.PreviousMessageIdentifier != nil, "PreviousMessageIdentifier", fwm.PreviousMessageIdentifier)
+	add(fwm.LocalInstrument != nil, "LocalInstrument", fwm.LocalInstrument)
+	add(fwm.PaymentNotification != nil, "PaymentNotification", fwm.PaymentNotification)
+	add(fwm.Charges != nil, "Charges", fwm.Charges)
+	add(fwm.InstructedAmount != nil, "InstructedAmount", fwm.InstructedAmount)
+	add(fwm.ExchangeRate != nil, "ExchangeRate", fwm.ExchangeRate)
+	add(fwm.BeneficiaryIntermediaryFI != nil, "BeneficiaryIntermediaryFI", fwm.BeneficiaryIntermediaryFI)
+	add(fwm.BeneficiaryFI != nil, "BeneficiaryFI", fwm.BeneficiaryFI)
+	add(fwm.Beneficiary != nil, "Beneficiary", fwm.Beneficiary)
+	add(fwm.BeneficiaryReference != nil, "BeneficiaryReference", fwm.BeneficiaryReference)
+	add(fwm.AccountDebitedDrawdown != nil, "AccountDebitedDrawdown", fwm.AccountDebitedDrawdown)
+	add(fwm.Originator != nil, "Originator", fwm.Originator)
+	add(fwm.OriginatorOptionF != nil, "OriginatorOptionF", fwm.OriginatorOptionF)
+	add(fwm.OriginatorFI != nil, "OriginatorFI", fwm.OriginatorFI)
+	add(fwm.InstructingFI != nil, "InstructingFI", fwm.InstructingFI)
+	add(fwm.AccountCreditedDrawdown != nil, "AccountCreditedDrawdown", fwm.AccountCreditedDrawdown)
+	add(fwm.OriginatorToBeneficiary != nil, "OriginatorToBeneficiary", fwm.OriginatorToBeneficiary)
+	add(fwm.FIReceiverFI != nil, "FIReceiverFI", fwm.FIReceiverFI)
+	add(fwm.FIDrawdownDebitAccountAdvice != nil, "FIDrawdownDebitAccountAdvice", fwm.FIDrawdownDebitAccountAdvice)
+	add(fwm.FIIntermediaryFI != nil, "FIIntermediaryFI", fwm.FIIntermediaryFI)
+	add(fwm.FIIntermediaryFIAdvice != nil, "FIIntermediaryFIAdvice", fwm.FIIntermediaryFIAdvice)
+	add(fwm.FIBeneficiaryFI != nil, "FIBeneficiaryFI", fwm.FIBeneficiaryFI)
+	add(fwm.FIBeneficiaryFIAdvice != nil, "FIBeneficiaryFIAdvice", fwm.FIBeneficiaryFIAdvice)
+	add(fwm.FIBeneficiary != nil, "FIBeneficiary", fwm.FIBeneficiary)
+	add(fwm.FIBeneficiaryAdvice != nil, "FIBeneficiaryAdvice", fwm.FIBeneficiaryAdvice)
+	add(fwm.FIPaymentMethodToBeneficiary != nil, "FIPaymentMethodToBeneficiary", fwm.FIPaymentMethodToBeneficiary)
+	add(fwm.FIAdditionalFIToFI != nil, "FIAdditionalFIToFI", fwm.FIAdditionalFIToFI)
+	add(fwm.CurrencyInstructedAmount != nil, "CurrencyInstructedAmount", fwm.CurrencyInstructedAmount)
+	add(fwm.OrderingCustomer != nil, "OrderingCustomer", fwm.OrderingCustomer)
+	add(fwm.OrderingInstitution != nil, "OrderingInstitution", fwm.OrderingInstitution)
+	add(fwm.IntermediaryInstitution != nil, "IntermediaryInstitution", fwm.IntermediaryInstitution)
+	add(fwm.InstitutionAccount != nil, "InstitutionAccount", fwm.InstitutionAccount)
+	add(fwm.BeneficiaryCustomer != nil, "BeneficiaryCustomer", fwm.BeneficiaryCustomer)
+	add(fwm.Remittance != nil, "Remittance", fwm.Remittance)
+	add(fwm.SenderToReceiver != nil, "SenderToReceiver", fwm.SenderToReceiver)
+	add(fwm.UnstructuredAddenda != nil, "UnstructuredAddenda", fwm.UnstructuredAddenda)
+	add(fwm.RelatedRemittance != nil, "RelatedRemittance", fwm.RelatedRemittance)
+	add(fwm.RemittanceOriginator != nil, "RemittanceOriginator", fwm.RemittanceOriginator)
+	add(fwm.RemittanceBeneficiary != nil, "RemittanceBeneficiary", fwm.RemittanceBeneficiary)
+	add(fwm.PrimaryRemittanceDocument != nil, "PrimaryRemittanceDocument", fwm.PrimaryRemittanceDocument)
+	add(fwm.ActualAmountPaid != nil, "ActualAmountPaid", fwm.ActualAmountPaid)
+	add(fwm.GrossAmountRemittanceDocument != nil, "GrossAmountRemittanceDocument", fwm.GrossAmountRemittanceDocument)
+	add(fwm.AmountNegotiatedDiscount != nil, "AmountNegotiatedDiscount", fwm.AmountNegotiatedDiscount)
+	add(fwm.Adjustment != nil, "Adjustment", fwm.Adjustment)
+	add(fwm.DateRemittanceDocument != nil, "DateRemittanceDocument", fwm.DateRemittanceDocument)
+	add(fwm.SecondaryRemittanceDocument != nil, "SecondaryRemittanceDocument", fwm.SecondaryRemittanceDocument)
+	add(fwm.RemittanceFreeText != nil, "RemittanceFreeText", fwm.RemittanceFreeText)
+	add(fwm.ServiceMessage != nil, "ServiceMessage", fwm.ServiceMessage)
+	return tags
+}
+
+// messageTags are the tags of the FEDWireMessage fields
+var messageTags = map[string]string{
+	"MessageDisposition":              TagMessageDisposition,
+	"ReceiptTimeStamp":                TagReceiptTimeStamp,
+	"OutputMessageAccountabilityData": TagOutputMessageAccountabilityData,
+	"ErrorWire":                       TagErrorWire,
+	"SenderSupplied":                  TagSenderSupplied,
+	"TypeSubType":                     TagTypeSubType,
+	"InputMessageAccountabilityData":  TagInputMessageAccountabilityData,
+	"Amount":                          TagAmount,
+	"SenderDepositoryInstitution":     TagSenderDepositoryInstitution,
+	"ReceiverDepositoryInstitution":   TagReceiverDepositoryInstitution,
+	"BusinessFunctionCode":            TagBusinessFunctionCode,
+	"SenderReference":                 TagSenderReference,
+	"PreviousMessageIdentifier":       TagPreviousMessageIdentifier,
+	"LocalInstrument":                 TagLocalInstrument,
+	"PaymentNotification":             TagPaymentNotification,
+	"Charges":                         TagCharges,
+	"InstructedAmount":                TagInstructedAmount,
+	"ExchangeRate":                    TagExchangeRate,
+	"BeneficiaryIntermediaryFI":       TagBeneficiaryIntermediaryFI,
+	"BeneficiaryFI":                   TagBeneficiaryFI,
+	"Beneficiary":                     TagBeneficiary,
+	"BeneficiaryReference":            TagBeneficiaryReference,
+	"AccountDebitedDrawdown":          TagAccountDebitedDrawdown,
+	"Originator":                      TagOriginator,
+	"OriginatorOptionF":               TagOriginatorOptionF,
+	"OriginatorFI":                    TagOriginatorFI,
+	"InstructingFI":                   TagInstructingFI,
+	"AccountCreditedDrawdown":         TagAccountCreditedDrawdown,
+	"OriginatorToBeneficiary":         TagOriginatorToBeneficiary,
+	"FIReceiverFI":                    TagFIReceiverFI,
+	"FIDrawdownDebitAccountAdvice":    TagFIDrawdownDebitAccountAdvice,
+	"FIIntermediaryFI":                TagFIIntermediaryFI,
+	"FIIntermediaryFIAdvice":          TagFIIntermediaryFIAdvice,
+	"FIBeneficiaryFI":                 TagFIBeneficiaryFI,
+	"FIBeneficiaryFIAdvice":           TagFIBeneficiaryFIAdvice,
+	"FIBeneficiary":                   TagFIBeneficiary,
+	"FIBeneficiaryAdvice":             TagFIBeneficiaryAdvice,
+	"FIPaymentMethodToBeneficiary":    TagFIPaymentMethodToBeneficiary,
+	"FIAdditionalFIToFI":              TagFIAdditionalFIToFI,
+	"CurrencyInstructedAmount":        TagCurrencyInstructedAmount,
+	"OrderingCustomer":                TagOrderingCustomer,
+	"OrderingInstitution":             TagOrderingInstitution,
+	"IntermediaryInstitution":         TagIntermediaryInstitution,
+	"InstitutionAccount":              TagInstitutionAccount,
+	"BeneficiaryCustomer":             TagBeneficiaryCustomer,
+	"Remittance":                      TagRemittance,
+	"SenderToReceiver":                TagSenderToReceiver,
+	"UnstructuredAddenda":             TagUnstructuredAddenda,
+	"RelatedRemittance":               TagRelatedRemittance,
+	"RemittanceOriginator":            TagRemittanceOriginator,
+	"RemittanceBeneficiary":           TagRemittanceBeneficiary,
+	"PrimaryRemittanceDocument":       TagPrimaryRemittanceDocument,
+	"ActualAmountPaid":                TagActualAmountPaid,
+	"GrossAmountRemittanceDocument":   TagGrossAmountRemittanceDocument,
+	"AmountNegotiatedDiscount":        TagAmountNegotiatedDiscount,
+	"Adjustment":                      TagAdjustment,
+	"DateRemittanceDocument":          TagDateRemittanceDocument,
+	"SecondaryRemittanceDocument":     TagSecondaryRemittanceDocument,
+	"RemittanceFreeText":              TagRemittanceFreeText,
+	"ServiceMessage":                  TagServiceMessage,
+}
+
 // mandatoryFields validates mandatory tags for a FEDWireMessage are defined
 //
 //			At a minimum, the following tags are mandatory in each outgoing message sent from a DI to the Fedwire Funds Service
@@ -221,33 +494,30 @@ func (fwm *FEDWireMessage) verify() error {
 //		 	NOTE: Not specified mandatory elements in each incoming message
 //	          Need to specify mandatory elements in this case
 func (fwm *FEDWireMessage) mandatoryFields() error {
-	if fwm.requireSenderSupplied() {
-		if err := fwm.validateSenderSupplied(); err != nil {
+	for _, check := range fwm.mandatoryChecks() {
+		if err := check(); err != nil {
 			return err
 		}
 	}
-	if err := fwm.validateTypeSubType(); err != nil {
-		return err
-	}
+	return nil
+}
 
-	if fwm.ValidateOptions == nil || !fwm.ValidateOptions.SkipMandatoryIMAD {
-		if err := fwm.validateIMAD(); err != nil {
-			return err
-		}
-	}
-	if err := fwm.validateAmount(); err != nil {
-		return err
-	}
-	if err := fwm.validateSenderDI(); err != nil {
-		return err
-	}
-	if err := fwm.validateReceiverDI(); err != nil {
-		return err
+// mandatoryChecks returns the checks of the mandatory tags in the order mandatoryFields runs them
+func (fwm *FEDWireMessage) mandatoryChecks() []func() error {
+	var checks []func() error
+	if fwm.requireSenderSupplied() {
+		checks = append(checks, fwm.validateSenderSupplied)
 	}
-	if err := fwm.validateBusinessFunctionCode(); err != nil {
-		return err
+	checks = append(checks, fwm.validateTypeSubType)
+	if !fwm.validation().SkipMandatoryIMAD {
+		checks = append(checks, fwm.validateIMAD)
 	}
-	return nil
+	return append(checks,
+		fwm.validateAmount,
+		fwm.validateSenderDI,
+		fwm.validateReceiverDI,
+		fwm.validateBusinessFunctionCode,
+	)
 }
 
 // validateSenderSupplied validates TagSenderSupplied within a FEDWireMessage
@@ -256,7 +526,7 @@ func (fwm *FEDWireMessage) validateSenderSupplied() error {
 	if fwm.SenderSupplied == nil {
 		return fieldError("SenderSupplied", ErrFieldRequired)
 	}
-	return fwm.SenderSupplied.Validate()
+	return fwm.validateTag(fwm.SenderSupplied)
 }
 
 // validateTypeSubType validates TagTypeSubType within a FEDWireMessage
@@ -265,7 +535,7 @@ func (fwm *FEDWireMessage) validateTypeSubType() error {
 	if fwm.TypeSubType == nil {
 		return fieldError("TypeSubType", ErrFieldRequired)
 	}
-	return fwm.TypeSubType.Validate()
+	return fwm.validateTag(fwm.TypeSubType)
 }
 
 // validateIMAD validates TagInputMessageAccountabilityData within a FEDWireMessage
@@ -274,7 +544,7 @@ func (fwm *FEDWireMessage) validateIMAD() error {
 	if fwm.InputMessageAccountabilityData == nil {
 		return fieldError("InputMessageAccountabilityData", ErrFieldRequired)
 	}
-	return fwm.InputMessageAccountabilityData.Validate()
+	return fwm.validateTag(fwm.InputMessageAccountabilityData)
 }
 
 // validateAmount validates TagAmount within a FEDWireMessage
@@ -284,11 +554,11 @@ func (fwm *FEDWireMessage) validateAmount() error {
 	if fwm.Amount == nil {
 		return fieldError("Amount", ErrFieldRequired)
 	}
-	if fwm.Amount.Amount == "000000000000" && fwm.TypeSubType.SubTypeCode != "90" {
+	if fwm.Amount.Amount == "000000000000" && fwm.TypeSubType != nil && fwm.TypeSubType.SubTypeCode != "90" {
 		return NewErrInvalidPropertyForProperty("Amount", fwm.Amount.Amount,
 			"SubTypeCode", fwm.TypeSubType.SubTypeCode)
 	}
-	return fwm.Amount.Validate()
+	return fwm.validateTag(fwm.Amount)
 }
 
 // validateSenderDI validates TagSenderDepositoryInstitution within a FEDWireMessage
@@ -297,7 +567,7 @@ func (fwm *FEDWireMessage) validateSenderDI() error {
 	if fwm.SenderDepositoryInstitution == nil {
 		return fieldError("SenderDepositoryInstitution", ErrFieldRequired)
 	}
-	return fwm.SenderDepositoryInstitution.Validate()
+	return fwm.validateTag(fwm.SenderDepositoryInstitution)
 }
 
 // validateReceiverDI validates TagReceiverDepositoryInstitution within a FEDWireMessage
@@ -306,7 +576,7 @@ func (fwm *FEDWireMessage) validateReceiverDI() error {
 	if fwm.ReceiverDepositoryInstitution == nil {
 		return fieldError("ReceiverDepositoryInstitution", ErrFieldRequired)
 	}
-	return fwm.ReceiverDepositoryInstitution.Validate()
+	return fwm.validateTag(fwm.ReceiverDepositoryInstitution)
 }
 
 // validateBusinessFunctionCode validates TagBusinessFunctionCode within a FEDWireMessage
@@ -315,6 +585,10 @@ func (fwm *FEDWireMessage) validateBusinessFunctionCode() error {
 	if fwm.BusinessFunctionCode == nil {
 		return fieldError("BusinessFunctionCode", ErrFieldRequired)
 	}
+	// the rules of each code check the type and subtype
+	if fwm.TypeSubType == nil {
+		return fieldError("TypeSubType", ErrFieldRequired)
+	}
 
 	switch fwm.BusinessFunctionCode.BusinessFunctionCode {
 	case BankTransfer:
@@ -362,7 +636,7 @@ func (fwm *FEDWireMessage) validateBusinessFunctionCode() error {
 			return err
 		}
 	}
-	return fwm.BusinessFunctionCode.Validate()
+	return fwm.validateTag(fwm.BusinessFunctionCode)
 }
 
 // validateBankTransfer validates the BankTransfer code and associated tags
@@ -393,6 +667,9 @@ func (fwm *FEDWireMessage) validateBankTransfer() error {
 //	OriginatorOptionF, AccountCreditedDrawdown, FIDrawdownDebitAccountAdvice, Any CoverPayment Information tag ({7xxx}),
 //	Any UnstructuredAddenda or remittance tags ({8xxx}), and ServiceMessage
 func (fwm *FEDWireMessage) checkProhibitedBankTransferTags() error {
+	if fwm.validation().SkipProhibitedTags {
+		return nil
+	}
 	if fwm.BusinessFunctionCode != nil {
 		if strings.TrimSpace(fwm.BusinessFunctionCode.TransactionTypeCode) != "" {
 			return fieldError("BusinessFunctionCode.TransactionTypeCode", ErrTransactionTypeCode, fwm.BusinessFunctionCode.TransactionTypeCode)
@@ -481,6 +758,9 @@ func (fwm *FEDWireMessage) checkMandatoryCustomerTransferTags() error {
 //	BusinessFunctionCode Element 02 = COV, LocalInstrument, PaymentNotification, AccountDebitedDrawdown, OriginatorOptionF, AccountCreditedDrawdown,
 //	FIDrawdownDebitAccountAdvice, any CoverPayment Information tag ({7xxx}), any UnstructuredAddenda or remittance tags ({8xxx}) and ServiceMessage
 func (fwm *FEDWireMessage) checkProhibitedCustomerTransferTags() error {
+	if fwm.validation().SkipProhibitedTags {
+		return nil
+	}
 	// This covers the edit requirement
 	if fwm.BusinessFunctionCode.TransactionTypeCode == "COV" {
 		return fieldError("BusinessFunctionCode.TransactionTypeCode", ErrTransactionTypeCode, fwm.BusinessFunctionCode.TransactionTypeCode)
@@ -558,6 +838,7 @@ func (fwm *FEDWireMessage) checkMandatoryCustomerTransferPlusTags() error {
 
 	// LocalInstrument is optional for Customer Transfer Plus
 	if fwm.LocalInstrument != nil {
+		remittance := !fwm.validation().SkipRemittanceRules
 		switch fwm.LocalInstrument.LocalInstrumentCode {
 		case SequenceBCoverPaymentStructured:
 			if fwm.BeneficiaryReference == nil {
@@ -571,14 +852,17 @@ func (fwm *FEDWireMessage) checkMandatoryCustomerTransferPlusTags() error {
 			}
 		case ANSIX12format, GeneralXMLformat, ISO20022XMLformat,
 			NarrativeText, STP820format, SWIFTfield70, UNEDIFACTformat:
-			if fwm.UnstructuredAddenda == nil {
+			if remittance && fwm.UnstructuredAddenda == nil {
 				return fieldError("UnstructuredAddenda", ErrFieldRequired)
 			}
 		case RelatedRemittanceInformation:
-			if fwm.RelatedRemittance == nil {
+			if remittance && fwm.RelatedRemittance == nil {
 				return fieldError("RelatedRemittance", ErrFieldRequired)
 			}
 		case RemittanceInformationStructured:
+			if !remittance {
+				break
+			}
 			if fwm.RemittanceOriginator == nil {
 				return fieldError("RemittanceOriginator", ErrFieldRequired)
 			}
@@ -609,6 +893,9 @@ func (fwm *FEDWireMessage) checkMandatoryCustomerTransferPlusTags() error {
 // If LocalInstrument = SequenceBCoverPaymentStructured, Charges, InstructedAmount & ExchangeRate are not permitted.
 // Certain {7xxx} tags & {8xxx} tags may not be permitted depending upon value of LocalInstrument.
 func (fwm *FEDWireMessage) checkProhibitedCustomerTransferPlusTags() error {
+	if fwm.validation().SkipProhibitedTags {
+		return nil
+	}
 	if strings.TrimSpace(fwm.BusinessFunctionCode.TransactionTypeCode) != "" {
 		return fieldError("BusinessFunctionCode.TransactionTypeCode", ErrTransactionTypeCode, fwm.BusinessFunctionCode.TransactionTypeCode)
 	}
@@ -798,6 +1085,9 @@ func (fwm *FEDWireMessage) validateServiceMessage() error {
 //	Beneficiary Code = SWIFTBICORBEIANDAccountNumber, Originator Code = SWIFTBICORBEIANDAccountNumber, OriginatorOptionF,
 //	any {7xxx} tag, any {8xxx} tag
 func (fwm *FEDWireMessage) checkProhibitedServiceMessageTags() error {
+	if fwm.validation().SkipProhibitedTags {
+		return nil
+	}
 	// BusinessFunctionCode.TransactionTypeCode (Element 02) is invalid
 	if fwm.BusinessFunctionCode != nil {
 		if strings.TrimSpace(fwm.BusinessFunctionCode.TransactionTypeCode) != "" {
@@ -844,6 +1134,9 @@ func (fwm *FEDWireMessage) checkProhibitedServiceMessageTags() error {
 // BusinessFunctionCode, create function isInvalidBusinessFunctionCodeTag() with the specific invalid tags for that
 // BusinessFunctionCode (e.g. checkProhibitedBankTransferTags)
 func (fwm *FEDWireMessage) checkSharedProhibitedTags() error {
+	if fwm.validation().SkipProhibitedTags {
+		return nil
+	}
 	// shared between CheckSameDaySettlement, DepositSendersAccount, FEDFundsReturned, FEDFundsSold, DrawdownResponse, BankDrawDownRequest, and CustomerCorporateDrawdownRequest
 	if strings.TrimSpace(fwm.BusinessFunctionCode.TransactionTypeCode) != "" {
 		return fieldError("BusinessFunctionCode.TransactionTypeCode", ErrTransactionTypeCode, fwm.BusinessFunctionCode.TransactionTypeCode)
@@ -982,7 +1275,7 @@ func (fwm *FEDWireMessage) validateLocalInstrumentCode() error {
 		if fwm.BusinessFunctionCode.BusinessFunctionCode != CustomerTransferPlus {
 			return fieldError("LocalInstrument", ErrLocalInstrumentNotPermitted)
 		}
-		return fwm.LocalInstrument.Validate()
+		return fwm.validateTag(fwm.LocalInstrument)
 	}
 	return nil
 
@@ -999,7 +1292,7 @@ func (fwm *FEDWireMessage) validateCharges() error {
 			return NewErrInvalidPropertyForProperty("LocalInstrumentCode", fwm.LocalInstrument.LocalInstrumentCode,
 				"Charges", fwm.Charges.String())
 		}
-		return fwm.Charges.Validate()
+		return fwm.validateTag(fwm.Charges)
 	}
 	return nil
 }
@@ -1021,7 +1314,7 @@ func (fwm *FEDWireMessage) validateInstructedAmount() error {
 			return NewErrInvalidPropertyForProperty("LocalInstrumentCode",
 				fwm.LocalInstrument.LocalInstrumentCode, "Instructed Amount", fwm.InstructedAmount.String())
 		}
-		return fwm.InstructedAmount.Validate()
+		return fwm.validateTag(fwm.InstructedAmount)
 	}
 	return nil
 }
@@ -1043,7 +1336,7 @@ func (fwm *FEDWireMessage) validateExchangeRate() error {
 			return NewErrInvalidPropertyForProperty("LocalInstrumentCode",
 				fwm.LocalInstrument.LocalInstrumentCode, "ExchangeRate", fwm.ExchangeRate.ExchangeRate)
 		}
-		return fwm.ExchangeRate.Validate()
+		return fwm.validateTag(fwm.ExchangeRate)
 	}
 	return nil
 }
@@ -1057,7 +1350,7 @@ func (fwm *FEDWireMessage) validateBeneficiaryIntermediaryFI() error {
 		if fwm.Beneficiary == nil {
 			return fieldError("Beneficiary", ErrFieldRequired)
 		}
-		return fwm.BeneficiaryIntermediaryFI.Validate()
+		return fwm.validateTag(fwm.BeneficiaryIntermediaryFI)
 	}
 	return nil
 }
@@ -1068,7 +1361,7 @@ func (fwm *FEDWireMessage) validateBeneficiaryFI() error {
 		if fwm.Beneficiary == nil {
 			return fieldError("Beneficiary", ErrFieldRequired)
 		}
-		return fwm.BeneficiaryFI.Validate()
+		return fwm.validateTag(fwm.BeneficiaryFI)
 	}
 	return nil
 }
@@ -1086,7 +1379,7 @@ func (fwm *FEDWireMessage) validateOriginatorFI() error {
 				return fieldError("Originator", ErrFieldRequired)
 			}
 		}
-		return fwm.OriginatorFI.Validate()
+		return fwm.validateTag(fwm.OriginatorFI)
 	}
 	return nil
 }
@@ -1107,7 +1400,7 @@ func (fwm *FEDWireMessage) validateInstructingFI() error {
 		if fwm.OriginatorFI == nil {
 			return fieldError("OriginatorFI", ErrFieldRequired)
 		}
-		return fwm.InstructingFI.Validate()
+		return fwm.validateTag(fwm.InstructingFI)
 	}
 	return nil
 }
@@ -1128,7 +1421,7 @@ func (fwm *FEDWireMessage) validateOriginatorToBeneficiary() error {
 				return fieldError("Originator", ErrFieldRequired)
 			}
 		}
-		return fwm.OriginatorToBeneficiary.Validate()
+		return fwm.validateTag(fwm.OriginatorToBeneficiary)
 	}
 	return nil
 }
@@ -1146,7 +1439,7 @@ func (fwm *FEDWireMessage) validateFIIntermediaryFI() error {
 		if fwm.Beneficiary == nil {
 			return fieldError("Beneficiary", ErrFieldRequired)
 		}
-		return fwm.FIIntermediaryFI.Validate()
+		return fwm.validateTag(fwm.FIIntermediaryFI)
 	}
 	return nil
 }
@@ -1164,7 +1457,7 @@ func (fwm *FEDWireMessage) validateFIIntermediaryFIAdvice() error {
 		if fwm.Beneficiary == nil {
 			return fieldError("Beneficiary", ErrFieldRequired)
 		}
-		return fwm.FIIntermediaryFIAdvice.Validate()
+		return fwm.validateTag(fwm.FIIntermediaryFIAdvice)
 	}
 	return nil
 }
@@ -1179,7 +1472,7 @@ func (fwm *FEDWireMessage) validateFIBeneficiaryFI() error {
 		if fwm.Beneficiary == nil {
 			return fieldError("Beneficiary", ErrFieldRequired)
 		}
-		return fwm.FIBeneficiaryFI.Validate()
+		return fwm.validateTag(fwm.FIBeneficiaryFI)
 	}
 	return nil
 }
@@ -1194,7 +1487,7 @@ func (fwm *FEDWireMessage) validateFIBeneficiaryFIAdvice() error {
 		if fwm.Beneficiary == nil {
 			return fieldError("Beneficiary", ErrFieldRequired)
 		}
-		return fwm.FIBeneficiaryFIAdvice.Validate()
+		return fwm.validateTag(fwm.FIBeneficiaryFIAdvice)
 	}
 	return nil
 }
@@ -1206,7 +1499,7 @@ func (fwm *FEDWireMessage) validateFIBeneficiary() error {
 		if fwm.Beneficiary == nil {
 			return fieldError("Beneficiary", ErrFieldRequired)
 		}
-		return fwm.FIBeneficiary.Validate()
+		return fwm.validateTag(fwm.FIBeneficiary)
 	}
 	return nil
 }
@@ -1218,7 +1511,7 @@ func (fwm *FEDWireMessage) validateFIBeneficiaryAdvice() error {
 		if fwm.Beneficiary == nil {
 			return fieldError("Beneficiary", ErrFieldRequired)
 		}
-		return fwm.FIBeneficiaryAdvice.Validate()
+		return fwm.validateTag(fwm.FIBeneficiaryAdvice)
 	}
 	return nil
 }
@@ -1233,7 +1526,7 @@ func (fwm *FEDWireMessage) validateFIPaymentMethodToBeneficiary() error {
 		if fwm.Beneficiary == nil {
 			return fieldError("Beneficiary", ErrFieldRequired)
 		}
-		return fwm.FIPaymentMethodToBeneficiary.Validate()
+		return fwm.validateTag(fwm.FIPaymentMethodToBeneficiary)
 	}
 	return nil
 }
@@ -1254,7 +1547,7 @@ func (fwm *FEDWireMessage) validateUnstructuredAddenda() error {
 			if fwm.UnstructuredAddenda == nil {
 				return fieldError("UnstructuredAddenda", ErrFieldRequired)
 			}
-			return fwm.UnstructuredAddenda.Validate()
+			return fwm.validateTag(fwm.UnstructuredAddenda)
 		default:
 			if fwm.UnstructuredAddenda != nil {
 				return NewErrInvalidPropertyForProperty("UnstructuredAddenda", fwm.UnstructuredAddenda.String(),
@@ -1283,7 +1576,7 @@ func (fwm *FEDWireMessage) validateRelatedRemittance() error {
 		if fwm.RelatedRemittance == nil {
 			return fieldError("RelatedRemittance", ErrFieldRequired)
 		}
-		return fwm.RelatedRemittance.Validate()
+		return fwm.validateTag(fwm.RelatedRemittance)
 	} else {
 		if fwm.RelatedRemittance != nil {
 			return fieldError("RelatedRemittance", ErrNotPermitted)
@@ -1303,7 +1596,7 @@ func (fwm *FEDWireMessage) validateRemittanceOriginator() error {
 		if fwm.RemittanceOriginator == nil {
 			return fieldError("RemittanceOriginator", ErrFieldRequired)
 		}
-		return fwm.RemittanceOriginator.Validate()
+		return fwm.validateTag(fwm.RemittanceOriginator)
 	} else {
 		if fwm.RemittanceOriginator != nil {
 			return fieldError("RemittanceOriginator", ErrNotPermitted)
@@ -1323,7 +1616,7 @@ func (fwm *FEDWireMessage) validateRemittanceBeneficiary() error {
 		if fwm.RemittanceBeneficiary == nil {
 			return fieldError("RemittanceBeneficiary", ErrFieldRequired)
 		}
-		return fwm.RemittanceBeneficiary.Validate()
+		return fwm.validateTag(fwm.RemittanceBeneficiary)
 	} else {
 		if fwm.RemittanceBeneficiary != nil {
 			return fieldError("RemittanceBeneficiary", ErrNotPermitted)
@@ -1343,7 +1636,7 @@ func (fwm *FEDWireMessage) validatePrimaryRemittanceDocument() error {
 		if fwm.PrimaryRemittanceDocument == nil {
 			return fieldError("PrimaryRemittanceDocument", ErrFieldRequired)
 		}
-		return fwm.PrimaryRemittanceDocument.Validate()
+		return fwm.validateTag(fwm.PrimaryRemittanceDocument)
 	} else {
 		if fwm.PrimaryRemittanceDocument != nil {
 			return fieldError("PrimaryRemittanceDocument", ErrNotPermitted)
@@ -1363,7 +1656,7 @@ func (fwm *FEDWireMessage) validateActualAmountPaid() error {
 		if fwm.ActualAmountPaid == nil {
 			return fieldError("ActualAmountPaid", ErrFieldRequired)
 		}
-		return fwm.ActualAmountPaid.Validate()
+		return fwm.validateTag(fwm.ActualAmountPaid)
 	} else {
 		if fwm.ActualAmountPaid != nil {
 			return fieldError("ActualAmountPaid", ErrNotPermitted)
@@ -1383,7 +1676,7 @@ func (fwm *FEDWireMessage) validateGrossAmountRemittanceDocument() error {
 		if fwm.GrossAmountRemittanceDocument == nil {
 			return fieldError("GrossAmountRemittanceDocument", ErrFieldRequired)
 		}
-		return fwm.GrossAmountRemittanceDocument.Validate()
+		return fwm.validateTag(fwm.GrossAmountRemittanceDocument)
 	} else {
 		if fwm.GrossAmountRemittanceDocument != nil {
 			return fieldError("GrossAmountRemittanceDocument", ErrNotPermitted)
@@ -1403,7 +1696,7 @@ func (fwm *FEDWireMessage) validateAdjustment() error {
 		if fwm.Adjustment == nil {
 			return fieldError("Adjustment", ErrFieldRequired)
 		}
-		return fwm.Adjustment.Validate()
+		return fwm.validateTag(fwm.Adjustment)
 	} else {
 		if fwm.Adjustment != nil {
 			return fieldError("Adjustment", ErrNotPermitted)
@@ -1423,7 +1716,7 @@ func (fwm *FEDWireMessage) validateDateRemittanceDocument() error {
 		if fwm.DateRemittanceDocument == nil {
 			return fieldError("DateRemittanceDocument", ErrFieldRequired)
 		}
-		return fwm.DateRemittanceDocument.Validate()
+		return fwm.validateTag(fwm.DateRemittanceDocument)
 	} else {
 		if fwm.DateRemittanceDocument != nil {
 			return fieldError("DateRemittanceDocument", ErrNotPermitted)
@@ -1462,7 +1755,7 @@ func (fwm *FEDWireMessage) validateRemittanceFreeText() error {
 		if fwm.RemittanceFreeText == nil {
 			return fieldError("RemittanceFreeText", ErrFieldRequired)
 		}
-		return fwm.RemittanceFreeText.Validate()
+		return fwm.validateTag(fwm.RemittanceFreeText)
 	} else {
 		if fwm.RemittanceFreeText != nil {
 			return fieldError("RemittanceFreeText", ErrNotPermitted)
@@ -1471,47 +1764,3 @@ func (fwm *FEDWireMessage) validateRemittanceFreeText() error {
 
 	return nil
 }
-
-func (fwm *FEDWireMessage) otherTransferInformation() error {
-	if err := fwm.validateLocalInstrumentCode(); err != nil {
-		return err
-	}
-	if err := fwm.validateCharges(); err != nil {
-		return err
-	}
-	if err := fwm.validateInstructedAmount(); err != nil {
-		return err
-	}
-	if err := fwm.validateExchangeRate(); err != nil {
-		return err
-	}
-	return nil
-}
-
-func (fwm *FEDWireMessage) isRemittanceValid() error {
-	if err := fwm.validateRemittanceOriginator(); err != nil {
-		return err
-	}
-	if err := fwm.validateRemittanceBeneficiary(); err != nil {
-		return err
-	}
-	if err := fwm.validatePrimaryRemittanceDocument(); err != nil {
-		return err
-	}
-	if err := fwm.validateActualAmountPaid(); err != nil {
-		return err
-	}
-	if err := fwm.validateGrossAmountRemittanceDocument(); err != nil {
-		return err
-	}
-	if err := fwm.validateAdjustment(); err != nil {
-		return err
-	}
-	if err := fwm.validateDateRemittanceDocument(); err != nil {
-		return err
-	}
-	if err := fwm.validateRemittanceFreeText(); err != nil {
-		return err
-	}
-	return nil
-}
@@ -5,6 +5,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/moov-io/base"
 	"github.com/stretchr/testify/require"
 )
 
@@ -43,7 +44,7 @@ func TestFEDWireMessage_invalidAmount(t *testing.T) {
 	// Validate File
 	err := file.Validate()
 
-	expected := NewErrInvalidPropertyForProperty("Amount", fwm.Amount.Amount, "SubTypeCode", fwm.TypeSubType.SubTypeCode).Error()
+	expected := NewMessageError(0, NewErrInvalidPropertyForProperty("Amount", fwm.Amount.Amount, "SubTypeCode", fwm.TypeSubType.SubTypeCode)).Error()
 	require.EqualError(t, err, expected)
 }
 
@@ -1238,7 +1239,7 @@ func TestAllowFIReceiverFIForCustomerTransferPlus(t *testing.T) {
 {1510}1000
 {1520}20240306MMQFMPYZ012345
 {2000}000005000000
-{3100}021000000CITIBANK NA*
+{3100}021000021CITIBANK NA*
 {3320}D0440000000001*
 {3400}000000000AAA ABC BAN*
 {3600}CTP
@@ -1275,10 +1276,10 @@ func TestFEDWireMessage_skipIMAD(t *testing.T) {
 	err := file.Validate()
 	require.NoError(t, err)
 
-	file.FEDWireMessage.InputMessageAccountabilityData = nil
+	file.FEDWireMessages[0].InputMessageAccountabilityData = nil
 
 	err = file.Validate()
-	expected := fieldError("InputMessageAccountabilityData", ErrFieldRequired).Error()
+	expected := NewMessageError(0, fieldError("InputMessageAccountabilityData", ErrFieldRequired)).Error()
 	require.EqualError(t, err, expected)
 
 	file.SetValidation(&ValidateOpts{SkipMandatoryIMAD: true})
@@ -1291,9 +1292,184 @@ func TestFEDWireMessage_skipIMAD(t *testing.T) {
 	newFile, err := FileFromJSON(bs)
 	require.NoError(t, err)
 	require.NotNil(t, newFile, "Created file shouldn't be nil")
-	require.Nil(t, newFile.FEDWireMessage.InputMessageAccountabilityData)
+	require.Nil(t, newFile.FEDWireMessages[0].InputMessageAccountabilityData)
 
 	err = newFile.Validate()
 	require.NoError(t, err)
 	require.True(t, newFile.GetValidation().SkipMandatoryIMAD)
 }
+
+func TestFEDWireMessage_ValidateAll(t *testing.T) {
+	fwm := mockCustomerTransferData()
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.Originator = mockOriginator()
+	require.NoError(t, fwm.ValidateAll())
+
+	fwm.Amount = nil
+	fwm.Beneficiary.Personal.Identifier = "®"
+	fwm.OriginatorFI = mockOriginatorFI()
+	fwm.Originator = nil
+	fwm.BeneficiaryReference = mockBeneficiaryReference()
+	fwm.BeneficiaryReference.BeneficiaryReference = "®"
+
+	err := fwm.ValidateAll()
+	var errs base.ErrorList
+	require.ErrorAs(t, err, &errs)
+	require.Len(t, errs, 4)
+	// verify runs the same checks, stopping at the first error
+	require.Equal(t, errs[0], fwm.verify())
+	require.ErrorIs(t, errs[0], ErrNonAlphanumeric)
+	require.Equal(t, TagBeneficiary, errs[0].(*ValidationError).Tag)
+	require.Contains(t, errs[0].Error(), "Identifier")
+	require.ErrorIs(t, errs[1], ErrNonAlphanumeric)
+	require.Equal(t, TagBeneficiaryReference, errs[1].(*ValidationError).Tag)
+	require.Contains(t, errs[1].Error(), "BeneficiaryReference")
+	require.EqualError(t, errs[2], fieldError("Amount", ErrFieldRequired).Error())
+	require.Equal(t, TagAmount, errs[2].(*ValidationError).Tag)
+	require.EqualError(t, errs[3], fieldError("Originator", ErrFieldRequired).Error())
+	require.Equal(t, TagOriginator, errs[3].(*ValidationError).Tag)
+}
+
+func TestFEDWireMessage_ValidateAllFields(t *testing.T) {
+	fwm := mockCustomerTransferData()
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.Originator = mockOriginator()
+
+	// fields failing with the same error are each reported, as are the fields of one tag
+	fwm.Beneficiary.Personal.Name = "Nam®"
+	fwm.Beneficiary.Personal.Address.AddressLineOne = "Line®"
+	fwm.Originator.Personal.Name = "Nam®"
+	err := fwm.ValidateAll()
+	var errs base.ErrorList
+	require.ErrorAs(t, err, &errs)
+	require.Len(t, errs, 3)
+	var fields []string
+	for _, err := range errs {
+		require.ErrorIs(t, err, ErrNonAlphanumeric)
+		fields = append(fields, err.(*ValidationError).Field)
+	}
+	require.Equal(t, []string{
+		"Beneficiary.Personal.Name",
+		"Beneficiary.Personal.Address.AddressLineOne",
+		"Originator.Personal.Name",
+	}, fields)
+
+	// Validate of the tag itself still stops at the first
+	require.EqualError(t, fwm.Beneficiary.Validate(), fieldError("Name", ErrNonAlphanumeric, "Nam®").Error())
+
+	// verify checks the fields of every tag present, as ValidateAll does
+	fwm = mockCustomerTransferData()
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.Originator = mockOriginator()
+	fwm.ErrorWire = mockErrorWire()
+	fwm.ErrorWire.ErrorCategory = "1"
+	require.ErrorIs(t, fwm.verify(), ErrErrorCategory)
+	require.ErrorAs(t, fwm.ValidateAll(), &errs)
+	require.Len(t, errs, 1)
+	require.Equal(t, TagErrorWire, errs[0].(*ValidationError).Tag)
+}
+
+func TestFEDWireMessage_ValidateAllMissingTags(t *testing.T) {
+	var fwm FEDWireMessage
+
+	err := fwm.ValidateAll()
+	var errs base.ErrorList
+	require.ErrorAs(t, err, &errs)
+	require.Len(t, errs, 7)
+	for _, err := range errs {
+		require.ErrorIs(t, err, ErrFieldRequired)
+	}
+}
+
+func TestFEDWireMessage_ValidateOptsSkipProhibitedTags(t *testing.T) {
+	fwm := mockCustomerTransferData()
+	fwm.BusinessFunctionCode.BusinessFunctionCode = BankTransfer
+	fwm.ServiceMessage = mockServiceMessage()
+	require.ErrorIs(t, fwm.verify(), ErrInvalidProperty)
+
+	fwm.ValidateOptions = &ValidateOpts{SkipProhibitedTags: true}
+	require.NoError(t, fwm.verify())
+	require.NoError(t, fwm.ValidateAll())
+}
+
+func TestFEDWireMessage_ValidateOptsSkipRemittanceRules(t *testing.T) {
+	fwm := mockCustomerTransferData()
+	fwm.BusinessFunctionCode.BusinessFunctionCode = CustomerTransferPlus
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.Originator = mockOriginator()
+	fwm.LocalInstrument = mockLocalInstrument()
+	fwm.LocalInstrument.LocalInstrumentCode = RelatedRemittanceInformation
+	require.EqualError(t, fwm.verify(), fieldError("RelatedRemittance", ErrFieldRequired).Error())
+
+	fwm.ValidateOptions = &ValidateOpts{SkipRemittanceRules: true}
+	require.NoError(t, fwm.verify())
+
+	// remittance tags are no longer prohibited either
+	fwm.LocalInstrument.LocalInstrumentCode = ANSIX12format
+	fwm.RelatedRemittance = mockRelatedRemittance()
+	require.NoError(t, fwm.verify())
+	require.NoError(t, fwm.ValidateAll())
+
+	// but their fields are still validated
+	fwm.RelatedRemittance.RemittanceData.Name = "Nam®"
+	require.ErrorIs(t, fwm.verify(), ErrNonAlphanumeric)
+	var errs base.ErrorList
+	require.ErrorAs(t, fwm.ValidateAll(), &errs)
+	require.Len(t, errs, 1)
+	require.ErrorIs(t, errs[0], ErrNonAlphanumeric)
+}
+
+func TestFEDWireMessage_ValidateOptsSkipAll(t *testing.T) {
+	var fwm FEDWireMessage
+	require.Error(t, fwm.verify())
+
+	fwm.ValidateOptions = &ValidateOpts{SkipAll: true}
+	require.NoError(t, fwm.verify())
+	require.NoError(t, fwm.ValidateAll())
+}
+
+func TestFEDWireMessage_ValidateOptsSkipCharacterSet(t *testing.T) {
+	fwm := mockCustomerTransferData()
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.Beneficiary.Personal.Name = "Nam®"
+	fwm.Originator = mockOriginator()
+	fwm.OriginatorFI = mockOriginatorFI()
+	fwm.OriginatorFI.FinancialInstitution.Name = "Nam®"
+	require.ErrorIs(t, fwm.verify(), ErrNonAlphanumeric)
+
+	fwm.ValidateOptions = &ValidateOpts{SkipCharacterSet: true}
+	require.NoError(t, fwm.verify())
+	require.NoError(t, fwm.ValidateAll())
+
+	// other errors are still returned
+	fwm.OriginatorFI.FinancialInstitution.IdentificationCode = "Z"
+	require.ErrorIs(t, fwm.verify(), ErrIdentificationCode)
+
+	// including those of the fields checked after one with an unpermitted character
+	fwm.OriginatorFI = mockOriginatorFI()
+	fwm.SenderDepositoryInstitution.SenderShortName = "Nam®"
+	fwm.SenderDepositoryInstitution.SenderABANumber = "121042883"
+	require.ErrorIs(t, fwm.verify(), ErrRoutingNumberCheckDigit)
+
+	// the options are only seen by the checks, the tags are left as they are
+	require.Nil(t, fwm.SenderDepositoryInstitution.opts)
+	require.Nil(t, fwm.OriginatorFI.FinancialInstitution.opts)
+}
+
+func TestFEDWireMessage_ValidateOptsSkipRoutingNumberCheck(t *testing.T) {
+	fwm := mockCustomerTransferData()
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.Originator = mockOriginator()
+	fwm.OriginatorFI = mockOriginatorFI()
+	fwm.OriginatorFI.FinancialInstitution.IdentificationCode = FEDRoutingNumber
+	fwm.OriginatorFI.FinancialInstitution.Identifier = "121042883"
+	require.ErrorIs(t, fwm.verify(), ErrRoutingNumberCheckDigit)
+
+	fwm.ValidateOptions = &ValidateOpts{SkipRoutingNumberCheck: true}
+	require.NoError(t, fwm.verify())
+	require.NoError(t, fwm.ValidateAll())
+
+	// routing numbers must still be numeric
+	fwm.SenderDepositoryInstitution.SenderABANumber = "12104288A"
+	require.ErrorIs(t, fwm.verify(), ErrNonNumeric)
+}
@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // FIBeneficiary is the financial institution beneficiary
@@ -136,28 +138,29 @@ func (fib *FIBeneficiary) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on FIBeneficiary and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (fib *FIBeneficiary) Validate() error {
+	var errs base.ErrorList
 	if fib.tag != TagFIBeneficiary {
-		return fieldError("tag", ErrValidTagForType, fib.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, fib.tag))
 	}
 	if err := fib.isAlphanumeric(fib.FIToFI.LineOne); err != nil {
-		return fieldError("LineOne", err, fib.FIToFI.LineOne)
+		errs.Add(fieldError("LineOne", err, fib.FIToFI.LineOne))
 	}
 	if err := fib.isAlphanumeric(fib.FIToFI.LineTwo); err != nil {
-		return fieldError("LineTwo", err, fib.FIToFI.LineTwo)
+		errs.Add(fieldError("LineTwo", err, fib.FIToFI.LineTwo))
 	}
 	if err := fib.isAlphanumeric(fib.FIToFI.LineThree); err != nil {
-		return fieldError("LineThree", err, fib.FIToFI.LineThree)
+		errs.Add(fieldError("LineThree", err, fib.FIToFI.LineThree))
 	}
 	if err := fib.isAlphanumeric(fib.FIToFI.LineFour); err != nil {
-		return fieldError("LineFour", err, fib.FIToFI.LineFour)
+		errs.Add(fieldError("LineFour", err, fib.FIToFI.LineFour))
 	}
 	if err := fib.isAlphanumeric(fib.FIToFI.LineFive); err != nil {
-		return fieldError("LineFive", err, fib.FIToFI.LineFive)
+		errs.Add(fieldError("LineFive", err, fib.FIToFI.LineFive))
 	}
 	if err := fib.isAlphanumeric(fib.FIToFI.LineSix); err != nil {
-		return fieldError("LineSix", err, fib.FIToFI.LineSix)
+		errs.Add(fieldError("LineSix", err, fib.FIToFI.LineSix))
 	}
-	return nil
+	return fib.collected(errs)
 }
 
 // LineOneField gets a string of the LineOne field
@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // FIDrawdownDebitAccountAdvice is the financial institution drawdown debit account advice
@@ -138,31 +140,32 @@ func (debitDDAdvice *FIDrawdownDebitAccountAdvice) Format(options FormatOptions)
 // Validate performs WIRE format rule checks on FIDrawdownDebitAccountAdvice and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (debitDDAdvice *FIDrawdownDebitAccountAdvice) Validate() error {
+	var errs base.ErrorList
 	if debitDDAdvice.tag != TagFIDrawdownDebitAccountAdvice {
-		return fieldError("tag", ErrValidTagForType, debitDDAdvice.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, debitDDAdvice.tag))
 	}
 	if err := debitDDAdvice.isAdviceCode(debitDDAdvice.Advice.AdviceCode); err != nil {
-		return fieldError("AdviceCode", err, debitDDAdvice.Advice.AdviceCode)
+		errs.Add(fieldError("AdviceCode", err, debitDDAdvice.Advice.AdviceCode))
 	}
 	if err := debitDDAdvice.isAlphanumeric(debitDDAdvice.Advice.LineOne); err != nil {
-		return fieldError("LineOne", err, debitDDAdvice.Advice.LineOne)
+		errs.Add(fieldError("LineOne", err, debitDDAdvice.Advice.LineOne))
 	}
 	if err := debitDDAdvice.isAlphanumeric(debitDDAdvice.Advice.LineTwo); err != nil {
-		return fieldError("LineTwo", err, debitDDAdvice.Advice.LineTwo)
+		errs.Add(fieldError("LineTwo", err, debitDDAdvice.Advice.LineTwo))
 	}
 	if err := debitDDAdvice.isAlphanumeric(debitDDAdvice.Advice.LineThree); err != nil {
-		return fieldError("LineThree", err, debitDDAdvice.Advice.LineThree)
+		errs.Add(fieldError("LineThree", err, debitDDAdvice.Advice.LineThree))
 	}
 	if err := debitDDAdvice.isAlphanumeric(debitDDAdvice.Advice.LineFour); err != nil {
-		return fieldError("LineFour", err, debitDDAdvice.Advice.LineFour)
+		errs.Add(fieldError("LineFour", err, debitDDAdvice.Advice.LineFour))
 	}
 	if err := debitDDAdvice.isAlphanumeric(debitDDAdvice.Advice.LineFive); err != nil {
-		return fieldError("LineFive", err, debitDDAdvice.Advice.LineFive)
+		errs.Add(fieldError("LineFive", err, debitDDAdvice.Advice.LineFive))
 	}
 	if err := debitDDAdvice.isAlphanumeric(debitDDAdvice.Advice.LineSix); err != nil {
-		return fieldError("LineSix", err, debitDDAdvice.Advice.LineSix)
+		errs.Add(fieldError("LineSix", err, debitDDAdvice.Advice.LineSix))
 	}
-	return nil
+	return debitDDAdvice.collected(errs)
 }
 
 // AdviceCodeField gets a string of the AdviceCode field
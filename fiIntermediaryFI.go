@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // FIIntermediaryFI is the financial institution intermediary financial institution
@@ -136,28 +138,29 @@ func (fiifi *FIIntermediaryFI) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on FIIntermediaryFI and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (fiifi *FIIntermediaryFI) Validate() error {
+	var errs base.ErrorList
 	if fiifi.tag != TagFIIntermediaryFI {
-		return fieldError("tag", ErrValidTagForType, fiifi.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, fiifi.tag))
 	}
 	if err := fiifi.isAlphanumeric(fiifi.FIToFI.LineOne); err != nil {
-		return fieldError("LineOne", err, fiifi.FIToFI.LineOne)
+		errs.Add(fieldError("LineOne", err, fiifi.FIToFI.LineOne))
 	}
 	if err := fiifi.isAlphanumeric(fiifi.FIToFI.LineTwo); err != nil {
-		return fieldError("LineTwo", err, fiifi.FIToFI.LineTwo)
+		errs.Add(fieldError("LineTwo", err, fiifi.FIToFI.LineTwo))
 	}
 	if err := fiifi.isAlphanumeric(fiifi.FIToFI.LineThree); err != nil {
-		return fieldError("LineThree", err, fiifi.FIToFI.LineThree)
+		errs.Add(fieldError("LineThree", err, fiifi.FIToFI.LineThree))
 	}
 	if err := fiifi.isAlphanumeric(fiifi.FIToFI.LineFour); err != nil {
-		return fieldError("LineFour", err, fiifi.FIToFI.LineFour)
+		errs.Add(fieldError("LineFour", err, fiifi.FIToFI.LineFour))
 	}
 	if err := fiifi.isAlphanumeric(fiifi.FIToFI.LineFive); err != nil {
-		return fieldError("LineFive", err, fiifi.FIToFI.LineFive)
+		errs.Add(fieldError("LineFive", err, fiifi.FIToFI.LineFive))
 	}
 	if err := fiifi.isAlphanumeric(fiifi.FIToFI.LineSix); err != nil {
-		return fieldError("LineSix", err, fiifi.FIToFI.LineSix)
+		errs.Add(fieldError("LineSix", err, fiifi.FIToFI.LineSix))
 	}
-	return nil
+	return fiifi.collected(errs)
 }
 
 // LineOneField gets a string of the LineOne field
@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // FIPaymentMethodToBeneficiary is the financial institution payment method to beneficiary
@@ -97,16 +99,17 @@ func (pm *FIPaymentMethodToBeneficiary) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on FIPaymentMethodToBeneficiary and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (pm *FIPaymentMethodToBeneficiary) Validate() error {
+	var errs base.ErrorList
 	if err := pm.fieldInclusion(); err != nil {
-		return err
+		errs.Add(err)
 	}
 	if pm.tag != TagFIPaymentMethodToBeneficiary {
-		return fieldError("tag", ErrValidTagForType, pm.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, pm.tag))
 	}
 	if err := pm.isAlphanumeric(pm.AdditionalInformation); err != nil {
-		return fieldError("AdditionalInformation", err, pm.AdditionalInformation)
+		errs.Add(fieldError("AdditionalInformation", err, pm.AdditionalInformation))
 	}
-	return nil
+	return pm.collected(errs)
 }
 
 // fieldInclusion validate mandatory fields. If fields are
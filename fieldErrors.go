@@ -22,6 +22,14 @@ var (
 	ErrNonAmount = errors.New("is an incorrect amount format")
 	// ErrNonCurrencyCode is returned for an incorrect currency code
 	ErrNonCurrencyCode = errors.New("is not a recognized currency code")
+	// ErrRoutingNumberLength is returned when a routing number isn't 9 digits
+	ErrRoutingNumberLength = errors.New("is not a 9 digit routing number")
+	// ErrRoutingNumberCheckDigit is returned when a routing number's check digit doesn't match its other digits
+	ErrRoutingNumberCheckDigit = errors.New("has an invalid routing number check digit")
+	// ErrBIC is returned when a field isn't an ISO 9362 BIC
+	ErrBIC = errors.New("is not a valid BIC")
+	// ErrIBAN is returned when a field isn't an ISO 13616 IBAN or its check digits are wrong
+	ErrIBAN = errors.New("is not a valid IBAN")
 	// ErrUpperAlpha is returned when a field is not in uppercase
 	ErrUpperAlpha = errors.New("is not uppercase A-Z or 0-9")
 	// ErrFieldInclusion is returned when a field is mandatory and has a default value
@@ -40,11 +48,20 @@ var (
 	ErrValidYear = errors.New("is an invalid year")
 	// ErrValidCentury is returned for an invalid century
 	ErrValidCentury = errors.New("is an invalid century")
+	// ErrCycleDateNotBusinessDay is returned when a cycle date isn't a business day of the Federal Reserve Banks
+	ErrCycleDateNotBusinessDay = errors.New("is not a business day")
+	// ErrCycleDateFuture is returned when a cycle date is after the next cycle date
+	ErrCycleDateFuture = errors.New("is after the next cycle date")
 	// ErrValidDate is returned for an invalid date
 	ErrValidDate = errors.New("is an invalid date format")
 	// ErrInvalidProperty is returned for an invalid type property
 	ErrInvalidProperty = errors.New("is an invalid property")
 
+	// ErrorWire Tag {1130}
+
+	// ErrErrorCategory is returned for an invalid ErrorCategory
+	ErrErrorCategory = errors.New("is an invalid error category")
+
 	// SenderSupplied Tag {1500}
 
 	// ErrFormatVersion is returned for an invalid an invalid FormatVersion
@@ -123,6 +140,13 @@ var (
 	// ErrOptionFName is returned for an invalid name for OriginatorOptionF
 	ErrOptionFName = errors.New("is an invalid name for originator optionF")
 
+	// Reversals and drawdown responses
+
+	// ErrNotReversible is returned when a reversal is built for a message which isn't a value transfer
+	ErrNotReversible = errors.New("is not a reversible transfer")
+	// ErrNotDrawdownRequest is returned when a drawdown response is built for a message which isn't a drawdown request
+	ErrNotDrawdownRequest = errors.New("is not a drawdown request")
+
 	// ErrValidLength is returned for an field with invalid length
 	ErrValidLength = errors.New("is an invalid length")
 
@@ -8,12 +8,17 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+
+	"github.com/moov-io/base"
 )
 
 // File contains the structures of a parsed WIRE File.
 type File struct {
-	ID             string         `json:"id"`
-	FEDWireMessage FEDWireMessage `json:"fedWireMessage"`
+	ID              string           `json:"id"`
+	FEDWireMessages []FEDWireMessage `json:"fedWireMessages"`
+
+	// validateOpts are applied to each FEDWireMessage added to the File which has none of its own
+	validateOpts *ValidateOpts
 }
 
 // NewFile constructs a file template
@@ -27,26 +32,38 @@ func NewFile(opts ...FilePropertyFunc) *File {
 	return f
 }
 
-// SetValidation stores ValidateOpts on the FEDWireMessage's validation rules
+// SetValidation stores ValidateOpts on each FEDWireMessage's validation rules
 func (f *File) SetValidation(opts *ValidateOpts) {
 	if f == nil || opts == nil {
 		return
 	}
-	f.FEDWireMessage.ValidateOptions = opts
+	f.validateOpts = opts
+	for i := range f.FEDWireMessages {
+		f.FEDWireMessages[i].ValidateOptions = opts
+	}
 }
 
-// GetValidation returns validation rules of FEDWireMessage
+// GetValidation returns validation rules of the File
 func (f *File) GetValidation() *ValidateOpts {
-	if f == nil || f.FEDWireMessage.ValidateOptions == nil {
+	if f == nil {
 		return nil
 	}
-	return f.FEDWireMessage.ValidateOptions
+	if f.validateOpts != nil {
+		return f.validateOpts
+	}
+	if len(f.FEDWireMessages) > 0 {
+		return f.FEDWireMessages[0].ValidateOptions
+	}
+	return nil
 }
 
 // AddFEDWireMessage appends a FEDWireMessage to the File
 func (f *File) AddFEDWireMessage(fwm FEDWireMessage) FEDWireMessage {
-	f.FEDWireMessage = fwm
-	return f.FEDWireMessage
+	if fwm.ValidateOptions == nil && f.validateOpts != nil {
+		fwm.ValidateOptions = f.validateOpts
+	}
+	f.FEDWireMessages = append(f.FEDWireMessages, fwm)
+	return fwm
 }
 
 // Create will tabulate and assemble an WIRE file into a valid state.
@@ -58,10 +75,63 @@ func (f *File) Create() error {
 }
 
 // Validate will never modify the file.
+//
+// Each FEDWireMessage is checked and any failures are returned as a base.ErrorList
+// of MessageError values which carry the index of the offending message.
 func (f *File) Validate() error {
-	if err := f.FEDWireMessage.verify(); err != nil {
+	if len(f.FEDWireMessages) == 0 {
+		return newFileError(ErrFileNoMessages)
+	}
+	var errs base.ErrorList
+	for i := range f.FEDWireMessages {
+		if err := f.FEDWireMessages[i].verify(); err != nil {
+			errs.Add(NewMessageError(i, err))
+		}
+	}
+	if errs.Empty() {
+		return nil
+	}
+	return errs
+}
+
+// ValidateAll will never modify the file.
+//
+// Unlike Validate it returns every error of each FEDWireMessage, as found by its ValidateAll, rather than
+// the first. They're returned as one base.ErrorList of MessageError values.
+func (f *File) ValidateAll() error {
+	if len(f.FEDWireMessages) == 0 {
+		return newFileError(ErrFileNoMessages)
+	}
+	var errs base.ErrorList
+	for i := range f.FEDWireMessages {
+		if el, ok := f.FEDWireMessages[i].ValidateAll().(base.ErrorList); ok {
+			for _, err := range el {
+				errs.Add(NewMessageError(i, err))
+			}
+		}
+	}
+	if errs.Empty() {
+		return nil
+	}
+	return errs
+}
+
+// UnmarshalJSON reads a File from JSON. Files written with a single "fedWireMessage"
+// object are also accepted, that message is read as the first in the File.
+func (f *File) UnmarshalJSON(data []byte) error {
+	type file File
+	aux := struct {
+		*file
+		FEDWireMessage *FEDWireMessage `json:"fedWireMessage"`
+	}{
+		file: (*file)(f),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
 		return err
 	}
+	if aux.FEDWireMessage != nil {
+		f.FEDWireMessages = append([]FEDWireMessage{*aux.FEDWireMessage}, f.FEDWireMessages...)
+	}
 	return nil
 }
 
@@ -90,10 +160,12 @@ type FilePropertyFunc func(*File)
 func OutgoingFile() FilePropertyFunc {
 	return func(f *File) {
 		if f != nil {
-			if f.FEDWireMessage.ValidateOptions == nil {
-				f.FEDWireMessage.ValidateOptions = &ValidateOpts{}
+			opts := f.GetValidation()
+			if opts == nil {
+				opts = &ValidateOpts{}
 			}
-			f.FEDWireMessage.ValidateOptions.AllowMissingSenderSupplied = false
+			opts.AllowMissingSenderSupplied = false
+			f.SetValidation(opts)
 		}
 	}
 }
@@ -102,10 +174,12 @@ func OutgoingFile() FilePropertyFunc {
 func IncomingFile() FilePropertyFunc {
 	return func(f *File) {
 		if f != nil {
-			if f.FEDWireMessage.ValidateOptions == nil {
-				f.FEDWireMessage.ValidateOptions = &ValidateOpts{}
+			opts := f.GetValidation()
+			if opts == nil {
+				opts = &ValidateOpts{}
 			}
-			f.FEDWireMessage.ValidateOptions.AllowMissingSenderSupplied = true
+			opts.AllowMissingSenderSupplied = true
+			f.SetValidation(opts)
 		}
 	}
 }
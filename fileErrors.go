@@ -12,8 +12,37 @@ import (
 var (
 	// ErrFileTooLong is the error given when a file exceeds the maximum possible length
 	ErrFileTooLong = errors.New("file exceeds maximum possible number of lines")
+	// ErrFileNoMessages is the error given when a file does not contain any FEDWireMessages
+	ErrFileNoMessages = errors.New("file contains no FEDWireMessages")
 )
 
+// MessageError is the error given when a FEDWireMessage within a File is invalid
+type MessageError struct {
+	Index int   // position of the FEDWireMessage within the File
+	Err   error // the validation error
+}
+
+// NewMessageError creates a new error of the MessageError type. A *ValidationError err is copied to point
+// into the FEDWireMessage at index within the File.
+func NewMessageError(index int, err error) *MessageError {
+	if ve, ok := err.(*ValidationError); ok {
+		err = ve.inMessage(index)
+	}
+	return &MessageError{
+		Index: index,
+		Err:   err,
+	}
+}
+
+func (e *MessageError) Error() string {
+	return fmt.Sprintf("FEDWireMessage[%d]: %v", e.Index, e.Err)
+}
+
+// Unwrap implements the base.UnwrappableError interface for MessageError
+func (e *MessageError) Unwrap() error {
+	return e.Err
+}
+
 // TagWrongLengthErr is the error given when a Tag is the wrong length
 type TagWrongLengthErr struct {
 	Message   string
@@ -5,10 +5,12 @@
 package wire
 
 import (
+	"encoding/json"
 	"os"
 	"path/filepath"
 	"testing"
 
+	"github.com/moov-io/base"
 	"github.com/stretchr/testify/require"
 )
 
@@ -25,5 +27,81 @@ func TestFile__FileFromJSON(t *testing.T) {
 
 	require.NoError(t, err)
 	require.Empty(t, file.ID, "id should not have been set")
-	require.NotNil(t, file.FEDWireMessage.FIAdditionalFIToFI, "FIAdditionalFIToFI shouldn't be nil")
+	require.NotNil(t, file.FEDWireMessages[0].FIAdditionalFIToFI, "FIAdditionalFIToFI shouldn't be nil")
+}
+
+func TestFile__JSONMultipleMessages(t *testing.T) {
+	fd, err := os.Open(filepath.Join("test", "testdata", "fedWireMessage-Batch.txt"))
+	require.NoError(t, err)
+	defer fd.Close()
+
+	file, err := NewReader(fd).Read()
+	require.NoError(t, err)
+
+	bs, err := json.Marshal(file)
+	require.NoError(t, err)
+	require.Contains(t, string(bs), `"fedWireMessages":[`)
+
+	read, err := FileFromJSON(bs)
+	require.NoError(t, err)
+	require.Len(t, read.FEDWireMessages, 3)
+	require.NoError(t, read.Validate())
+}
+
+func TestFile__ValidateMessageIndex(t *testing.T) {
+	file := NewFile()
+	require.ErrorIs(t, file.Validate(), ErrFileNoMessages)
+
+	for i := 0; i < 3; i++ {
+		fwm := mockCustomerTransferData()
+		fwm.Beneficiary = mockBeneficiary()
+		fwm.Originator = mockOriginator()
+		file.AddFEDWireMessage(fwm)
+	}
+	require.NoError(t, file.Validate())
+
+	file.FEDWireMessages[1].Amount = nil
+	file.FEDWireMessages[2].TypeSubType = nil
+
+	err := file.Validate()
+
+	var errs base.ErrorList
+	require.ErrorAs(t, err, &errs)
+	require.Len(t, errs, 2)
+
+	var msgErr *MessageError
+	require.ErrorAs(t, errs[0], &msgErr)
+	require.Equal(t, 1, msgErr.Index)
+	require.ErrorAs(t, errs[1], &msgErr)
+	require.Equal(t, 2, msgErr.Index)
+	require.ErrorIs(t, msgErr, ErrFieldRequired)
+}
+
+func TestFile__ValidateAll(t *testing.T) {
+	file := NewFile()
+	require.ErrorIs(t, file.ValidateAll(), ErrFileNoMessages)
+
+	for i := 0; i < 2; i++ {
+		fwm := mockCustomerTransferData()
+		fwm.Beneficiary = mockBeneficiary()
+		fwm.Originator = mockOriginator()
+		file.AddFEDWireMessage(fwm)
+	}
+	require.NoError(t, file.ValidateAll())
+
+	file.FEDWireMessages[1].Amount = nil
+	file.FEDWireMessages[1].Beneficiary = nil
+
+	var errs base.ErrorList
+	require.ErrorAs(t, file.Validate(), &errs)
+	require.Len(t, errs, 1)
+
+	require.ErrorAs(t, file.ValidateAll(), &errs)
+	require.Len(t, errs, 2)
+	for _, err := range errs {
+		var msgErr *MessageError
+		require.ErrorAs(t, err, &msgErr)
+		require.Equal(t, 1, msgErr.Index)
+		require.ErrorIs(t, err, ErrFieldRequired)
+	}
 }
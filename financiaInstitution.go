@@ -6,6 +6,8 @@ package wire
 
 import (
 	"slices"
+
+	"github.com/moov-io/base"
 )
 
 var (
@@ -33,32 +35,41 @@ type FinancialInstitution struct {
 }
 
 func (fi FinancialInstitution) Validate() error {
+	var errs base.ErrorList
 	if err := fi.fieldInclusion(); err != nil {
-		return err
+		errs.Add(err)
 	}
 
 	// if ID Code is present, make sure it's a valid value
 	if fi.IdentificationCode != "" && !slices.Contains(financialInstitutionIDCodes, fi.IdentificationCode) {
-		return fieldError("IdentificationCode", ErrIdentificationCode, fi.IdentificationCode)
+		errs.Add(fieldError("IdentificationCode", ErrIdentificationCode, fi.IdentificationCode))
 	}
 
 	if err := fi.isAlphanumeric(fi.Identifier); err != nil {
-		return fieldError("Identifier", err, fi.Identifier)
+		errs.Add(fieldError("Identifier", err, fi.Identifier))
+	}
+	if fi.IdentificationCode == FEDRoutingNumber {
+		if err := fi.isRoutingNumber(fi.Identifier); err != nil {
+			errs.Add(fieldError("Identifier", err, fi.Identifier))
+		}
 	}
 	if err := fi.isAlphanumeric(fi.Name); err != nil {
-		return fieldError("Name", err, fi.Name)
+		errs.Add(fieldError("Name", err, fi.Name))
 	}
 	if err := fi.isAlphanumeric(fi.Address.AddressLineOne); err != nil {
-		return fieldError("AddressLineOne", err, fi.Address.AddressLineOne)
+		errs.Add(fieldError("AddressLineOne", err, fi.Address.AddressLineOne))
 	}
 	if err := fi.isAlphanumeric(fi.Address.AddressLineTwo); err != nil {
-		return fieldError("AddressLineTwo", err, fi.Address.AddressLineTwo)
+		errs.Add(fieldError("AddressLineTwo", err, fi.Address.AddressLineTwo))
 	}
 	if err := fi.isAlphanumeric(fi.Address.AddressLineThree); err != nil {
-		return fieldError("AddressLineThree", err, fi.Address.AddressLineThree)
+		errs.Add(fieldError("AddressLineThree", err, fi.Address.AddressLineThree))
+	}
+	if err := fi.isSWIFTIdentifier(fi.IdentificationCode, fi.Identifier); err != nil {
+		errs.Add(fieldError("Identifier", err, fi.Identifier))
 	}
 
-	return nil
+	return fi.collected(errs)
 }
 
 func (fi FinancialInstitution) fieldInclusion() error {
@@ -64,6 +64,29 @@ func TestFinancialInstitution_Validate(t *testing.T) {
 			},
 			wantErr: fieldError("AddressLineThree", ErrNonAlphanumeric, "ℯⰰ").Error(),
 		},
+		{
+			desc: "valid BIC",
+			fi: FinancialInstitution{
+				IdentificationCode: SWIFTBankIdentifierCode,
+				Identifier:         "CHASUS33XXX",
+			},
+		},
+		{
+			desc: "invalid BIC",
+			fi: FinancialInstitution{
+				IdentificationCode: SWIFTBankIdentifierCode,
+				Identifier:         "CHASXX33",
+			},
+			wantErr: fieldError("Identifier", ErrBIC, "CHASXX33").Error(),
+		},
+		{
+			desc: "invalid routing number",
+			fi: FinancialInstitution{
+				IdentificationCode: FEDRoutingNumber,
+				Identifier:         "121042883",
+			},
+			wantErr: fieldError("Identifier", ErrRoutingNumberCheckDigit, "121042883").Error(),
+		},
 	}
 
 	for _, tt := range tests {
@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // GrossAmountRemittanceDocument is the gross amount remittance document
@@ -104,19 +106,20 @@ func (gard *GrossAmountRemittanceDocument) Format(options FormatOptions) string
 // Validate performs WIRE format rule checks on GrossAmountRemittanceDocument and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (gard *GrossAmountRemittanceDocument) Validate() error {
+	var errs base.ErrorList
 	if err := gard.fieldInclusion(); err != nil {
-		return err
+		errs.Add(err)
 	}
 	if gard.tag != TagGrossAmountRemittanceDocument {
-		return fieldError("tag", ErrValidTagForType, gard.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, gard.tag))
 	}
 	if err := gard.isCurrencyCode(gard.RemittanceAmount.CurrencyCode); err != nil {
-		return fieldError("CurrencyCode", err, gard.RemittanceAmount.CurrencyCode)
+		errs.Add(fieldError("CurrencyCode", err, gard.RemittanceAmount.CurrencyCode))
 	}
 	if err := gard.isAmount(gard.RemittanceAmount.Amount); err != nil {
-		return fieldError("Amount", err, gard.RemittanceAmount.Amount)
+		errs.Add(fieldError("Amount", err, gard.RemittanceAmount.Amount))
 	}
-	return nil
+	return gard.collected(errs)
 }
 
 // fieldInclusion validate mandatory fields. If fields are
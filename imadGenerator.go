@@ -0,0 +1,319 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// maxInputSequenceNumber is the largest InputSequenceNumber, which is 6 digits
+const maxInputSequenceNumber = 999999
+
+var (
+	// ErrIMADSequenceExhausted is the error given when every InputSequenceNumber of an InputSource has been
+	// handed out for the cycle date
+	ErrIMADSequenceExhausted = errors.New("has no InputSequenceNumber left for the cycle date")
+	// ErrIMADSequenceUnknown is the error given when releasing an InputSequenceNumber which wasn't handed out
+	ErrIMADSequenceUnknown = errors.New("was not handed out for the cycle date")
+	// ErrIMADCycleDateEarlier is the error given when asking for an IMAD of a cycle date whose sequences were
+	// already dropped, such as after the clock was set back
+	ErrIMADCycleDateEarlier = errors.New("is earlier than the latest cycle date handed out")
+)
+
+// IMADGenerator hands out the InputMessageAccountabilityData of messages sent through an InputSource. Sequence
+// numbers are unique per InputSource and cycle date: they start at 000001 on each cycle date, increase with
+// every message, and are never handed out twice. Implementations are safe for concurrent use.
+type IMADGenerator interface {
+	// Next returns the IMAD of the next InputSequenceNumber of source for the cycle date of a message of business
+	// function code sent at now. The cycle date is the NextCycleDate of the code in the DefaultSchedule, so a
+	// customer transfer sent after its cutoff gets the next cycle date.
+	Next(source, code string, now time.Time) (*InputMessageAccountabilityData, error)
+	// Release records the InputSequenceNumber of an IMAD handed out by Next but not sent as a gap
+	Release(imad *InputMessageAccountabilityData) error
+	// Gaps returns the InputSequenceNumbers of source released on the cycle date of cycleDate, in order
+	Gaps(source string, cycleDate time.Time) ([]int, error)
+}
+
+// imadSequence is the state of the sequence numbers of an InputSource on a cycle date
+type imadSequence struct {
+	Last int   `json:"last"`
+	Gaps []int `json:"gaps,omitempty"`
+}
+
+// imadSequences are the sequences by cycle date, in CycleDateFormat, and InputSource
+type imadSequences map[string]map[string]*imadSequence
+
+// next hands out the next sequence number of source on cycleDate, when earliest is the earliest cycle date a
+// message can be sent for. The sequences of cycle dates before earliest are dropped, and earliest is kept even
+// without sequences, so that the earliest cycle date kept is the latest earliest seen. A cycleDate before it is
+// rejected, as its sequences may have been dropped already and its sequence numbers would be handed out again.
+func (seqs imadSequences) next(source, cycleDate, earliest string) (int, error) {
+	var kept string
+	for date := range seqs {
+		if kept == "" || date < kept {
+			kept = date
+		}
+	}
+	if cycleDate < kept {
+		return 0, fieldError("InputCycleDate", ErrIMADCycleDateEarlier, cycleDate)
+	}
+	// a clock set back leaves the sequences as they are
+	if earliest > kept {
+		for date := range seqs {
+			if date < earliest {
+				delete(seqs, date)
+			}
+		}
+		if seqs[earliest] == nil {
+			seqs[earliest] = make(map[string]*imadSequence)
+		}
+	}
+	if seqs[cycleDate] == nil {
+		seqs[cycleDate] = make(map[string]*imadSequence)
+	}
+	seq := seqs[cycleDate][source]
+	if seq == nil {
+		seq = &imadSequence{}
+		seqs[cycleDate][source] = seq
+	}
+	if seq.Last >= maxInputSequenceNumber {
+		return 0, fieldError("InputSource", ErrIMADSequenceExhausted, source)
+	}
+	seq.Last++
+	return seq.Last, nil
+}
+
+// clone returns a copy of the sequences sharing nothing with them
+func (seqs imadSequences) clone() imadSequences {
+	out := make(imadSequences, len(seqs))
+	for date, sources := range seqs {
+		out[date] = make(map[string]*imadSequence, len(sources))
+		for source, seq := range sources {
+			out[date][source] = &imadSequence{Last: seq.Last, Gaps: slices.Clone(seq.Gaps)}
+		}
+	}
+	return out
+}
+
+// release records n as a gap of source on cycleDate. It returns true if n wasn't a gap already.
+func (seqs imadSequences) release(source, cycleDate string, n int) (bool, error) {
+	seq := seqs[cycleDate][source]
+	if seq == nil || n < 1 || n > seq.Last {
+		return false, fieldError("InputSequenceNumber", ErrIMADSequenceUnknown, n)
+	}
+	i, found := slices.BinarySearch(seq.Gaps, n)
+	if found {
+		return false, nil
+	}
+	seq.Gaps = slices.Insert(seq.Gaps, i, n)
+	return true, nil
+}
+
+// gaps returns a copy of the gaps of source on cycleDate
+func (seqs imadSequences) gaps(source, cycleDate string) []int {
+	if seq := seqs[cycleDate][source]; seq != nil {
+		return slices.Clone(seq.Gaps)
+	}
+	return nil
+}
+
+// validateInputSource checks source can be the InputSource of an IMAD
+func validateInputSource(source string) error {
+	if source == "" {
+		return fieldError("InputSource", ErrFieldRequired, source)
+	}
+	if utf8.RuneCountInString(source) > 8 {
+		return fieldError("InputSource", ErrValidLength, source)
+	}
+	var v validator
+	if err := v.isAlphanumeric(source); err != nil {
+		return fieldError("InputSource", err, source)
+	}
+	return nil
+}
+
+// generatedCycleDates returns the cycle date of a message of business function code sent at now, which is
+// later than today's once the cutoff of code has passed, and the earliest cycle date of any message sent at
+// now, both in CycleDateFormat
+func generatedCycleDates(code string, now time.Time) (cycleDate, earliest string) {
+	return fedSchedule.NextCycleDate(code, now).Format(CycleDateFormat), NextCycleDate(now).Format(CycleDateFormat)
+}
+
+// newGeneratedIMAD returns the IMAD of sequence number n of source on cycleDate
+func newGeneratedIMAD(source, cycleDate string, n int) *InputMessageAccountabilityData {
+	imad := NewInputMessageAccountabilityData()
+	imad.InputCycleDate = cycleDate
+	imad.InputSource = source
+	imad.InputSequenceNumber = fmt.Sprintf("%06d", n)
+	return imad
+}
+
+// parseGeneratedIMAD returns the sequence number of imad
+func parseGeneratedIMAD(imad *InputMessageAccountabilityData) (int, error) {
+	n, err := strconv.Atoi(imad.InputSequenceNumber)
+	if err != nil {
+		return 0, fieldError("InputSequenceNumber", ErrNonNumeric, imad.InputSequenceNumber)
+	}
+	return n, nil
+}
+
+// MemoryIMADGenerator is an IMADGenerator keeping its sequence numbers in memory, so they start over when the
+// process restarts
+type MemoryIMADGenerator struct {
+	mu        sync.Mutex
+	sequences imadSequences
+}
+
+// NewMemoryIMADGenerator returns a new MemoryIMADGenerator
+func NewMemoryIMADGenerator() *MemoryIMADGenerator {
+	return &MemoryIMADGenerator{
+		sequences: make(imadSequences),
+	}
+}
+
+// Next returns the IMAD of the next InputSequenceNumber of source for the cycle date of a message of business
+// function code sent at now
+func (g *MemoryIMADGenerator) Next(source, code string, now time.Time) (*InputMessageAccountabilityData, error) {
+	if err := validateInputSource(source); err != nil {
+		return nil, err
+	}
+	cycleDate, earliest := generatedCycleDates(code, now)
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	n, err := g.sequences.next(source, cycleDate, earliest)
+	if err != nil {
+		return nil, err
+	}
+	return newGeneratedIMAD(source, cycleDate, n), nil
+}
+
+// Release records the InputSequenceNumber of an IMAD handed out by Next but not sent as a gap
+func (g *MemoryIMADGenerator) Release(imad *InputMessageAccountabilityData) error {
+	n, err := parseGeneratedIMAD(imad)
+	if err != nil {
+		return err
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	_, err = g.sequences.release(imad.InputSource, imad.InputCycleDate, n)
+	return err
+}
+
+// Gaps returns the InputSequenceNumbers of source released on the cycle date of cycleDate, in order
+func (g *MemoryIMADGenerator) Gaps(source string, cycleDate time.Time) ([]int, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.sequences.gaps(source, cycleDate.Format(CycleDateFormat)), nil
+}
+
+// FileIMADGenerator is an IMADGenerator saving its sequence numbers to a JSON file after every change, so they
+// carry on when the process restarts. Only one FileIMADGenerator, in one process, may use a file at a time.
+type FileIMADGenerator struct {
+	path string
+
+	mu        sync.Mutex
+	sequences imadSequences
+}
+
+// NewFileIMADGenerator returns a FileIMADGenerator saving to path, loading the sequence numbers saved there
+// if the file exists
+func NewFileIMADGenerator(path string) (*FileIMADGenerator, error) {
+	g := &FileIMADGenerator{
+		path:      path,
+		sequences: make(imadSequences),
+	}
+	bs, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return g, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading IMAD sequences: %w", err)
+	}
+	if err := json.Unmarshal(bs, &g.sequences); err != nil {
+		return nil, fmt.Errorf("reading IMAD sequences from %s: %w", path, err)
+	}
+	if g.sequences == nil {
+		g.sequences = make(imadSequences)
+	}
+	return g, nil
+}
+
+// Next returns the IMAD of the next InputSequenceNumber of source for the cycle date of a message of business
+// function code sent at now. The sequence number is saved before it's returned, so it's never handed out again.
+func (g *FileIMADGenerator) Next(source, code string, now time.Time) (*InputMessageAccountabilityData, error) {
+	if err := validateInputSource(source); err != nil {
+		return nil, err
+	}
+	cycleDate, earliest := generatedCycleDates(code, now)
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	// the sequences are restored as they were when they can't be saved, including those next drops
+	before := g.sequences.clone()
+	n, err := g.sequences.next(source, cycleDate, earliest)
+	if err == nil {
+		err = g.save()
+	}
+	if err != nil {
+		g.sequences = before
+		return nil, err
+	}
+	return newGeneratedIMAD(source, cycleDate, n), nil
+}
+
+// Release records the InputSequenceNumber of an IMAD handed out by Next but not sent as a gap
+func (g *FileIMADGenerator) Release(imad *InputMessageAccountabilityData) error {
+	n, err := parseGeneratedIMAD(imad)
+	if err != nil {
+		return err
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	before := g.sequences.clone()
+	changed, err := g.sequences.release(imad.InputSource, imad.InputCycleDate, n)
+	if err != nil || !changed {
+		return err
+	}
+	if err := g.save(); err != nil {
+		g.sequences = before
+		return err
+	}
+	return nil
+}
+
+// Gaps returns the InputSequenceNumbers of source released on the cycle date of cycleDate, in order
+func (g *FileIMADGenerator) Gaps(source string, cycleDate time.Time) ([]int, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.sequences.gaps(source, cycleDate.Format(CycleDateFormat)), nil
+}
+
+// save writes the sequences to a temporary file and renames it over the file, so a crash never leaves a
+// partly written file behind
+func (g *FileIMADGenerator) save() error {
+	bs, err := json.Marshal(g.sequences)
+	if err != nil {
+		return fmt.Errorf("saving IMAD sequences: %w", err)
+	}
+	tmp := g.path + ".tmp"
+	if err := os.WriteFile(tmp, bs, 0600); err != nil {
+		return fmt.Errorf("saving IMAD sequences: %w", err)
+	}
+	if err := os.Rename(tmp, g.path); err != nil {
+		return fmt.Errorf("saving IMAD sequences: %w", err)
+	}
+	return nil
+}
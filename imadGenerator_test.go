@@ -0,0 +1,194 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testIMADGenerator(t *testing.T, g IMADGenerator) {
+	t.Helper()
+
+	now := eastern(2023, time.July, 5, 10)
+	imad, err := g.Next("Source08", BankTransfer, now)
+	require.NoError(t, err)
+	require.Equal(t, "20230705", imad.InputCycleDate)
+	require.Equal(t, "Source08", imad.InputSource)
+	require.Equal(t, "000001", imad.InputSequenceNumber)
+	require.Equal(t, "{1520}20230705Source08000001", imad.String())
+
+	// each InputSource has its own sequence
+	imad, err = g.Next("Source09", BankTransfer, now)
+	require.NoError(t, err)
+	require.Equal(t, "000001", imad.InputSequenceNumber)
+
+	imad, err = g.Next("Source08", BankTransfer, now)
+	require.NoError(t, err)
+	require.Equal(t, "000002", imad.InputSequenceNumber)
+
+	// released numbers are gaps and never handed out again
+	require.NoError(t, g.Release(imad))
+	require.NoError(t, g.Release(imad))
+	gaps, err := g.Gaps("Source08", now)
+	require.NoError(t, err)
+	require.Equal(t, []int{2}, gaps)
+
+	imad, err = g.Next("Source08", BankTransfer, now)
+	require.NoError(t, err)
+	require.Equal(t, "000003", imad.InputSequenceNumber)
+
+	unknown := *imad
+	unknown.InputSequenceNumber = "000004"
+	require.ErrorIs(t, g.Release(&unknown), ErrIMADSequenceUnknown)
+	unknown.InputSequenceNumber = "00000A"
+	require.ErrorIs(t, g.Release(&unknown), ErrNonNumeric)
+
+	// customer transfers get the next cycle date after their 18:00 cutoff, while bank transfers keep today's
+	imad, err = g.Next("Source09", CustomerTransfer, eastern(2023, time.July, 5, 18).Add(30*time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, "20230706", imad.InputCycleDate)
+	require.Equal(t, "000001", imad.InputSequenceNumber)
+	imad, err = g.Next("Source09", BankTransfer, eastern(2023, time.July, 5, 18).Add(35*time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, "20230705", imad.InputCycleDate)
+	require.Equal(t, "000002", imad.InputSequenceNumber)
+
+	// the sequences reset on the next cycle date, once the Funds Service has closed for the day
+	imad, err = g.Next("Source08", BankTransfer, eastern(2023, time.July, 5, 20))
+	require.NoError(t, err)
+	require.Equal(t, "20230706", imad.InputCycleDate)
+	require.Equal(t, "000001", imad.InputSequenceNumber)
+	gaps, err = g.Gaps("Source08", now)
+	require.NoError(t, err)
+	require.Empty(t, gaps)
+
+	// and the earlier cycle date can't be handed out again, as when the clock is set back
+	_, err = g.Next("Source08", BankTransfer, now)
+	require.ErrorIs(t, err, ErrIMADCycleDateEarlier)
+	_, err = g.Next("Source10", BankTransfer, now)
+	require.ErrorIs(t, err, ErrIMADCycleDateEarlier)
+
+	// invalid sources
+	_, err = g.Next("", BankTransfer, now)
+	require.ErrorIs(t, err, ErrFieldRequired)
+	_, err = g.Next("Source089", BankTransfer, now)
+	require.ErrorIs(t, err, ErrValidLength)
+	_, err = g.Next("Source®", BankTransfer, now)
+	require.ErrorIs(t, err, ErrNonAlphanumeric)
+}
+
+func TestMemoryIMADGenerator(t *testing.T) {
+	testIMADGenerator(t, NewMemoryIMADGenerator())
+}
+
+func TestMemoryIMADGenerator_Concurrent(t *testing.T) {
+	g := NewMemoryIMADGenerator()
+	now := time.Now()
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	seen := make(map[string]bool)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				imad, err := g.Next("Source08", BankTransfer, now)
+				if !assert.NoError(t, err) {
+					return
+				}
+				mu.Lock()
+				seen[imad.InputSequenceNumber] = true
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	require.Len(t, seen, 1000)
+}
+
+func TestMemoryIMADGenerator_Exhausted(t *testing.T) {
+	g := NewMemoryIMADGenerator()
+	now := eastern(2023, time.July, 5, 10)
+	g.sequences["20230705"] = map[string]*imadSequence{"Source08": {Last: 999998}}
+
+	imad, err := g.Next("Source08", BankTransfer, now)
+	require.NoError(t, err)
+	require.Equal(t, "999999", imad.InputSequenceNumber)
+
+	_, err = g.Next("Source08", BankTransfer, now)
+	require.ErrorIs(t, err, ErrIMADSequenceExhausted)
+}
+
+func TestFileIMADGenerator(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "imad.json")
+
+	g, err := NewFileIMADGenerator(path)
+	require.NoError(t, err)
+	testIMADGenerator(t, g)
+
+	// a new generator carries on from the saved sequences
+	now := eastern(2023, time.July, 5, 20)
+	g, err = NewFileIMADGenerator(path)
+	require.NoError(t, err)
+	imad, err := g.Next("Source08", BankTransfer, now)
+	require.NoError(t, err)
+	require.Equal(t, "000002", imad.InputSequenceNumber)
+	require.NoError(t, g.Release(imad))
+
+	g, err = NewFileIMADGenerator(path)
+	require.NoError(t, err)
+	gaps, err := g.Gaps("Source08", eastern(2023, time.July, 6, 0))
+	require.NoError(t, err)
+	require.Equal(t, []int{2}, gaps)
+}
+
+func TestFileIMADGenerator_Errors(t *testing.T) {
+	dir := t.TempDir()
+
+	path := filepath.Join(dir, "imad.json")
+	require.NoError(t, os.WriteFile(path, []byte("{"), 0600))
+	_, err := NewFileIMADGenerator(path)
+	require.ErrorContains(t, err, "reading IMAD sequences")
+
+	// a number which couldn't be saved is handed out again
+	g, err := NewFileIMADGenerator(filepath.Join(dir, "missing", "imad.json"))
+	require.NoError(t, err)
+	now := eastern(2023, time.July, 5, 10)
+	_, err = g.Next("Source08", BankTransfer, now)
+	require.ErrorContains(t, err, "saving IMAD sequences")
+
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "missing"), 0700))
+	imad, err := g.Next("Source08", BankTransfer, now)
+	require.NoError(t, err)
+	require.Equal(t, "000001", imad.InputSequenceNumber)
+	require.NoError(t, g.Release(imad))
+
+	// the sequences of the earlier cycle date are kept when those of a later one can't be saved
+	require.NoError(t, os.RemoveAll(filepath.Join(dir, "missing")))
+	_, err = g.Next("Source08", BankTransfer, eastern(2023, time.July, 6, 10))
+	require.ErrorContains(t, err, "saving IMAD sequences")
+	gaps, err := g.Gaps("Source08", now)
+	require.NoError(t, err)
+	require.Equal(t, []int{1}, gaps)
+
+	// as are the gaps when a release can't be saved
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "missing"), 0700))
+	imad, err = g.Next("Source08", BankTransfer, now)
+	require.NoError(t, err)
+	require.Equal(t, "000002", imad.InputSequenceNumber)
+	require.NoError(t, os.RemoveAll(filepath.Join(dir, "missing")))
+	require.ErrorContains(t, g.Release(imad), "saving IMAD sequences")
+	gaps, err = g.Gaps("Source08", now)
+	require.NoError(t, err)
+	require.Equal(t, []int{1}, gaps)
+}
@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // InputMessageAccountabilityData (IMAD) {1520}
@@ -75,25 +77,35 @@ func (imad *InputMessageAccountabilityData) String() string {
 	return buf.String()
 }
 
+// Reference returns the IMAD as a single reference, as written to a PreviousMessageIdentifier, e.g.
+// 20190410Source08000001. It's empty if the IMAD is nil or has no InputCycleDate.
+func (imad *InputMessageAccountabilityData) Reference() string {
+	if imad == nil || imad.InputCycleDate == "" {
+		return ""
+	}
+	return imad.InputCycleDate + imad.InputSource + imad.InputSequenceNumber
+}
+
 // Validate performs WIRE format rule checks on InputMessageAccountabilityData and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (imad *InputMessageAccountabilityData) Validate() error {
+	var errs base.ErrorList
 	if err := imad.fieldInclusion(); err != nil {
-		return err
+		errs.Add(err)
 	}
 	if imad.tag != TagInputMessageAccountabilityData {
-		return fieldError("tag", ErrValidTagForType, imad.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, imad.tag))
 	}
-	if err := imad.validateDate(imad.InputCycleDate); err != nil {
-		return fieldError("InputCycleDate", err, imad.InputCycleDate)
+	if err := imad.isCycleDate(imad.InputCycleDate); err != nil {
+		errs.Add(fieldError("InputCycleDate", err, imad.InputCycleDate))
 	}
 	if err := imad.isAlphanumeric(imad.InputSource); err != nil {
-		return fieldError("InputSource", err, imad.InputSource)
+		errs.Add(fieldError("InputSource", err, imad.InputSource))
 	}
 	if err := imad.isNumeric(imad.InputSequenceNumber); err != nil {
-		return fieldError("InputSequenceNumber", err, imad.InputSequenceNumber)
+		errs.Add(fieldError("InputSequenceNumber", err, imad.InputSequenceNumber))
 	}
-	return nil
+	return imad.collected(errs)
 }
 
 // fieldInclusion validate mandatory fields. If fields are
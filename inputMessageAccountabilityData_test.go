@@ -11,7 +11,7 @@ import (
 // mockInputMessageAccountabilityData creates a mockInputMessageAccountabilityData
 func mockInputMessageAccountabilityData() *InputMessageAccountabilityData {
 	imad := NewInputMessageAccountabilityData()
-	imad.InputCycleDate = time.Now().Format("20060102")
+	imad.InputCycleDate = NextCycleDate(time.Now()).Format(CycleDateFormat)
 	imad.InputSource = "Source08"
 	imad.InputSequenceNumber = "000001"
 	return imad
@@ -108,3 +108,15 @@ func TestInputMessageAccountabilityDataInputCycleDateError(t *testing.T) {
 
 	require.EqualError(t, imad.Validate(), fieldError("InputCycleDate", ErrValidDate, imad.InputCycleDate).Error())
 }
+
+// TestInputMessageAccountabilityDataReference validates the reference of an InputMessageAccountabilityData
+func TestInputMessageAccountabilityDataReference(t *testing.T) {
+	imad := mockInputMessageAccountabilityData()
+	imad.InputCycleDate = "20190410"
+	require.Equal(t, "20190410Source08000001", imad.Reference())
+
+	imad.InputCycleDate = ""
+	require.Empty(t, imad.Reference())
+	imad = nil
+	require.Empty(t, imad.Reference())
+}
@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // InstitutionAccount is the institution account
@@ -136,31 +138,35 @@ func (iAccount *InstitutionAccount) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on InstitutionAccount and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (iAccount *InstitutionAccount) Validate() error {
+	var errs base.ErrorList
 	if err := iAccount.fieldInclusion(); err != nil {
-		return err
+		errs.Add(err)
 	}
 	if iAccount.tag != TagInstitutionAccount {
-		return fieldError("tag", ErrValidTagForType, iAccount.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, iAccount.tag))
 	}
 	if err := iAccount.isAlphanumeric(iAccount.CoverPayment.SwiftFieldTag); err != nil {
-		return fieldError("SwiftFieldTag", err, iAccount.CoverPayment.SwiftFieldTag)
+		errs.Add(fieldError("SwiftFieldTag", err, iAccount.CoverPayment.SwiftFieldTag))
 	}
 	if err := iAccount.isAlphanumeric(iAccount.CoverPayment.SwiftLineOne); err != nil {
-		return fieldError("SwiftLineOne", err, iAccount.CoverPayment.SwiftLineOne)
+		errs.Add(fieldError("SwiftLineOne", err, iAccount.CoverPayment.SwiftLineOne))
 	}
 	if err := iAccount.isAlphanumeric(iAccount.CoverPayment.SwiftLineTwo); err != nil {
-		return fieldError("SwiftLineTwo", err, iAccount.CoverPayment.SwiftLineTwo)
+		errs.Add(fieldError("SwiftLineTwo", err, iAccount.CoverPayment.SwiftLineTwo))
 	}
 	if err := iAccount.isAlphanumeric(iAccount.CoverPayment.SwiftLineThree); err != nil {
-		return fieldError("SwiftLineThree", err, iAccount.CoverPayment.SwiftLineThree)
+		errs.Add(fieldError("SwiftLineThree", err, iAccount.CoverPayment.SwiftLineThree))
 	}
 	if err := iAccount.isAlphanumeric(iAccount.CoverPayment.SwiftLineFour); err != nil {
-		return fieldError("SwiftLineFour", err, iAccount.CoverPayment.SwiftLineFour)
+		errs.Add(fieldError("SwiftLineFour", err, iAccount.CoverPayment.SwiftLineFour))
 	}
 	if err := iAccount.isAlphanumeric(iAccount.CoverPayment.SwiftLineFive); err != nil {
-		return fieldError("SwiftLineFive", err, iAccount.CoverPayment.SwiftLineFive)
+		errs.Add(fieldError("SwiftLineFive", err, iAccount.CoverPayment.SwiftLineFive))
 	}
-	return nil
+	if err := iAccount.validateSwiftParty(iAccount.CoverPayment); err != nil {
+		errs.Add(err)
+	}
+	return iAccount.collected(errs)
 }
 
 // fieldInclusion validate mandatory fields. If fields are
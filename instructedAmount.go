@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // InstructedAmount is the InstructedAmount of the wire
@@ -103,19 +105,20 @@ func (ia *InstructedAmount) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on InstructedAmount and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (ia *InstructedAmount) Validate() error {
+	var errs base.ErrorList
 	if err := ia.fieldInclusion(); err != nil {
-		return err
+		errs.Add(err)
 	}
 	if ia.tag != TagInstructedAmount {
-		return fieldError("tag", ErrValidTagForType, ia.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, ia.tag))
 	}
 	if err := ia.isCurrencyCode(ia.CurrencyCode); err != nil {
-		return fieldError("CurrencyCode", err, ia.CurrencyCode)
+		errs.Add(fieldError("CurrencyCode", err, ia.CurrencyCode))
 	}
 	if err := ia.isAmount(ia.Amount); err != nil {
-		return fieldError("Amount", err, ia.Amount)
+		errs.Add(fieldError("Amount", err, ia.Amount))
 	}
-	return nil
+	return ia.collected(errs)
 }
 
 // fieldInclusion validate mandatory fields. If fields are
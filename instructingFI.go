@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // InstructingFI is the instructing financial institution
@@ -130,15 +132,21 @@ func (ifi *InstructingFI) Format(options FormatOptions) string {
 // The first error encountered is returned and stops that parsing.
 // If ID Code is present, Identifier is mandatory and vice versa.
 func (ifi *InstructingFI) Validate() error {
+	var errs base.ErrorList
 	if ifi.tag != TagInstructingFI {
-		return fieldError("tag", ErrValidTagForType, ifi.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, ifi.tag))
 	}
 
 	if err := ifi.FinancialInstitution.Validate(); err != nil {
-		return err
+		errs.Add(err)
 	}
 
-	return nil
+	return ifi.FinancialInstitution.collected(errs)
+}
+
+// setValidateOpts sets the ValidateOpts the checks of the FinancialInstitution follow
+func (ifi *InstructingFI) setValidateOpts(opts *ValidateOpts) {
+	ifi.FinancialInstitution.setValidateOpts(opts)
 }
 
 // IdentificationCodeField gets a string of the IdentificationCode field
@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // IntermediaryInstitution is the intermediary institution
@@ -136,31 +138,35 @@ func (ii *IntermediaryInstitution) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on IntermediaryInstitution and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (ii *IntermediaryInstitution) Validate() error {
+	var errs base.ErrorList
 	if err := ii.fieldInclusion(); err != nil {
-		return err
+		errs.Add(err)
 	}
 	if ii.tag != TagIntermediaryInstitution {
-		return fieldError("tag", ErrValidTagForType, ii.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, ii.tag))
 	}
 	if err := ii.isAlphanumeric(ii.CoverPayment.SwiftFieldTag); err != nil {
-		return fieldError("SwiftFieldTag", err, ii.CoverPayment.SwiftFieldTag)
+		errs.Add(fieldError("SwiftFieldTag", err, ii.CoverPayment.SwiftFieldTag))
 	}
 	if err := ii.isAlphanumeric(ii.CoverPayment.SwiftLineOne); err != nil {
-		return fieldError("SwiftLineOne", err, ii.CoverPayment.SwiftLineOne)
+		errs.Add(fieldError("SwiftLineOne", err, ii.CoverPayment.SwiftLineOne))
 	}
 	if err := ii.isAlphanumeric(ii.CoverPayment.SwiftLineTwo); err != nil {
-		return fieldError("SwiftLineTwo", err, ii.CoverPayment.SwiftLineTwo)
+		errs.Add(fieldError("SwiftLineTwo", err, ii.CoverPayment.SwiftLineTwo))
 	}
 	if err := ii.isAlphanumeric(ii.CoverPayment.SwiftLineThree); err != nil {
-		return fieldError("SwiftLineThree", err, ii.CoverPayment.SwiftLineThree)
+		errs.Add(fieldError("SwiftLineThree", err, ii.CoverPayment.SwiftLineThree))
 	}
 	if err := ii.isAlphanumeric(ii.CoverPayment.SwiftLineFour); err != nil {
-		return fieldError("SwiftLineFour", err, ii.CoverPayment.SwiftLineFour)
+		errs.Add(fieldError("SwiftLineFour", err, ii.CoverPayment.SwiftLineFour))
 	}
 	if err := ii.isAlphanumeric(ii.CoverPayment.SwiftLineFive); err != nil {
-		return fieldError("SwiftLineFive", err, ii.CoverPayment.SwiftLineFive)
+		errs.Add(fieldError("SwiftLineFive", err, ii.CoverPayment.SwiftLineFive))
 	}
-	return nil
+	if err := ii.validateSwiftParty(ii.CoverPayment); err != nil {
+		errs.Add(err)
+	}
+	return ii.collected(errs)
 }
 
 // fieldInclusion validate mandatory fields. If fields are
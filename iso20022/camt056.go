@@ -0,0 +1,241 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package iso20022
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	"github.com/moov-io/wire"
+)
+
+// Camt056Namespace is the XML namespace of camt.056.001.08 documents
+const Camt056Namespace = "urn:iso:std:iso:20022:tech:xsd:camt.056.001.08"
+
+// Camt056 is a camt.056.001.08 FIToFIPaymentCancellationRequest document, the ISO 20022 equivalent of a
+// request for reversal (sub type 01) or request for reversal of a prior day transfer (sub type 07).
+type Camt056 struct {
+	XMLName             xml.Name                         `xml:"urn:iso:std:iso:20022:tech:xsd:camt.056.001.08 Document"`
+	CancellationRequest FIToFIPaymentCancellationRequest `xml:"FIToFIPmtCxlReq"`
+}
+
+// FIToFIPaymentCancellationRequest is the FIToFIPmtCxlReq message of a camt.056 document
+type FIToFIPaymentCancellationRequest struct {
+	Assignment CaseAssignment          `xml:"Assgnmt"`
+	Underlying []UnderlyingTransaction `xml:"Undrlyg"`
+}
+
+// CaseAssignment identifies the cancellation request and the agents sending and receiving it
+type CaseAssignment struct {
+	ID               string       `xml:"Id"`
+	Assigner         PartyOrAgent `xml:"Assgnr"`
+	Assignee         PartyOrAgent `xml:"Assgne"`
+	CreationDateTime string       `xml:"CreDtTm"`
+}
+
+// UnderlyingTransaction holds the transactions a cancellation is requested for
+type UnderlyingTransaction struct {
+	Transactions []PaymentCancellationTransaction `xml:"TxInf"`
+}
+
+// PaymentCancellationTransaction is the TxInf of a camt.056 document
+type PaymentCancellationTransaction struct {
+	CancellationID                    string                        `xml:"CxlId,omitempty"`
+	OriginalInstructionID             string                        `xml:"OrgnlInstrId,omitempty"`
+	OriginalEndToEndID                string                        `xml:"OrgnlEndToEndId,omitempty"`
+	OriginalUETR                      string                        `xml:"OrgnlUETR,omitempty"`
+	OriginalInterbankSettlementAmount *ActiveCurrencyAndAmount      `xml:"OrgnlIntrBkSttlmAmt,omitempty"`
+	OriginalInterbankSettlementDate   string                        `xml:"OrgnlIntrBkSttlmDt,omitempty"`
+	CancellationReasonInformation     []ReasonInformation           `xml:"CxlRsnInf,omitempty"`
+	OriginalTransactionReference      *OriginalTransactionReference `xml:"OrgnlTxRef,omitempty"`
+
+	// Unmapped holds the elements read from a document which have no Fedwire equivalent
+	Unmapped []UnmappedElement `xml:",any"`
+}
+
+// NewCamt056 converts a request for reversal (sub type 01 or 07 of a CTP or SVC) into a camt.056 document.
+//
+// {3500} PreviousMessageIdentifier identifies the original transfer and {9000} ServiceMessage becomes the
+// cancellation reason. The originator, beneficiary, their financial institutions, {3610} LocalInstrument and
+// the remittance information describe the original transaction.
+func NewCamt056(fwm *wire.FEDWireMessage) (*Camt056, []Warning, error) {
+	if err := requireTags(fwm); err != nil {
+		return nil, nil, err
+	}
+	switch sub := fwm.TypeSubType.SubTypeCode; sub {
+	case wire.RequestReversal, wire.RequestReversalPriorDayTransfer:
+	default:
+		return nil, nil, fmt.Errorf("%w: camt.056 carries requests for reversal, not sub type %s", ErrUnsupportedMessage, sub)
+	}
+	bfc := fwm.BusinessFunctionCode.BusinessFunctionCode
+	switch bfc {
+	case wire.CustomerTransferPlus, wire.BFCServiceMessage:
+	default:
+		return nil, nil, fmt.Errorf("%w: requests for reversal are customer transfers or service messages, not %s", ErrUnsupportedMessage, bfc)
+	}
+	if fwm.PreviousMessageIdentifier == nil {
+		return nil, nil, missingTag(wire.TagPreviousMessageIdentifier, "PreviousMessageIdentifier")
+	}
+
+	c := &converter{message: "camt.056"}
+	c.envelope(fwm)
+	c.unmapped(fwm.TypeSubType.TypeCode != wire.FundsTransfer, wire.TagTypeSubType, "TypeSubType.TypeCode")
+
+	amount, err := c.settlementAmount(fwm)
+	if err != nil {
+		return nil, nil, err
+	}
+	sender := fedAgent(fwm.SenderDepositoryInstitution.SenderABANumber, fwm.SenderDepositoryInstitution.SenderShortName)
+	receiver := fedAgent(fwm.ReceiverDepositoryInstitution.ReceiverABANumber, fwm.ReceiverDepositoryInstitution.ReceiverShortName)
+
+	originalID, originalUETR, originalDate := originalReference(fwm)
+	c.checkReversalSubType(fwm, true, originalDate)
+
+	pmtID := c.paymentIdentification(fwm)
+	tx := PaymentCancellationTransaction{
+		CancellationID:                    pmtID.InstructionID,
+		OriginalInstructionID:             originalID,
+		OriginalEndToEndID:                pmtID.EndToEndID,
+		OriginalUETR:                      originalUETR,
+		OriginalInterbankSettlementAmount: &amount,
+		OriginalInterbankSettlementDate:   originalDate,
+	}
+	if sm := fwm.ServiceMessage; sm != nil {
+		lines := trimLines(sm.LineOne, sm.LineTwo, sm.LineThree, sm.LineFour, sm.LineFive, sm.LineSix,
+			sm.LineSeven, sm.LineEight, sm.LineNine, sm.LineTen, sm.LineEleven, sm.LineTwelve)
+		if len(lines) > 0 {
+			tx.CancellationReasonInformation = []ReasonInformation{{AdditionalInformation: lines}}
+		}
+	}
+
+	ref := &OriginalTransactionReference{
+		PaymentTypeInformation: localInstrument(fwm),
+		RemittanceInformation:  c.remittanceInformation(fwm),
+	}
+	if bfc == wire.CustomerTransferPlus && ref.PaymentTypeInformation == nil {
+		c.warn(wire.TagBusinessFunctionCode, "BusinessFunctionCode.BusinessFunctionCode", "a customer transfer plus without a local instrument reads back as a service message")
+	}
+	if fi := fwm.OriginatorFI; fi != nil {
+		ref.DebtorAgent = c.agent(fi.FinancialInstitution)
+	}
+	if fi := fwm.BeneficiaryFI; fi != nil {
+		ref.CreditorAgent = c.agent(fi.FinancialInstitution)
+	}
+	if fwm.Originator != nil {
+		ref.Debtor, ref.DebtorAccount = c.partyOrAgent(false, fwm.Originator.Personal)
+		c.unmapped(fwm.OriginatorOptionF != nil, wire.TagOriginatorOptionF, "OriginatorOptionF")
+	} else if fwm.OriginatorOptionF != nil {
+		party, acct := c.optionF(fwm.OriginatorOptionF)
+		ref.Debtor, ref.DebtorAccount = &PartyOrAgent{Party: &party}, acct
+	}
+	if fwm.Beneficiary != nil {
+		ref.Creditor, ref.CreditorAccount = c.partyOrAgent(false, fwm.Beneficiary.Personal)
+	}
+	if ref.PaymentTypeInformation != nil || ref.RemittanceInformation != nil || ref.Debtor != nil || ref.DebtorAccount != nil ||
+		ref.DebtorAgent != nil || ref.CreditorAgent != nil || ref.Creditor != nil || ref.CreditorAccount != nil {
+		tx.OriginalTransactionReference = ref
+	}
+
+	c.unmapped(fwm.PaymentNotification != nil, wire.TagPaymentNotification, "PaymentNotification")
+	c.unmapped(fwm.Charges != nil, wire.TagCharges, "Charges")
+	c.unmapped(fwm.InstructedAmount != nil, wire.TagInstructedAmount, "InstructedAmount")
+	c.unmapped(fwm.ExchangeRate != nil, wire.TagExchangeRate, "ExchangeRate")
+	c.unmapped(fwm.BeneficiaryIntermediaryFI != nil, wire.TagBeneficiaryIntermediaryFI, "BeneficiaryIntermediaryFI")
+	c.unmapped(fwm.InstructingFI != nil, wire.TagInstructingFI, "InstructingFI")
+	c.unmapped(fwm.AccountDebitedDrawdown != nil, wire.TagAccountDebitedDrawdown, "AccountDebitedDrawdown")
+	c.unmapped(fwm.AccountCreditedDrawdown != nil, wire.TagAccountCreditedDrawdown, "AccountCreditedDrawdown")
+	c.unmapped(fwm.UnstructuredAddenda != nil, wire.TagUnstructuredAddenda, "UnstructuredAddenda")
+	c.unmapped(fwm.RelatedRemittance != nil, wire.TagRelatedRemittance, "RelatedRemittance")
+	c.fiToFiInformation(fwm)
+	c.coverPayment(fwm)
+
+	doc := &Camt056{
+		CancellationRequest: FIToFIPaymentCancellationRequest{
+			Assignment: CaseAssignment{
+				ID:               c.groupHeader(fwm).MessageID,
+				Assigner:         PartyOrAgent{Agent: sender},
+				Assignee:         PartyOrAgent{Agent: receiver},
+				CreationDateTime: creationDateTime(),
+			},
+			Underlying: []UnderlyingTransaction{{Transactions: []PaymentCancellationTransaction{tx}}},
+		},
+	}
+	return doc, c.warnings, nil
+}
+
+// FEDWireMessage converts the document's cancellation request into a request for reversal.
+//
+// The request is a CustomerTransferPlus when the original transaction has a local instrument and a
+// ServiceMessage otherwise. It's a request for reversal of a prior day transfer (07) when the original settled
+// before the cycle date of the request's IMAD.
+func (doc *Camt056) FEDWireMessage() (*wire.FEDWireMessage, []Warning, error) {
+	var txs []PaymentCancellationTransaction
+	for _, u := range doc.CancellationRequest.Underlying {
+		txs = append(txs, u.Transactions...)
+	}
+	if len(txs) != 1 {
+		return nil, nil, fmt.Errorf("%w: Fedwire messages carry one transaction, found %d", ErrUnsupportedMessage, len(txs))
+	}
+	tx := txs[0]
+	path := "FIToFIPmtCxlReq/Undrlyg/TxInf"
+	assignment := doc.CancellationRequest.Assignment
+
+	c := &converter{message: "camt.056"}
+	fwm := c.newMessage(assignment.ID, tx.CancellationID, tx.OriginalEndToEndID)
+	if tx.OriginalInterbankSettlementAmount == nil {
+		return nil, nil, missingElement(path + "/OrgnlIntrBkSttlmAmt")
+	}
+	if err := c.setAmount(fwm, path+"/OrgnlIntrBkSttlmAmt", *tx.OriginalInterbankSettlementAmount); err != nil {
+		return nil, nil, err
+	}
+	if err := c.setDepositoryInstitutions(fwm, "FIToFIPmtCxlReq/Assgnmt/Assgnr/Agt", assignment.Assigner.Agent,
+		"FIToFIPmtCxlReq/Assgnmt/Assgne/Agt", assignment.Assignee.Agent); err != nil {
+		return nil, nil, err
+	}
+	if err := c.setOriginalReference(fwm, path+"/OrgnlInstrId", tx.OriginalInstructionID, path+"/OrgnlUETR", tx.OriginalUETR); err != nil {
+		return nil, nil, err
+	}
+	fwm.TypeSubType.SubTypeCode = reversalSubType(true, tx.OriginalInterbankSettlementDate, settlementDate(fwm))
+
+	if lines := c.reasonLines(path+"/CxlRsnInf", tx.CancellationReasonInformation); len(lines) > 0 {
+		lines = c.lines(wire.TagServiceMessage, "ServiceMessage", lines, 12, 35)
+		sm := wire.NewServiceMessage()
+		sm.LineOne, sm.LineTwo, sm.LineThree, sm.LineFour = lines[0], lines[1], lines[2], lines[3]
+		sm.LineFive, sm.LineSix, sm.LineSeven, sm.LineEight = lines[4], lines[5], lines[6], lines[7]
+		sm.LineNine, sm.LineTen, sm.LineEleven, sm.LineTwelve = lines[8], lines[9], lines[10], lines[11]
+		fwm.ServiceMessage = sm
+	}
+
+	fwm.BusinessFunctionCode.BusinessFunctionCode = wire.BFCServiceMessage
+	if ref := tx.OriginalTransactionReference; ref != nil {
+		if pti := ref.PaymentTypeInformation; pti != nil && pti.LocalInstrument != nil {
+			fwm.BusinessFunctionCode.BusinessFunctionCode = wire.CustomerTransferPlus
+			c.setLocalInstrument(fwm, schemeValue(pti.LocalInstrument))
+		}
+		if ref.DebtorAgent != nil {
+			fi := wire.NewOriginatorFI()
+			fi.FinancialInstitution = c.financialInstitution(wire.TagOriginatorFI, "OriginatorFI.FinancialInstitution", ref.DebtorAgent)
+			fwm.OriginatorFI = fi
+		}
+		if ref.CreditorAgent != nil {
+			fi := wire.NewBeneficiaryFI()
+			fi.FinancialInstitution = c.financialInstitution(wire.TagBeneficiaryFI, "BeneficiaryFI.FinancialInstitution", ref.CreditorAgent)
+			fwm.BeneficiaryFI = fi
+		}
+		if ref.Debtor != nil || ref.DebtorAccount != nil {
+			fwm.Originator = wire.NewOriginator()
+			fwm.Originator.Personal = c.personalOf(wire.TagOriginator, "Originator.Personal", ref.Debtor, ref.DebtorAccount)
+		}
+		if ref.Creditor != nil || ref.CreditorAccount != nil {
+			fwm.Beneficiary = wire.NewBeneficiary()
+			fwm.Beneficiary.Personal = c.personalOf(wire.TagBeneficiary, "Beneficiary.Personal", ref.Creditor, ref.CreditorAccount)
+		}
+		c.setRemittanceInformation(fwm, path+"/OrgnlTxRef/RmtInf", ref.RemittanceInformation)
+		c.unmappedElements(path+"/OrgnlTxRef", ref.Unmapped)
+	}
+	c.unmappedElements(path, tx.Unmapped)
+
+	return fwm, c.warnings, nil
+}
@@ -0,0 +1,157 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package iso20022
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/moov-io/wire"
+	"github.com/stretchr/testify/require"
+)
+
+// camt056RoundTrip converts fwm into a camt.056 document, writes and reads the document and converts it back
+func camt056RoundTrip(t *testing.T, fwm *wire.FEDWireMessage) (*Camt056, []Warning) {
+	t.Helper()
+
+	doc, warnings, err := NewCamt056(fwm)
+	require.NoError(t, err)
+
+	bs, err := xml.MarshalIndent(doc, "", "  ")
+	require.NoError(t, err)
+
+	var read Camt056
+	require.NoError(t, xml.Unmarshal(bs, &read))
+
+	got, back, err := read.FEDWireMessage()
+	require.NoError(t, err)
+	requireRoundTrip(t, fwm, got, warnings, back)
+
+	return doc, warnings
+}
+
+func TestCamt056_serviceMessage(t *testing.T) {
+	fwm := readMessage(t, "fedWireMessage-ServiceMessage.txt")
+
+	doc, warnings := camt056RoundTrip(t, fwm)
+
+	req := doc.CancellationRequest
+	require.Equal(t, "20190410Source08000001", req.Assignment.ID)
+	require.Equal(t, "121042882", req.Assignment.Assigner.Agent.FinancialInstitutionIdentification.ClearingSystemMemberIdentification.MemberIdentification)
+	require.Equal(t, "231380104", req.Assignment.Assignee.Agent.FinancialInstitutionIdentification.ClearingSystemMemberIdentification.MemberIdentification)
+
+	tx := req.Underlying[0].Transactions[0]
+	require.Equal(t, "Sender Reference", tx.CancellationID)
+	require.Equal(t, "Previous Message Ident", tx.OriginalInstructionID)
+	require.Equal(t, "Reference", tx.OriginalEndToEndID)
+	require.Empty(t, tx.OriginalUETR)
+	require.Equal(t, "12345.67", tx.OriginalInterbankSettlementAmount.Value)
+	require.Len(t, tx.CancellationReasonInformation[0].AdditionalInformation, 12)
+
+	ref := tx.OriginalTransactionReference
+	require.Nil(t, ref.PaymentTypeInformation)
+	require.Equal(t, "Name", ref.Debtor.Party.Name)
+	require.Equal(t, "Name", ref.Creditor.Party.Name)
+	require.Equal(t, "FI Name", ref.DebtorAgent.FinancialInstitutionIdentification.Name)
+	require.Equal(t, []string{"LineOne", "LineTwo", "LineThree", "LineFour"}, ref.RemittanceInformation.Unstructured)
+
+	require.Contains(t, warnings, Warning{Tag: wire.TagBeneficiaryIntermediaryFI, Field: "BeneficiaryIntermediaryFI", Reason: "no camt.056 equivalent"})
+	require.Contains(t, warnings, Warning{Tag: wire.TagAccountDebitedDrawdown, Field: "AccountDebitedDrawdown", Reason: "no camt.056 equivalent"})
+
+	bs, err := xml.Marshal(doc)
+	require.NoError(t, err)
+	require.Contains(t, string(bs), `<Document xmlns="`+Camt056Namespace+`"><FIToFIPmtCxlReq><Assgnmt>`)
+}
+
+func TestCamt056_priorDay(t *testing.T) {
+	fwm := readMessage(t, "fedWireMessage-ServiceMessage.txt")
+	fwm.BusinessFunctionCode.BusinessFunctionCode = wire.CustomerTransferPlus
+	fwm.TypeSubType.SubTypeCode = wire.RequestReversalPriorDayTransfer
+	fwm.LocalInstrument = wire.NewLocalInstrument()
+	fwm.LocalInstrument.LocalInstrumentCode = wire.ProprietaryLocalInstrumentCode
+	fwm.LocalInstrument.ProprietaryCode = "RFRV"
+	fwm.PreviousMessageIdentifier.PreviousMessageIdentifier = "20190409Source08000007"
+	fwm.AccountDebitedDrawdown, fwm.AccountCreditedDrawdown = nil, nil
+	fwm.FIDrawdownDebitAccountAdvice = nil
+
+	doc, warnings := camt056RoundTrip(t, fwm)
+	for _, w := range warnings {
+		require.NotEqual(t, wire.TagTypeSubType, w.Tag, w.String())
+		require.NotEqual(t, wire.TagPreviousMessageIdentifier, w.Tag, w.String())
+	}
+
+	tx := doc.CancellationRequest.Underlying[0].Transactions[0]
+	require.Equal(t, "20190409Source08000007", tx.OriginalInstructionID)
+	require.Equal(t, uetr("20190409Source08000007"), tx.OriginalUETR)
+	require.Equal(t, "2019-04-09", tx.OriginalInterbankSettlementDate)
+	require.Equal(t, "RFRV", tx.OriginalTransactionReference.PaymentTypeInformation.LocalInstrument.Proprietary)
+
+	// a same day sub type on a prior day request reads back as prior day
+	fwm.TypeSubType.SubTypeCode = wire.RequestReversal
+	_, warnings, err := NewCamt056(fwm)
+	require.NoError(t, err)
+	require.Contains(t, warnings, Warning{Tag: wire.TagTypeSubType, Field: "TypeSubType.SubTypeCode", Reason: "sub type 01 doesn't follow from the settlement dates and reads back as 07"})
+}
+
+func TestCamt056_unsupported(t *testing.T) {
+	_, _, err := NewCamt056(readMessage(t, "fedWireMessage-CustomerTransfer.txt"))
+	require.ErrorIs(t, err, ErrUnsupportedMessage)
+
+	fwm := readMessage(t, "fedWireMessage-BankTransfer.txt")
+	fwm.TypeSubType.SubTypeCode = wire.RequestReversal
+	_, _, err = NewCamt056(fwm)
+	require.ErrorIs(t, err, ErrUnsupportedMessage)
+
+	fwm = readMessage(t, "fedWireMessage-ServiceMessage.txt")
+	fwm.PreviousMessageIdentifier = nil
+	_, _, err = NewCamt056(fwm)
+	require.ErrorIs(t, err, ErrMissingTag)
+}
+
+func TestCamt056_read(t *testing.T) {
+	input := `<?xml version="1.0" encoding="UTF-8"?>
+<Document xmlns="urn:iso:std:iso:20022:tech:xsd:camt.056.001.08">
+  <FIToFIPmtCxlReq>
+    <Assgnmt>
+      <Id>20240103MMQFMP9T000042</Id>
+      <Assgnr><Agt><FinInstnId><ClrSysMmbId><ClrSysId><Cd>USABA</Cd></ClrSysId><MmbId>121042882</MmbId></ClrSysMmbId></FinInstnId></Agt></Assgnr>
+      <Assgne><Agt><FinInstnId><ClrSysMmbId><ClrSysId><Cd>USABA</Cd></ClrSysId><MmbId>231380104</MmbId></ClrSysMmbId></FinInstnId></Agt></Assgne>
+      <CreDtTm>2024-01-03T10:00:00-05:00</CreDtTm>
+    </Assgnmt>
+    <Undrlyg>
+      <TxInf>
+        <OrgnlInstrId>20240102MMQFMP9T000124</OrgnlInstrId>
+        <OrgnlIntrBkSttlmAmt Ccy="USD">250.00</OrgnlIntrBkSttlmAmt>
+        <OrgnlIntrBkSttlmDt>2024-01-02</OrgnlIntrBkSttlmDt>
+        <CxlRsnInf>
+          <Rsn><Cd>DUPL</Cd></Rsn>
+          <AddtlInf>Duplicate payment</AddtlInf>
+        </CxlRsnInf>
+      </TxInf>
+    </Undrlyg>
+  </FIToFIPmtCxlReq>
+</Document>`
+
+	var doc Camt056
+	require.NoError(t, xml.Unmarshal([]byte(input), &doc))
+
+	fwm, warnings, err := doc.FEDWireMessage()
+	require.NoError(t, err)
+
+	require.Equal(t, wire.BFCServiceMessage, fwm.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, wire.RequestReversalPriorDayTransfer, fwm.TypeSubType.SubTypeCode)
+	require.Equal(t, "000000025000", fwm.Amount.Amount)
+	require.Equal(t, "20240102MMQFMP9T000124", fwm.PreviousMessageIdentifier.PreviousMessageIdentifier)
+	require.Equal(t, "Duplicate payment", fwm.ServiceMessage.LineOne)
+	require.Nil(t, fwm.SenderReference)
+
+	require.Equal(t, []Warning{
+		{Element: "FIToFIPmtCxlReq/Undrlyg/TxInf/CxlRsnInf/Rsn", Reason: "reason DUPL has no Fedwire equivalent"},
+	}, warnings)
+
+	file := wire.NewFile()
+	file.AddFEDWireMessage(*fwm)
+	require.NoError(t, file.Validate())
+}
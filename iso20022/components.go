@@ -0,0 +1,287 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package iso20022
+
+import (
+	"encoding/xml"
+)
+
+// The message components below are shared between the ISO 20022 messages in this package. Only the elements
+// which have a Fedwire equivalent are modeled, and they are declared in schema order so marshaled documents
+// follow the ISO 20022 sequence.
+
+// GroupHeader is the GrpHdr block of a payments clearing and settlement message
+type GroupHeader struct {
+	// MessageID is the Fedwire IMAD of the message
+	MessageID string `xml:"MsgId"`
+	// CreationDateTime is when the ISO 20022 document was created
+	CreationDateTime string `xml:"CreDtTm"`
+	// NumberOfTransactions is always 1, Fedwire messages carry a single transfer
+	NumberOfTransactions string `xml:"NbOfTxs,omitempty"`
+	// SettlementInformation identifies the Fedwire Funds Service as clearing system
+	SettlementInformation *SettlementInstruction `xml:"SttlmInf,omitempty"`
+}
+
+// SettlementInstruction describes how a transfer is settled
+type SettlementInstruction struct {
+	SettlementMethod string             `xml:"SttlmMtd"`
+	ClearingSystem   *CodeOrProprietary `xml:"ClrSys,omitempty"`
+}
+
+// CodeOrProprietary holds either an ISO 20022 external code or a proprietary value
+type CodeOrProprietary struct {
+	Code        string `xml:"Cd,omitempty"`
+	Proprietary string `xml:"Prtry,omitempty"`
+}
+
+// PaymentIdentification holds the references of a transfer
+type PaymentIdentification struct {
+	InstructionID string `xml:"InstrId,omitempty"`
+	EndToEndID    string `xml:"EndToEndId"`
+	UETR          string `xml:"UETR,omitempty"`
+}
+
+// PaymentTypeInformation qualifies the type of transfer
+type PaymentTypeInformation struct {
+	LocalInstrument *CodeOrProprietary `xml:"LclInstrm,omitempty"`
+	CategoryPurpose *CodeOrProprietary `xml:"CtgyPurp,omitempty"`
+}
+
+// ActiveCurrencyAndAmount is an amount with a decimal point and its ISO 4217 currency
+type ActiveCurrencyAndAmount struct {
+	Currency string `xml:"Ccy,attr"`
+	Value    string `xml:",chardata"`
+}
+
+// ChargesInformation is one amount of charges deducted by an agent
+type ChargesInformation struct {
+	Amount ActiveCurrencyAndAmount                     `xml:"Amt"`
+	Agent  BranchAndFinancialInstitutionIdentification `xml:"Agt"`
+}
+
+// BranchAndFinancialInstitutionIdentification identifies an agent
+type BranchAndFinancialInstitutionIdentification struct {
+	FinancialInstitutionIdentification FinancialInstitutionIdentification `xml:"FinInstnId"`
+}
+
+// FinancialInstitutionIdentification identifies a financial institution by BIC, clearing system member id
+// or another identifier
+type FinancialInstitutionIdentification struct {
+	BICFI                              string                              `xml:"BICFI,omitempty"`
+	ClearingSystemMemberIdentification *ClearingSystemMemberIdentification `xml:"ClrSysMmbId,omitempty"`
+	Name                               string                              `xml:"Nm,omitempty"`
+	PostalAddress                      *PostalAddress                      `xml:"PstlAdr,omitempty"`
+	Other                              *GenericIdentification              `xml:"Othr,omitempty"`
+}
+
+// ClearingSystemMemberIdentification is a member id within a clearing system, e.g. an ABA routing number
+type ClearingSystemMemberIdentification struct {
+	ClearingSystemIdentification *CodeOrProprietary `xml:"ClrSysId,omitempty"`
+	MemberIdentification         string             `xml:"MmbId"`
+}
+
+// PostalAddress is a structured and/or unstructured postal address
+type PostalAddress struct {
+	AddressType        *CodeOrProprietary `xml:"AdrTp,omitempty"`
+	Department         string             `xml:"Dept,omitempty"`
+	SubDepartment      string             `xml:"SubDept,omitempty"`
+	StreetName         string             `xml:"StrtNm,omitempty"`
+	BuildingNumber     string             `xml:"BldgNb,omitempty"`
+	PostCode           string             `xml:"PstCd,omitempty"`
+	TownName           string             `xml:"TwnNm,omitempty"`
+	CountrySubDivision string             `xml:"CtrySubDvsn,omitempty"`
+	Country            string             `xml:"Ctry,omitempty"`
+	AddressLines       []string           `xml:"AdrLine"`
+}
+
+// PartyIdentification identifies a non-financial party such as the debtor or creditor
+type PartyIdentification struct {
+	Name               string         `xml:"Nm,omitempty"`
+	PostalAddress      *PostalAddress `xml:"PstlAdr,omitempty"`
+	Identification     *Party         `xml:"Id,omitempty"`
+	CountryOfResidence string         `xml:"CtryOfRes,omitempty"`
+	ContactDetails     *Contact       `xml:"CtctDtls,omitempty"`
+}
+
+// Party identifies a party as either an organisation or a private person
+type Party struct {
+	OrganisationIdentification *OrganisationIdentification `xml:"OrgId,omitempty"`
+	PrivateIdentification      *PersonIdentification       `xml:"PrvtId,omitempty"`
+}
+
+// OrganisationIdentification identifies an organisation
+type OrganisationIdentification struct {
+	AnyBIC string                  `xml:"AnyBIC,omitempty"`
+	Other  []GenericIdentification `xml:"Othr,omitempty"`
+}
+
+// PersonIdentification identifies a private person
+type PersonIdentification struct {
+	Other []GenericIdentification `xml:"Othr,omitempty"`
+}
+
+// GenericIdentification is an identifier qualified by its scheme and issuer
+type GenericIdentification struct {
+	Identification string             `xml:"Id"`
+	SchemeName     *CodeOrProprietary `xml:"SchmeNm,omitempty"`
+	Issuer         string             `xml:"Issr,omitempty"`
+}
+
+// Contact holds the contact details of a party
+type Contact struct {
+	Name         string        `xml:"Nm,omitempty"`
+	PhoneNumber  string        `xml:"PhneNb,omitempty"`
+	MobileNumber string        `xml:"MobNb,omitempty"`
+	FaxNumber    string        `xml:"FaxNb,omitempty"`
+	EmailAddress string        `xml:"EmailAdr,omitempty"`
+	Other        *OtherContact `xml:"Othr,omitempty"`
+}
+
+// OtherContact is a contact channel which isn't phone, fax or email
+type OtherContact struct {
+	ChannelType    string `xml:"ChanlTp"`
+	Identification string `xml:"Id,omitempty"`
+}
+
+// CashAccount identifies an account of a party or agent
+type CashAccount struct {
+	Identification AccountIdentification `xml:"Id"`
+}
+
+// AccountIdentification is an IBAN or another account number
+type AccountIdentification struct {
+	IBAN  string                 `xml:"IBAN,omitempty"`
+	Other *GenericIdentification `xml:"Othr,omitempty"`
+}
+
+// PartyOrAgent is either a party or a financial institution, e.g. the debtor of a return chain which is a
+// customer in a customer transfer and an institution in a bank transfer
+type PartyOrAgent struct {
+	Party *PartyIdentification                         `xml:"Pty,omitempty"`
+	Agent *BranchAndFinancialInstitutionIdentification `xml:"Agt,omitempty"`
+}
+
+// ReasonInformation is the reason of a cancellation request or return
+type ReasonInformation struct {
+	Originator            *PartyIdentification `xml:"Orgtr,omitempty"`
+	Reason                *CodeOrProprietary   `xml:"Rsn,omitempty"`
+	AdditionalInformation []string             `xml:"AddtlInf,omitempty"`
+}
+
+// OriginalTransactionReference holds the details of the original transaction a cancellation request or
+// return refers to
+type OriginalTransactionReference struct {
+	PaymentTypeInformation *PaymentTypeInformation                      `xml:"PmtTpInf,omitempty"`
+	RemittanceInformation  *RemittanceInformation                       `xml:"RmtInf,omitempty"`
+	Debtor                 *PartyOrAgent                                `xml:"Dbtr,omitempty"`
+	DebtorAccount          *CashAccount                                 `xml:"DbtrAcct,omitempty"`
+	DebtorAgent            *BranchAndFinancialInstitutionIdentification `xml:"DbtrAgt,omitempty"`
+	CreditorAgent          *BranchAndFinancialInstitutionIdentification `xml:"CdtrAgt,omitempty"`
+	Creditor               *PartyOrAgent                                `xml:"Cdtr,omitempty"`
+	CreditorAccount        *CashAccount                                 `xml:"CdtrAcct,omitempty"`
+
+	// Unmapped holds the elements read from a document which have no Fedwire equivalent
+	Unmapped []UnmappedElement `xml:",any"`
+}
+
+// AmountType is the amount of a payment activation request, in the currency the debtor is instructed to pay
+type AmountType struct {
+	InstructedAmount ActiveCurrencyAndAmount `xml:"InstdAmt"`
+}
+
+// DateAndDateTime is a date or a date and time, e.g. the requested execution date of a drawdown
+type DateAndDateTime struct {
+	Date string `xml:"Dt,omitempty"`
+}
+
+// InstructionForNextAgent is an instruction for the next agent in the payment chain
+type InstructionForNextAgent struct {
+	Code                   string `xml:"Cd,omitempty"`
+	InstructionInformation string `xml:"InstrInf,omitempty"`
+}
+
+// RemittanceLocation describes where the remittance information was sent separately
+type RemittanceLocation struct {
+	RemittanceIdentification  string                   `xml:"RmtId,omitempty"`
+	RemittanceLocationDetails []RemittanceLocationData `xml:"RmtLctnDtls,omitempty"`
+}
+
+// RemittanceLocationData is the method and address the remittance information was sent with
+type RemittanceLocationData struct {
+	Method            string          `xml:"Mtd"`
+	ElectronicAddress string          `xml:"ElctrncAdr,omitempty"`
+	PostalAddress     *NameAndAddress `xml:"PstlAdr,omitempty"`
+}
+
+// NameAndAddress is a name and postal address
+type NameAndAddress struct {
+	Name    string        `xml:"Nm"`
+	Address PostalAddress `xml:"Adr"`
+}
+
+// RemittanceInformation is the unstructured and structured remittance of a transfer
+type RemittanceInformation struct {
+	Unstructured []string                          `xml:"Ustrd,omitempty"`
+	Structured   []StructuredRemittanceInformation `xml:"Strd,omitempty"`
+}
+
+// StructuredRemittanceInformation is the structured remittance of a transfer
+type StructuredRemittanceInformation struct {
+	ReferredDocumentInformation     []ReferredDocumentInformation `xml:"RfrdDocInf,omitempty"`
+	ReferredDocumentAmount          *RemittanceAmount             `xml:"RfrdDocAmt,omitempty"`
+	CreditorReferenceInformation    *CreditorReferenceInformation `xml:"CdtrRefInf,omitempty"`
+	Invoicer                        *PartyIdentification          `xml:"Invcr,omitempty"`
+	Invoicee                        *PartyIdentification          `xml:"Invcee,omitempty"`
+	AdditionalRemittanceInformation []string                      `xml:"AddtlRmtInf,omitempty"`
+}
+
+// ReferredDocumentInformation identifies the document a transfer is paying
+type ReferredDocumentInformation struct {
+	Type        *DocumentType `xml:"Tp,omitempty"`
+	Number      string        `xml:"Nb,omitempty"`
+	RelatedDate string        `xml:"RltdDt,omitempty"`
+}
+
+// DocumentType is the type of a referred document and who issued it
+type DocumentType struct {
+	CodeOrProprietary CodeOrProprietary `xml:"CdOrPrtry"`
+	Issuer            string            `xml:"Issr,omitempty"`
+}
+
+// RemittanceAmount holds the amounts of the referred document
+type RemittanceAmount struct {
+	DuePayableAmount          *ActiveCurrencyAndAmount `xml:"DuePyblAmt,omitempty"`
+	DiscountAppliedAmount     []DiscountAmountAndType  `xml:"DscntApldAmt,omitempty"`
+	AdjustmentAmountAndReason []DocumentAdjustment     `xml:"AdjstmntAmtAndRsn,omitempty"`
+	RemittedAmount            *ActiveCurrencyAndAmount `xml:"RmtdAmt,omitempty"`
+}
+
+// DiscountAmountAndType is a discount applied to the referred document
+type DiscountAmountAndType struct {
+	Amount ActiveCurrencyAndAmount `xml:"Amt"`
+}
+
+// DocumentAdjustment is an adjustment applied to the referred document
+type DocumentAdjustment struct {
+	Amount                ActiveCurrencyAndAmount `xml:"Amt"`
+	CreditDebitIndicator  string                  `xml:"CdtDbtInd,omitempty"`
+	Reason                string                  `xml:"Rsn,omitempty"`
+	AdditionalInformation string                  `xml:"AddtlInf,omitempty"`
+}
+
+// CreditorReferenceInformation is the reference the creditor assigned to the remittance
+type CreditorReferenceInformation struct {
+	Type      *DocumentType `xml:"Tp,omitempty"`
+	Reference string        `xml:"Ref,omitempty"`
+}
+
+// UnmappedElement is an element of a transaction which has no Fedwire equivalent. Unmapped elements are kept
+// so a document survives being read and written again, and each one is reported as a Warning when converted
+// to a FEDWireMessage.
+type UnmappedElement struct {
+	XMLName  xml.Name
+	Attrs    []xml.Attr `xml:",any,attr"`
+	InnerXML string     `xml:",innerxml"`
+}
@@ -0,0 +1,523 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package iso20022
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/moov-io/wire"
+)
+
+// converter maps values between a FEDWireMessage and an ISO 20022 message, collecting a Warning for every
+// value it has to drop or shorten.
+type converter struct {
+	// message is the ISO 20022 message being converted, e.g. pacs.008
+	message  string
+	warnings []Warning
+}
+
+func (c *converter) warn(tag, field, format string, args ...interface{}) {
+	c.warnings = append(c.warnings, Warning{
+		Tag:    tag,
+		Field:  field,
+		Reason: fmt.Sprintf(format, args...),
+	})
+}
+
+// warnElement records a dropped ISO 20022 element which has no Fedwire tag
+func (c *converter) warnElement(element, format string, args ...interface{}) {
+	c.warnings = append(c.warnings, Warning{
+		Element: element,
+		Reason:  fmt.Sprintf(format, args...),
+	})
+}
+
+// unmapped records a FEDWireMessage field without an equivalent in the ISO 20022 message when it's present
+func (c *converter) unmapped(present bool, tag, field string) {
+	if present {
+		c.warn(tag, field, "no %s equivalent", c.message)
+	}
+}
+
+// unmappedElements records the elements of a transaction which were read but have no Fedwire equivalent
+func (c *converter) unmappedElements(path string, elements []UnmappedElement) {
+	for _, elm := range elements {
+		c.warnElement(path+"/"+elm.XMLName.Local, "no Fedwire equivalent")
+	}
+}
+
+// text returns s cut to max characters, recording a Warning if anything was cut off
+func (c *converter) text(tag, field, s string, max int) string {
+	if utf8.RuneCountInString(s) <= max {
+		return s
+	}
+	c.warn(tag, field, "truncated to %d characters", max)
+	return string([]rune(s)[:max])
+}
+
+// lines returns exactly n lines of at most width characters, recording a Warning for lines which don't fit
+func (c *converter) lines(tag, field string, values []string, n, width int) []string {
+	if len(values) > n {
+		c.warn(tag, field, "only %d of %d lines fit", n, len(values))
+		values = values[:n]
+	}
+	out := make([]string, n)
+	for i := range values {
+		out[i] = c.text(tag, field, values[i], width)
+	}
+	return out
+}
+
+// trimLines drops trailing empty lines. Empty lines in between are kept so each line keeps its position.
+func trimLines(lines ...string) []string {
+	for len(lines) > 0 && strings.TrimSpace(lines[len(lines)-1]) == "" {
+		lines = lines[:len(lines)-1]
+	}
+	for i := range lines {
+		lines[i] = strings.TrimSpace(lines[i])
+	}
+	return lines
+}
+
+// requireTags checks the tags every transfer needs to become an ISO 20022 message
+func requireTags(fwm *wire.FEDWireMessage) error {
+	switch {
+	case fwm.TypeSubType == nil:
+		return missingTag(wire.TagTypeSubType, "TypeSubType")
+	case fwm.Amount == nil:
+		return missingTag(wire.TagAmount, "Amount")
+	case fwm.SenderDepositoryInstitution == nil:
+		return missingTag(wire.TagSenderDepositoryInstitution, "SenderDepositoryInstitution")
+	case fwm.ReceiverDepositoryInstitution == nil:
+		return missingTag(wire.TagReceiverDepositoryInstitution, "ReceiverDepositoryInstitution")
+	case fwm.BusinessFunctionCode == nil:
+		return missingTag(wire.TagBusinessFunctionCode, "BusinessFunctionCode")
+	}
+	return nil
+}
+
+// envelope records the Fedwire values which describe the message itself rather than the transfer. ISO 20022
+// carries these in the business application header, which this package doesn't produce.
+func (c *converter) envelope(fwm *wire.FEDWireMessage) {
+	if ss := fwm.SenderSupplied; ss != nil {
+		c.unmapped(strings.TrimSpace(ss.UserRequestCorrelation) != "", wire.TagSenderSupplied, "SenderSupplied.UserRequestCorrelation")
+		c.unmapped(ss.TestProductionCode == wire.EnvironmentTest, wire.TagSenderSupplied, "SenderSupplied.TestProductionCode")
+		c.unmapped(ss.MessageDuplicationCode == wire.MessageDuplicationResend, wire.TagSenderSupplied, "SenderSupplied.MessageDuplicationCode")
+	}
+	c.unmapped(fwm.MessageDisposition != nil, wire.TagMessageDisposition, "MessageDisposition")
+	c.unmapped(fwm.ReceiptTimeStamp != nil, wire.TagReceiptTimeStamp, "ReceiptTimeStamp")
+	c.unmapped(fwm.OutputMessageAccountabilityData != nil, wire.TagOutputMessageAccountabilityData, "OutputMessageAccountabilityData")
+	c.unmapped(fwm.ErrorWire != nil, wire.TagErrorWire, "ErrorWire")
+}
+
+// fiToFiInformation records the {6100}-{6500} FI to FI information tags, which are free text addressed to
+// particular agents and have no structured ISO 20022 equivalent.
+func (c *converter) fiToFiInformation(fwm *wire.FEDWireMessage) {
+	c.unmapped(fwm.FIReceiverFI != nil, wire.TagFIReceiverFI, "FIReceiverFI")
+	c.unmapped(fwm.FIDrawdownDebitAccountAdvice != nil, wire.TagFIDrawdownDebitAccountAdvice, "FIDrawdownDebitAccountAdvice")
+	c.unmapped(fwm.FIIntermediaryFI != nil, wire.TagFIIntermediaryFI, "FIIntermediaryFI")
+	c.unmapped(fwm.FIIntermediaryFIAdvice != nil, wire.TagFIIntermediaryFIAdvice, "FIIntermediaryFIAdvice")
+	c.unmapped(fwm.FIBeneficiaryFI != nil, wire.TagFIBeneficiaryFI, "FIBeneficiaryFI")
+	c.unmapped(fwm.FIBeneficiaryFIAdvice != nil, wire.TagFIBeneficiaryFIAdvice, "FIBeneficiaryFIAdvice")
+	c.unmapped(fwm.FIBeneficiary != nil, wire.TagFIBeneficiary, "FIBeneficiary")
+	c.unmapped(fwm.FIBeneficiaryAdvice != nil, wire.TagFIBeneficiaryAdvice, "FIBeneficiaryAdvice")
+	c.unmapped(fwm.FIPaymentMethodToBeneficiary != nil, wire.TagFIPaymentMethodToBeneficiary, "FIPaymentMethodToBeneficiary")
+	c.unmapped(fwm.FIAdditionalFIToFI != nil, wire.TagFIAdditionalFIToFI, "FIAdditionalFIToFI")
+}
+
+// coverPayment records the {7033}-{7072} cover payment tags
+func (c *converter) coverPayment(fwm *wire.FEDWireMessage) {
+	c.unmapped(fwm.CurrencyInstructedAmount != nil, wire.TagCurrencyInstructedAmount, "CurrencyInstructedAmount")
+	c.unmapped(fwm.OrderingCustomer != nil, wire.TagOrderingCustomer, "OrderingCustomer")
+	c.unmapped(fwm.OrderingInstitution != nil, wire.TagOrderingInstitution, "OrderingInstitution")
+	c.unmapped(fwm.IntermediaryInstitution != nil, wire.TagIntermediaryInstitution, "IntermediaryInstitution")
+	c.unmapped(fwm.InstitutionAccount != nil, wire.TagInstitutionAccount, "InstitutionAccount")
+	c.unmapped(fwm.BeneficiaryCustomer != nil, wire.TagBeneficiaryCustomer, "BeneficiaryCustomer")
+	c.unmapped(fwm.Remittance != nil, wire.TagRemittance, "Remittance")
+	c.unmapped(fwm.SenderToReceiver != nil, wire.TagSenderToReceiver, "SenderToReceiver")
+}
+
+// remittanceTags records the {8250}-{8750} remittance tags
+func (c *converter) remittanceTags(fwm *wire.FEDWireMessage) {
+	c.unmapped(fwm.RelatedRemittance != nil, wire.TagRelatedRemittance, "RelatedRemittance")
+	c.unmapped(fwm.RemittanceOriginator != nil, wire.TagRemittanceOriginator, "RemittanceOriginator")
+	c.unmapped(fwm.RemittanceBeneficiary != nil, wire.TagRemittanceBeneficiary, "RemittanceBeneficiary")
+	c.unmapped(fwm.PrimaryRemittanceDocument != nil, wire.TagPrimaryRemittanceDocument, "PrimaryRemittanceDocument")
+	c.unmapped(fwm.ActualAmountPaid != nil, wire.TagActualAmountPaid, "ActualAmountPaid")
+	c.unmapped(fwm.GrossAmountRemittanceDocument != nil, wire.TagGrossAmountRemittanceDocument, "GrossAmountRemittanceDocument")
+	c.unmapped(fwm.AmountNegotiatedDiscount != nil, wire.TagAmountNegotiatedDiscount, "AmountNegotiatedDiscount")
+	c.unmapped(fwm.Adjustment != nil, wire.TagAdjustment, "Adjustment")
+	c.unmapped(fwm.DateRemittanceDocument != nil, wire.TagDateRemittanceDocument, "DateRemittanceDocument")
+	c.unmapped(fwm.SecondaryRemittanceDocument != nil, wire.TagSecondaryRemittanceDocument, "SecondaryRemittanceDocument")
+	c.unmapped(fwm.RemittanceFreeText != nil, wire.TagRemittanceFreeText, "RemittanceFreeText")
+}
+
+// groupHeader returns the GrpHdr of a document carrying fwm
+func (c *converter) groupHeader(fwm *wire.FEDWireMessage) GroupHeader {
+	msgID := fwm.InputMessageAccountabilityData.Reference()
+	if msgID == "" {
+		msgID = NotProvided
+	}
+	return GroupHeader{
+		MessageID:            msgID,
+		CreationDateTime:     creationDateTime(),
+		NumberOfTransactions: "1",
+		SettlementInformation: &SettlementInstruction{
+			SettlementMethod: SettlementMethodClearing,
+			ClearingSystem:   &CodeOrProprietary{Code: ClearingSystemFedwire},
+		},
+	}
+}
+
+// paymentIdentification returns the PmtId of fwm. The IMAD identifies the instruction and derives the UETR,
+// unless a SenderReference was given which then becomes the instruction id.
+func (c *converter) paymentIdentification(fwm *wire.FEDWireMessage) PaymentIdentification {
+	imad := fwm.InputMessageAccountabilityData.Reference()
+	pmtID := PaymentIdentification{
+		InstructionID: imad,
+		EndToEndID:    NotProvided,
+	}
+	if imad != "" {
+		pmtID.UETR = uetr(imad)
+	}
+	if fwm.SenderReference != nil && strings.TrimSpace(fwm.SenderReference.SenderReference) != "" {
+		pmtID.InstructionID = strings.TrimSpace(fwm.SenderReference.SenderReference)
+	}
+	if fwm.BeneficiaryReference != nil && strings.TrimSpace(fwm.BeneficiaryReference.BeneficiaryReference) != "" {
+		pmtID.EndToEndID = strings.TrimSpace(fwm.BeneficiaryReference.BeneficiaryReference)
+	}
+	return pmtID
+}
+
+// settlementAmount returns the IntrBkSttlmAmt of fwm
+func (c *converter) settlementAmount(fwm *wire.FEDWireMessage) (ActiveCurrencyAndAmount, error) {
+	amount, err := amountFromImplied(fwm.Amount.Amount)
+	if err != nil {
+		return ActiveCurrencyAndAmount{}, fmt.Errorf("%s Amount: %w", wire.TagAmount, err)
+	}
+	return ActiveCurrencyAndAmount{Currency: currencyUSD, Value: amount}, nil
+}
+
+// settlementDate returns the IntrBkSttlmDt of fwm, which is the IMAD cycle date
+func settlementDate(fwm *wire.FEDWireMessage) string {
+	if fwm.InputMessageAccountabilityData == nil {
+		return ""
+	}
+	return isoDateFromFed(fwm.InputMessageAccountabilityData.InputCycleDate)
+}
+
+// typeSubType records a {1510} which isn't a basic transfer of the given type code
+func (c *converter) typeSubType(fwm *wire.FEDWireMessage, typeCode string) {
+	tst := fwm.TypeSubType
+	c.unmapped(tst.TypeCode != typeCode, wire.TagTypeSubType, "TypeSubType.TypeCode")
+	c.unmapped(tst.SubTypeCode != wire.BasicFundsTransfer, wire.TagTypeSubType, "TypeSubType.SubTypeCode")
+}
+
+// fedAgent returns the agent of a depository institution identified by its ABA routing number
+func fedAgent(aba, name string) *BranchAndFinancialInstitutionIdentification {
+	return &BranchAndFinancialInstitutionIdentification{
+		FinancialInstitutionIdentification: FinancialInstitutionIdentification{
+			ClearingSystemMemberIdentification: &ClearingSystemMemberIdentification{
+				ClearingSystemIdentification: &CodeOrProprietary{Code: ClearingSystemABA},
+				MemberIdentification:         aba,
+			},
+			Name: strings.TrimSpace(name),
+		},
+	}
+}
+
+// routingNumber returns the ABA routing number and name of a depository institution agent
+func (c *converter) routingNumber(path, tag, field string, agent *BranchAndFinancialInstitutionIdentification) (string, string, error) {
+	if agent == nil {
+		return "", "", missingElement(path)
+	}
+	fi := agent.FinancialInstitutionIdentification
+	mmb := fi.ClearingSystemMemberIdentification
+	if mmb == nil || mmb.ClearingSystemIdentification == nil || mmb.ClearingSystemIdentification.Code != ClearingSystemABA {
+		return "", "", missingElement(path + "/FinInstnId/ClrSysMmbId")
+	}
+	return mmb.MemberIdentification, c.text(tag, field+"ShortName", fi.Name, 18), nil
+}
+
+// agent returns the ISO 20022 agent of a Fedwire financial institution. BICs and ABA or CHIPS participant
+// numbers have their own elements, other identification codes are kept as a proprietary scheme.
+func (c *converter) agent(fi wire.FinancialInstitution) *BranchAndFinancialInstitutionIdentification {
+	id := FinancialInstitutionIdentification{
+		Name:          strings.TrimSpace(fi.Name),
+		PostalAddress: postalAddress(fi.Address),
+	}
+	switch fi.IdentificationCode {
+	case "":
+	case wire.SWIFTBankIdentifierCode:
+		id.BICFI = fi.Identifier
+	case wire.FEDRoutingNumber:
+		id.ClearingSystemMemberIdentification = &ClearingSystemMemberIdentification{
+			ClearingSystemIdentification: &CodeOrProprietary{Code: ClearingSystemABA},
+			MemberIdentification:         fi.Identifier,
+		}
+	case wire.CHIPSParticipant:
+		id.ClearingSystemMemberIdentification = &ClearingSystemMemberIdentification{
+			ClearingSystemIdentification: &CodeOrProprietary{Code: ClearingSystemCHIPS},
+			MemberIdentification:         fi.Identifier,
+		}
+	default:
+		id.Other = &GenericIdentification{
+			Identification: fi.Identifier,
+			SchemeName:     &CodeOrProprietary{Proprietary: fi.IdentificationCode},
+		}
+	}
+	return &BranchAndFinancialInstitutionIdentification{FinancialInstitutionIdentification: id}
+}
+
+// financialInstitution returns the Fedwire financial institution of an ISO 20022 agent
+func (c *converter) financialInstitution(tag, field string, agent *BranchAndFinancialInstitutionIdentification) wire.FinancialInstitution {
+	id := agent.FinancialInstitutionIdentification
+	fi := wire.FinancialInstitution{
+		Name:    c.text(tag, field+".Name", id.Name, 35),
+		Address: c.address(tag, field+".Address", id.PostalAddress),
+	}
+	switch {
+	case id.BICFI != "":
+		fi.IdentificationCode = wire.SWIFTBankIdentifierCode
+		fi.Identifier = id.BICFI
+	case id.ClearingSystemMemberIdentification != nil:
+		mmb := id.ClearingSystemMemberIdentification
+		code := ""
+		if mmb.ClearingSystemIdentification != nil {
+			code = mmb.ClearingSystemIdentification.Code
+		}
+		switch code {
+		case ClearingSystemABA:
+			fi.IdentificationCode = wire.FEDRoutingNumber
+		case ClearingSystemCHIPS:
+			fi.IdentificationCode = wire.CHIPSParticipant
+		default:
+			c.warn(tag, field+".Identifier", "clearing system %q has no Fedwire identification code", code)
+			return fi
+		}
+		fi.Identifier = c.text(tag, field+".Identifier", mmb.MemberIdentification, 34)
+	case id.Other != nil:
+		code := schemeValue(id.Other.SchemeName)
+		if !identificationCodes[code] {
+			c.warn(tag, field+".Identifier", "scheme %q has no Fedwire identification code", code)
+			return fi
+		}
+		fi.IdentificationCode = code
+		fi.Identifier = c.text(tag, field+".Identifier", id.Other.Identification, 34)
+	}
+	return fi
+}
+
+// sameAgent reports if two agents are the same institution
+func sameAgent(a, b *BranchAndFinancialInstitutionIdentification) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	x, y := a.FinancialInstitutionIdentification, b.FinancialInstitutionIdentification
+	if x.BICFI != y.BICFI || x.Name != y.Name || x.PostalAddress != nil || y.PostalAddress != nil || x.Other != nil || y.Other != nil {
+		return false
+	}
+	if x.ClearingSystemMemberIdentification == nil || y.ClearingSystemMemberIdentification == nil {
+		return x.ClearingSystemMemberIdentification == y.ClearingSystemMemberIdentification
+	}
+	return x.ClearingSystemMemberIdentification.MemberIdentification == y.ClearingSystemMemberIdentification.MemberIdentification
+}
+
+// identificationCodes are the Fedwire identification codes of parties and financial institutions
+var identificationCodes = map[string]bool{
+	wire.SWIFTBankIdentifierCode:       true,
+	wire.CHIPSParticipant:              true,
+	wire.DemandDepositAccountNumber:    true,
+	wire.FEDRoutingNumber:              true,
+	wire.SWIFTBICORBEIANDAccountNumber: true,
+	wire.CHIPSIdentifier:               true,
+	wire.PassportNumber:                true,
+	wire.TaxIdentificationNumber:       true,
+	wire.DriversLicenseNumber:          true,
+	wire.AlienRegistrationNumber:       true,
+	wire.CorporateIdentification:       true,
+	wire.OtherIdentification:           true,
+}
+
+// personSchemes are the ISO 20022 person identification schemes of Fedwire identification codes
+var personSchemes = map[string]string{
+	wire.PassportNumber:          wire.PartyIdentifierPassportNumber,
+	wire.TaxIdentificationNumber: wire.PartyIdentifierTaxIdentificationNumber,
+	wire.DriversLicenseNumber:    wire.PartyIdentifierDriversLicenseNumber,
+	wire.AlienRegistrationNumber: wire.PartyIdentifierAlienRegistrationNumber,
+}
+
+// schemeValue returns the code or proprietary value of a scheme name
+func schemeValue(scheme *CodeOrProprietary) string {
+	if scheme == nil {
+		return ""
+	}
+	if scheme.Code != "" {
+		return scheme.Code
+	}
+	return scheme.Proprietary
+}
+
+// party returns the ISO 20022 party and account of a Fedwire originator or beneficiary. Account numbers become
+// the party's account, BICs and the person identification codes get their own elements and other codes are
+// kept as a proprietary scheme.
+func (c *converter) party(p wire.Personal) (PartyIdentification, *CashAccount) {
+	party := PartyIdentification{
+		Name:          strings.TrimSpace(p.Name),
+		PostalAddress: postalAddress(p.Address),
+	}
+	code := p.IdentificationCode
+	other := GenericIdentification{
+		Identification: p.Identifier,
+		SchemeName:     &CodeOrProprietary{Proprietary: code},
+	}
+	switch {
+	case code == "":
+	case code == wire.DemandDepositAccountNumber:
+		return party, account(p.Identifier)
+	case code == wire.SWIFTBankIdentifierCode:
+		party.Identification = &Party{OrganisationIdentification: &OrganisationIdentification{AnyBIC: p.Identifier}}
+	case personSchemes[code] != "":
+		other.SchemeName = &CodeOrProprietary{Code: personSchemes[code]}
+		party.Identification = &Party{PrivateIdentification: &PersonIdentification{Other: []GenericIdentification{other}}}
+	case code == wire.OtherIdentification:
+		party.Identification = &Party{PrivateIdentification: &PersonIdentification{Other: []GenericIdentification{other}}}
+	default:
+		party.Identification = &Party{OrganisationIdentification: &OrganisationIdentification{Other: []GenericIdentification{other}}}
+	}
+	return party, nil
+}
+
+// personal returns the Fedwire originator or beneficiary of an ISO 20022 party and account
+func (c *converter) personal(tag, field string, party PartyIdentification, acct *CashAccount) wire.Personal {
+	p := wire.Personal{
+		Name:    c.text(tag, field+".Name", party.Name, 35),
+		Address: c.address(tag, field+".Address", party.PostalAddress),
+	}
+	c.unmapped(party.CountryOfResidence != "", tag, field+".CountryOfResidence")
+	c.unmapped(party.ContactDetails != nil, tag, field+".ContactDetails")
+
+	if acct != nil {
+		p.IdentificationCode = wire.DemandDepositAccountNumber
+		p.Identifier = c.text(tag, field+".Identifier", accountNumber(acct), 34)
+		c.unmapped(party.Identification != nil, tag, field+".Identifier")
+		return p
+	}
+	if party.Identification == nil {
+		return p
+	}
+	var others []GenericIdentification
+	if org := party.Identification.OrganisationIdentification; org != nil {
+		if org.AnyBIC != "" {
+			p.IdentificationCode = wire.SWIFTBankIdentifierCode
+			p.Identifier = org.AnyBIC
+			return p
+		}
+		others = org.Other
+	}
+	if prvt := party.Identification.PrivateIdentification; prvt != nil {
+		others = append(others, prvt.Other...)
+	}
+	if len(others) == 0 {
+		return p
+	}
+	if len(others) > 1 {
+		c.warn(tag, field+".Identifier", "only the first of %d identifications fits", len(others))
+	}
+	p.IdentificationCode = identificationCode(others[0].SchemeName)
+	if p.IdentificationCode == wire.OtherIdentification && schemeValue(others[0].SchemeName) != wire.OtherIdentification {
+		c.warn(tag, field+".IdentificationCode", "scheme %q has no Fedwire identification code", schemeValue(others[0].SchemeName))
+	}
+	p.Identifier = c.text(tag, field+".Identifier", others[0].Identification, 34)
+	return p
+}
+
+// identificationCode returns the Fedwire identification code of a scheme, which is OtherIdentification
+// when the scheme has no Fedwire code of its own.
+func identificationCode(scheme *CodeOrProprietary) string {
+	if scheme == nil {
+		return wire.OtherIdentification
+	}
+	for code, iso := range personSchemes {
+		if scheme.Code == iso {
+			return code
+		}
+	}
+	if identificationCodes[scheme.Proprietary] {
+		return scheme.Proprietary
+	}
+	return wire.OtherIdentification
+}
+
+// account returns an account identified by a Fedwire account number
+func account(number string) *CashAccount {
+	return &CashAccount{
+		Identification: AccountIdentification{
+			Other: &GenericIdentification{Identification: number},
+		},
+	}
+}
+
+// accountNumber returns the IBAN or other account number of acct
+func accountNumber(acct *CashAccount) string {
+	if acct.Identification.IBAN != "" {
+		return acct.Identification.IBAN
+	}
+	if acct.Identification.Other != nil {
+		return acct.Identification.Other.Identification
+	}
+	return ""
+}
+
+// postalAddress returns the unstructured ISO 20022 address of a Fedwire address, or nil when it's empty
+func postalAddress(addr wire.Address) *PostalAddress {
+	lines := trimLines(addr.AddressLineOne, addr.AddressLineTwo, addr.AddressLineThree)
+	if len(lines) == 0 {
+		return nil
+	}
+	return &PostalAddress{AddressLines: lines}
+}
+
+// address returns the Fedwire address of an ISO 20022 address. Fedwire addresses are three unstructured
+// lines, so structured addresses are written out as lines.
+func (c *converter) address(tag, field string, pa *PostalAddress) wire.Address {
+	if pa == nil {
+		return wire.Address{}
+	}
+	lines := pa.AddressLines
+	structured := addressLines(pa)
+	if len(lines) == 0 {
+		lines = structured
+	} else if len(structured) > 0 {
+		c.warn(tag, field, "structured address dropped in favour of the address lines")
+	}
+	lines = c.lines(tag, field, lines, 3, 35)
+	return wire.Address{
+		AddressLineOne:   lines[0],
+		AddressLineTwo:   lines[1],
+		AddressLineThree: lines[2],
+	}
+}
+
+// addressLines writes out the structured elements of an address as lines
+func addressLines(pa *PostalAddress) []string {
+	join := func(values ...string) string {
+		return strings.Join(strings.Fields(strings.Join(values, " ")), " ")
+	}
+	var lines []string
+	for _, line := range []string{
+		join(pa.Department, pa.SubDepartment),
+		join(pa.BuildingNumber, pa.StreetName),
+		join(pa.TownName, pa.CountrySubDivision, pa.PostCode),
+		pa.Country,
+	} {
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
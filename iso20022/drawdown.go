@@ -0,0 +1,173 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package iso20022
+
+import (
+	"strings"
+
+	"github.com/moov-io/wire"
+)
+
+// A drawdown request (sub type 31) asks the receiver to debit {4400} AccountDebitedDrawdown and pay the
+// {4200} Beneficiary at {5400} AccountCreditedDrawdown. The receiver honors it with a drawdown payment (DRW,
+// sub type 32) or refuses it (sub type 33), and the reply refers to the request by its IMAD in {3500}
+// PreviousMessageIdentifier. pain.013 documents carry requests and pain.014 documents the replies.
+
+const (
+	// LocalInstrumentCustomerDrawdown is the pain.013 and pain.014 local instrument of a
+	// CustomerCorporateDrawdownRequest (DRC)
+	LocalInstrumentCustomerDrawdown = "DRCF"
+	// LocalInstrumentBankDrawdown is the pain.013 and pain.014 local instrument of a BankDrawDownRequest (DRB)
+	LocalInstrumentBankDrawdown = "DRBF"
+)
+
+// drawdownInstrument returns the local instrument of a drawdown, which is a bank drawdown when it settles
+// between banks (type 16)
+func drawdownInstrument(fwm *wire.FEDWireMessage) *PaymentTypeInformation {
+	instrument := LocalInstrumentCustomerDrawdown
+	if fwm.BusinessFunctionCode.BusinessFunctionCode == wire.BankDrawDownRequest || fwm.TypeSubType.TypeCode == wire.SettlementTransfer {
+		instrument = LocalInstrumentBankDrawdown
+	}
+	return &PaymentTypeInformation{LocalInstrument: &CodeOrProprietary{Proprietary: instrument}}
+}
+
+// localInstrumentCode returns the local instrument of pti, if any
+func localInstrumentCode(pti *PaymentTypeInformation) string {
+	if pti == nil || pti.LocalInstrument == nil {
+		return ""
+	}
+	return schemeValue(pti.LocalInstrument)
+}
+
+// identifiedParty returns the ISO 20022 party of a {5000} Originator or {4200} Beneficiary where the party
+// has no account of its own. A demand deposit account is kept as the party's identification instead.
+func (c *converter) identifiedParty(p wire.Personal) PartyIdentification {
+	party, acct := c.party(p)
+	if acct != nil {
+		party.Identification = &Party{OrganisationIdentification: &OrganisationIdentification{
+			Other: []GenericIdentification{{
+				Identification: accountNumber(acct),
+				SchemeName:     &CodeOrProprietary{Proprietary: wire.DemandDepositAccountNumber},
+			}},
+		}}
+	}
+	return party
+}
+
+// emptyParty reports whether party has nothing a Fedwire originator or beneficiary could carry
+func emptyParty(party *PartyIdentification) bool {
+	return party == nil || (strings.TrimSpace(party.Name) == "" && party.PostalAddress == nil && party.Identification == nil)
+}
+
+// accountDebited returns the debtor and account of a {4400} AccountDebitedDrawdown
+func accountDebited(fwm *wire.FEDWireMessage) (*PartyIdentification, *CashAccount) {
+	debit := fwm.AccountDebitedDrawdown
+	if debit == nil {
+		return nil, nil
+	}
+	party := &PartyIdentification{
+		Name:          strings.TrimSpace(debit.Name),
+		PostalAddress: postalAddress(debit.Address),
+	}
+	return party, account(strings.TrimSpace(debit.Identifier))
+}
+
+// setAccountDebited sets the {4400} of a debtor's account on fwm
+func (c *converter) setAccountDebited(fwm *wire.FEDWireMessage, party *PartyIdentification, acct *CashAccount) {
+	tag := wire.TagAccountDebitedDrawdown
+	if acct == nil {
+		if !emptyParty(party) {
+			c.warn(tag, "AccountDebitedDrawdown", "a debtor without an account has no Fedwire equivalent")
+		}
+		return
+	}
+	debit := wire.NewAccountDebitedDrawdown()
+	debit.IdentificationCode = wire.DemandDepositAccountNumber
+	debit.Identifier = c.text(tag, "AccountDebitedDrawdown.Identifier", accountNumber(acct), 34)
+	if party != nil {
+		debit.Name = c.text(tag, "AccountDebitedDrawdown.Name", party.Name, 35)
+		debit.Address = c.address(tag, "AccountDebitedDrawdown.Address", party.PostalAddress)
+		c.unmapped(party.Identification != nil, tag, "AccountDebitedDrawdown.Identification")
+		c.unmapped(party.CountryOfResidence != "", tag, "AccountDebitedDrawdown.CountryOfResidence")
+		c.unmapped(party.ContactDetails != nil, tag, "AccountDebitedDrawdown.ContactDetails")
+	}
+	fwm.AccountDebitedDrawdown = debit
+}
+
+// accountCredited returns the creditor account of a {5400} AccountCreditedDrawdown
+func accountCredited(fwm *wire.FEDWireMessage) *CashAccount {
+	if fwm.AccountCreditedDrawdown == nil {
+		return nil
+	}
+	return account(strings.TrimSpace(fwm.AccountCreditedDrawdown.DrawdownCreditAccountNumber))
+}
+
+// setAccountCredited sets the {5400} of a creditor account on fwm
+func (c *converter) setAccountCredited(fwm *wire.FEDWireMessage, acct *CashAccount) {
+	if acct == nil {
+		return
+	}
+	credit := wire.NewAccountCreditedDrawdown()
+	credit.DrawdownCreditAccountNumber = c.text(wire.TagAccountCreditedDrawdown, "AccountCreditedDrawdown.DrawdownCreditAccountNumber", accountNumber(acct), 9)
+	fwm.AccountCreditedDrawdown = credit
+}
+
+// setOriginator sets the {5000} of the party which initiated a drawdown request or reply on fwm
+func (c *converter) setOriginator(fwm *wire.FEDWireMessage, party *PartyIdentification) {
+	if emptyParty(party) {
+		return
+	}
+	fwm.Originator = wire.NewOriginator()
+	fwm.Originator.Personal = c.personal(wire.TagOriginator, "Originator.Personal", *party, nil)
+}
+
+// setBeneficiary sets the {4200} of a drawdown's creditor on fwm
+func (c *converter) setBeneficiary(fwm *wire.FEDWireMessage, party *PartyIdentification) {
+	if emptyParty(party) {
+		return
+	}
+	fwm.Beneficiary = wire.NewBeneficiary()
+	fwm.Beneficiary.Personal = c.personal(wire.TagBeneficiary, "Beneficiary.Personal", *party, nil)
+}
+
+// drawdownTags records the tags a drawdown request or reply has no ISO 20022 equivalent for
+func (c *converter) drawdownTags(fwm *wire.FEDWireMessage) {
+	c.unmapped(fwm.PaymentNotification != nil, wire.TagPaymentNotification, "PaymentNotification")
+	c.unmapped(fwm.Charges != nil, wire.TagCharges, "Charges")
+	c.unmapped(fwm.InstructedAmount != nil, wire.TagInstructedAmount, "InstructedAmount")
+	c.unmapped(fwm.ExchangeRate != nil, wire.TagExchangeRate, "ExchangeRate")
+	c.unmapped(fwm.LocalInstrument != nil, wire.TagLocalInstrument, "LocalInstrument")
+	c.unmapped(fwm.OriginatorOptionF != nil, wire.TagOriginatorOptionF, "OriginatorOptionF")
+	c.unmapped(fwm.BeneficiaryIntermediaryFI != nil, wire.TagBeneficiaryIntermediaryFI, "BeneficiaryIntermediaryFI")
+	c.unmapped(fwm.BeneficiaryFI != nil, wire.TagBeneficiaryFI, "BeneficiaryFI")
+	c.unmapped(fwm.OriginatorFI != nil, wire.TagOriginatorFI, "OriginatorFI")
+	c.unmapped(fwm.InstructingFI != nil, wire.TagInstructingFI, "InstructingFI")
+	c.unmapped(fwm.UnstructuredAddenda != nil, wire.TagUnstructuredAddenda, "UnstructuredAddenda")
+	c.remittanceTags(fwm)
+	c.fiToFiInformation(fwm)
+	c.coverPayment(fwm)
+}
+
+// drawdownRemittance returns the RmtInf of a drawdown's {6000} OriginatorToBeneficiary
+func drawdownRemittance(fwm *wire.FEDWireMessage) *RemittanceInformation {
+	if ob := fwm.OriginatorToBeneficiary; ob != nil {
+		if lines := trimLines(ob.LineOne, ob.LineTwo, ob.LineThree, ob.LineFour); len(lines) > 0 {
+			return &RemittanceInformation{Unstructured: lines}
+		}
+	}
+	return nil
+}
+
+// setDrawdownRemittance sets the {6000} of a drawdown's unstructured remittance on fwm. Drawdowns have no
+// structured remittance.
+func (c *converter) setDrawdownRemittance(fwm *wire.FEDWireMessage, path string, rmtInf *RemittanceInformation) {
+	if rmtInf == nil {
+		return
+	}
+	if len(rmtInf.Structured) > 0 {
+		c.warnElement(path+"/Strd", "drawdowns have no structured remittance")
+	}
+	c.setRemittanceInformation(fwm, path, &RemittanceInformation{Unstructured: rmtInf.Unstructured})
+}
@@ -0,0 +1,185 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+// Package iso20022 converts Fedwire FEDWireMessages to and from ISO 20022 XML messages.
+//
+// Fedwire tags and ISO 20022 elements don't line up one to one. Values which can't be carried over, or which
+// had to be shortened, are returned as Warnings next to the converted message rather than being dropped
+// silently.
+package iso20022
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/moov-io/wire"
+)
+
+const (
+	// ClearingSystemFedwire is the ISO 20022 clearing system code of the Fedwire Funds Service
+	ClearingSystemFedwire = "FDW"
+	// ClearingSystemABA is the clearing system id of ABA routing numbers
+	ClearingSystemABA = "USABA"
+	// ClearingSystemCHIPS is the clearing system id of CHIPS participant numbers
+	ClearingSystemCHIPS = "USPID"
+	// SettlementMethodClearing is the settlement method of transfers settled through a clearing system
+	SettlementMethodClearing = "CLRG"
+	// NotProvided is the ISO 20022 placeholder for a mandatory reference which has no value
+	NotProvided = "NOTPROVIDED"
+
+	currencyUSD = "USD"
+	isoDate     = "2006-01-02"
+	isoDateTime = "2006-01-02T15:04:05-07:00"
+	fedDate     = "20060102"
+)
+
+var (
+	// ErrUnsupportedMessage is returned when a FEDWireMessage has no equivalent in the requested ISO 20022 message
+	ErrUnsupportedMessage = errors.New("unsupported message")
+	// ErrMissingTag is returned when a FEDWireMessage lacks a tag the ISO 20022 message requires
+	ErrMissingTag = errors.New("missing tag")
+	// ErrMissingElement is returned when an ISO 20022 message lacks an element the FEDWireMessage requires
+	ErrMissingElement = errors.New("missing element")
+	// ErrInvalidAmount is returned when an amount can't be represented in Fedwire's format
+	ErrInvalidAmount = errors.New("invalid amount")
+)
+
+// Warning describes a value which was dropped or altered while converting between a FEDWireMessage and an
+// ISO 20022 message.
+type Warning struct {
+	// Tag is the Fedwire tag of the value, e.g. {6100}
+	Tag string `json:"tag,omitempty"`
+	// Field is the FEDWireMessage field of the value, e.g. FIReceiverFI or Beneficiary.Personal.Name
+	Field string `json:"field,omitempty"`
+	// Element is the ISO 20022 element of the value when it has no Fedwire tag, e.g. CdtTrfTxInf/UltmtDbtr
+	Element string `json:"element,omitempty"`
+	// Reason explains what happened to the value
+	Reason string `json:"reason"`
+}
+
+func (w Warning) String() string {
+	if w.Tag == "" {
+		return fmt.Sprintf("%s: %s", w.Element, w.Reason)
+	}
+	return fmt.Sprintf("%s %s: %s", w.Tag, w.Field, w.Reason)
+}
+
+// missingTag returns an error for a FEDWireMessage field which must be present
+func missingTag(tag, field string) error {
+	return fmt.Errorf("%s %s: %w", tag, field, ErrMissingTag)
+}
+
+// missingElement returns an error for an ISO 20022 element which must be present
+func missingElement(path string) error {
+	return fmt.Errorf("%s: %w", path, ErrMissingElement)
+}
+
+// uetr derives a version 4 formatted UUID from the IMAD, so converting the same FEDWireMessage always produces
+// the same Unique End-to-end Transaction Reference.
+func uetr(imad string) string {
+	sum := sha256.Sum256([]byte(imad))
+	sum[6] = (sum[6] & 0x0f) | 0x40
+	sum[8] = (sum[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", sum[0:4], sum[4:6], sum[6:8], sum[8:10], sum[10:16])
+}
+
+// parseIMAD reads an IMAD reference written by InputMessageAccountabilityData.Reference
+func parseIMAD(s string) *wire.InputMessageAccountabilityData {
+	if len(s) != 22 {
+		return nil
+	}
+	if _, err := time.Parse(fedDate, s[:8]); err != nil {
+		return nil
+	}
+	if _, err := strconv.Atoi(s[16:]); err != nil {
+		return nil
+	}
+	imad := wire.NewInputMessageAccountabilityData()
+	imad.InputCycleDate = s[:8]
+	imad.InputSource = s[8:16]
+	imad.InputSequenceNumber = s[16:]
+	return imad
+}
+
+// isoDateFromFed converts a CCYYMMDD date into an ISO 20022 date
+func isoDateFromFed(s string) string {
+	t, err := time.Parse(fedDate, s)
+	if err != nil {
+		return ""
+	}
+	return t.Format(isoDate)
+}
+
+// fedDateFromISO converts an ISO 20022 date into CCYYMMDD
+func fedDateFromISO(s string) string {
+	t, err := time.Parse(isoDate, s)
+	if err != nil {
+		return ""
+	}
+	return t.Format(fedDate)
+}
+
+// creationDateTime is the CreDtTm of new documents, it's a variable so tests can fix it
+var creationDateTime = func() string {
+	return time.Now().In(wire.EasternTime).Format(isoDateTime)
+}
+
+// amountFromImplied converts a Fedwire {2000} amount (12 digits, implied decimal point) into an ISO 20022 amount
+func amountFromImplied(s string) (string, error) {
+	cents, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+	if err != nil || cents < 0 {
+		return "", fmt.Errorf("%q: %w", s, ErrInvalidAmount)
+	}
+	return fmt.Sprintf("%d.%02d", cents/100, cents%100), nil
+}
+
+// impliedFromAmount converts an ISO 20022 amount into a Fedwire {2000} amount
+func impliedFromAmount(s string) (string, error) {
+	whole, fraction, _ := strings.Cut(strings.TrimSpace(s), ".")
+	fraction = strings.TrimRight(fraction, "0")
+	if whole == "" || len(fraction) > 2 {
+		return "", fmt.Errorf("%q: %w", s, ErrInvalidAmount)
+	}
+	units, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil || units < 0 {
+		return "", fmt.Errorf("%q: %w", s, ErrInvalidAmount)
+	}
+	cents := int64(0)
+	if fraction != "" {
+		cents, err = strconv.ParseInt((fraction + "0")[:2], 10, 64)
+		if err != nil {
+			return "", fmt.Errorf("%q: %w", s, ErrInvalidAmount)
+		}
+	}
+	out := fmt.Sprintf("%012d", units*100+cents)
+	if len(out) > 12 {
+		return "", fmt.Errorf("%q: %w", s, ErrInvalidAmount)
+	}
+	return out, nil
+}
+
+// decimalFromComma converts a Fedwire amount or rate using a decimal comma (e.g. 1234,56) into an ISO 20022 decimal
+func decimalFromComma(s string) string {
+	return strings.Replace(s, ",", ".", 1)
+}
+
+// commaFromDecimal converts an ISO 20022 decimal into a Fedwire value using a decimal comma
+func commaFromDecimal(s string) string {
+	if !strings.Contains(s, ".") {
+		return s + ","
+	}
+	return strings.Replace(s, ".", ",", 1)
+}
+
+// splitCurrencyAmount splits a Fedwire currency and amount (e.g. USD1234,56) into an ISO 20022 amount
+func splitCurrencyAmount(s string) ActiveCurrencyAndAmount {
+	if len(s) < 3 {
+		return ActiveCurrencyAndAmount{Value: s}
+	}
+	return ActiveCurrencyAndAmount{Currency: s[:3], Value: decimalFromComma(s[3:])}
+}
@@ -0,0 +1,128 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package iso20022
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/moov-io/wire"
+	"github.com/stretchr/testify/require"
+)
+
+// readMessage reads the only FEDWireMessage of a file in test/testdata
+func readMessage(t *testing.T, name string) *wire.FEDWireMessage {
+	t.Helper()
+
+	fd, err := os.Open(filepath.Join("..", "test", "testdata", name))
+	require.NoError(t, err)
+	defer fd.Close()
+
+	file, err := wire.NewReader(fd).Read()
+	require.NoError(t, err)
+	require.Len(t, file.FEDWireMessages, 1)
+	return &file.FEDWireMessages[0]
+}
+
+// tagLines writes fwm and returns its records by tag. Writing validates fwm as well.
+func tagLines(t *testing.T, fwm *wire.FEDWireMessage) map[string]string {
+	t.Helper()
+
+	file := wire.NewFile()
+	file.AddFEDWireMessage(*fwm)
+
+	var buf bytes.Buffer
+	require.NoError(t, wire.NewWriter(&buf, wire.VariableLengthFields(true)).Write(file))
+
+	lines := make(map[string]string)
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		lines[line[:6]] = line
+	}
+	return lines
+}
+
+// requireRoundTrip checks every tag of orig and got is the same, except the tags a Warning was returned for
+func requireRoundTrip(t *testing.T, orig, got *wire.FEDWireMessage, warnings ...[]Warning) {
+	t.Helper()
+
+	warned := make(map[string]bool)
+	for _, ws := range warnings {
+		for _, w := range ws {
+			warned[w.Tag] = true
+		}
+	}
+	want, have := tagLines(t, orig), tagLines(t, got)
+	for tag, line := range want {
+		if !warned[tag] {
+			require.Equal(t, line, have[tag], "tag %s", tag)
+		}
+	}
+	for tag, line := range have {
+		if !warned[tag] {
+			require.Equal(t, want[tag], line, "tag %s", tag)
+		}
+	}
+}
+
+func TestWarning_String(t *testing.T) {
+	w := Warning{Tag: wire.TagFIReceiverFI, Field: "FIReceiverFI", Reason: "no pacs.008 equivalent"}
+	require.Equal(t, "{6100} FIReceiverFI: no pacs.008 equivalent", w.String())
+
+	w = Warning{Element: "FIToFICstmrCdtTrf/CdtTrfTxInf/UltmtDbtr", Reason: "no Fedwire equivalent"}
+	require.Equal(t, "FIToFICstmrCdtTrf/CdtTrfTxInf/UltmtDbtr: no Fedwire equivalent", w.String())
+}
+
+func TestAmounts(t *testing.T) {
+	amt, err := amountFromImplied("000001234567")
+	require.NoError(t, err)
+	require.Equal(t, "12345.67", amt)
+
+	amt, err = amountFromImplied("000000000005")
+	require.NoError(t, err)
+	require.Equal(t, "0.05", amt)
+
+	_, err = amountFromImplied("12AB")
+	require.ErrorIs(t, err, ErrInvalidAmount)
+
+	for input, expected := range map[string]string{
+		"12345.67":  "000001234567",
+		"12345.6":   "000001234560",
+		"12345":     "000001234500",
+		"12345.670": "000001234567",
+	} {
+		implied, err := impliedFromAmount(input)
+		require.NoError(t, err, input)
+		require.Equal(t, expected, implied, input)
+	}
+
+	for _, input := range []string{"12.345", "10000000000.00", "-1.00", ".50"} {
+		_, err := impliedFromAmount(input)
+		require.ErrorIs(t, err, ErrInvalidAmount, input)
+	}
+
+	require.Equal(t, ActiveCurrencyAndAmount{Currency: "USD", Value: "0.99"}, splitCurrencyAmount("USD0,99"))
+	require.Equal(t, "1234,", commaFromDecimal("1234"))
+	require.Equal(t, "1,2345", commaFromDecimal("1.2345"))
+}
+
+func TestIMAD(t *testing.T) {
+	imad := parseIMAD("20190410Source08000001")
+	require.NotNil(t, imad)
+	require.Equal(t, "20190410", imad.InputCycleDate)
+	require.Equal(t, "Source08", imad.InputSource)
+	require.Equal(t, "000001", imad.InputSequenceNumber)
+	require.Equal(t, "20190410Source08000001", imad.Reference())
+
+	require.Nil(t, parseIMAD("NOTPROVIDED"))
+	require.Nil(t, parseIMAD("20191310Source08000001"))
+
+	id := uetr("20190410Source08000001")
+	require.Regexp(t, "^[a-f0-9]{8}-[a-f0-9]{4}-4[a-f0-9]{3}-[89ab][a-f0-9]{3}-[a-f0-9]{12}$", id)
+	require.Equal(t, id, uetr("20190410Source08000001"))
+	require.NotEqual(t, id, uetr("20190410Source08000002"))
+}
@@ -0,0 +1,355 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package iso20022
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	"github.com/moov-io/wire"
+)
+
+// Pacs004Namespace is the XML namespace of pacs.004.001.09 documents
+const Pacs004Namespace = "urn:iso:std:iso:20022:tech:xsd:pacs.004.001.09"
+
+// Pacs004 is a pacs.004.001.09 PaymentReturn document, the ISO 20022 equivalent of a reversal (sub type 02)
+// or reversal of a prior day transfer (sub type 08).
+type Pacs004 struct {
+	XMLName       xml.Name      `xml:"urn:iso:std:iso:20022:tech:xsd:pacs.004.001.09 Document"`
+	PaymentReturn PaymentReturn `xml:"PmtRtr"`
+}
+
+// PaymentReturn is the PmtRtr message of a pacs.004 document
+type PaymentReturn struct {
+	GroupHeader  GroupHeader                `xml:"GrpHdr"`
+	Transactions []PaymentReturnTransaction `xml:"TxInf"`
+}
+
+// PaymentReturnTransaction is the TxInf of a pacs.004 document
+type PaymentReturnTransaction struct {
+	ReturnID                          string                                       `xml:"RtrId,omitempty"`
+	OriginalInstructionID             string                                       `xml:"OrgnlInstrId,omitempty"`
+	OriginalEndToEndID                string                                       `xml:"OrgnlEndToEndId,omitempty"`
+	OriginalUETR                      string                                       `xml:"OrgnlUETR,omitempty"`
+	OriginalInterbankSettlementDate   string                                       `xml:"OrgnlIntrBkSttlmDt,omitempty"`
+	PaymentTypeInformation            *PaymentTypeInformation                      `xml:"PmtTpInf,omitempty"`
+	ReturnedInterbankSettlementAmount ActiveCurrencyAndAmount                      `xml:"RtrdIntrBkSttlmAmt"`
+	InterbankSettlementDate           string                                       `xml:"IntrBkSttlmDt,omitempty"`
+	ReturnedInstructedAmount          *ActiveCurrencyAndAmount                     `xml:"RtrdInstdAmt,omitempty"`
+	ExchangeRate                      string                                       `xml:"XchgRate,omitempty"`
+	ChargeBearer                      string                                       `xml:"ChrgBr,omitempty"`
+	ChargesInformation                []ChargesInformation                         `xml:"ChrgsInf,omitempty"`
+	InstructingAgent                  *BranchAndFinancialInstitutionIdentification `xml:"InstgAgt,omitempty"`
+	InstructedAgent                   *BranchAndFinancialInstitutionIdentification `xml:"InstdAgt,omitempty"`
+	ReturnChain                       *TransactionParties                          `xml:"RtrChain,omitempty"`
+	ReturnReasonInformation           []ReasonInformation                          `xml:"RtrRsnInf,omitempty"`
+	OriginalTransactionReference      *OriginalTransactionReference                `xml:"OrgnlTxRef,omitempty"`
+
+	// Unmapped holds the elements read from a document which have no Fedwire equivalent
+	Unmapped []UnmappedElement `xml:",any"`
+}
+
+// TransactionParties are the parties and agents of a return, in the direction of the return
+type TransactionParties struct {
+	Debtor                   *PartyOrAgent                                `xml:"Dbtr,omitempty"`
+	DebtorAccount            *CashAccount                                 `xml:"DbtrAcct,omitempty"`
+	DebtorAgent              *BranchAndFinancialInstitutionIdentification `xml:"DbtrAgt,omitempty"`
+	PreviousInstructingAgent *BranchAndFinancialInstitutionIdentification `xml:"PrvsInstgAgt1,omitempty"`
+	IntermediaryAgent        *BranchAndFinancialInstitutionIdentification `xml:"IntrmyAgt1,omitempty"`
+	CreditorAgent            *BranchAndFinancialInstitutionIdentification `xml:"CdtrAgt,omitempty"`
+	Creditor                 *PartyOrAgent                                `xml:"Cdtr,omitempty"`
+	CreditorAccount          *CashAccount                                 `xml:"CdtrAcct,omitempty"`
+}
+
+// NewPacs004 converts a reversal (sub type 02 or 08 of a BTR, FFS, FFR, CTR or CTP) into a pacs.004 document.
+//
+// {3500} PreviousMessageIdentifier identifies the original transfer and {6000} OriginatorToBeneficiary becomes
+// the return reason. The return chain holds the originator, beneficiary and their financial institutions,
+// which are institutions (Agt) in bank transfers and parties (Pty) in customer transfers.
+func NewPacs004(fwm *wire.FEDWireMessage) (*Pacs004, []Warning, error) {
+	if err := requireTags(fwm); err != nil {
+		return nil, nil, err
+	}
+	switch sub := fwm.TypeSubType.SubTypeCode; sub {
+	case wire.ReversalTransfer, wire.ReversalPriorDayTransfer:
+	default:
+		return nil, nil, fmt.Errorf("%w: pacs.004 carries reversals, not sub type %s", ErrUnsupportedMessage, sub)
+	}
+	var instrument, typeCode string
+	bankTransfer := true
+	switch bfc := fwm.BusinessFunctionCode.BusinessFunctionCode; bfc {
+	case wire.BankTransfer:
+		instrument, typeCode = LocalInstrumentBankTransfer, wire.FundsTransfer
+	case wire.FEDFundsSold:
+		instrument, typeCode = LocalInstrumentFEDFundsSold, wire.SettlementTransfer
+	case wire.FEDFundsReturned:
+		instrument, typeCode = LocalInstrumentFEDFundsReturned, wire.SettlementTransfer
+	case wire.CustomerTransfer, wire.CustomerTransferPlus:
+		if isCover(fwm) {
+			return nil, nil, fmt.Errorf("%w: pacs.004 doesn't carry the underlying customer transfer of a cover payment", ErrUnsupportedMessage)
+		}
+		typeCode, bankTransfer = wire.FundsTransfer, false
+	default:
+		return nil, nil, fmt.Errorf("%w: reversals are bank or customer transfers, not %s", ErrUnsupportedMessage, bfc)
+	}
+	if fwm.PreviousMessageIdentifier == nil {
+		return nil, nil, missingTag(wire.TagPreviousMessageIdentifier, "PreviousMessageIdentifier")
+	}
+
+	c := &converter{message: "pacs.004"}
+	c.envelope(fwm)
+	c.unmapped(fwm.TypeSubType.TypeCode != typeCode, wire.TagTypeSubType, "TypeSubType.TypeCode")
+
+	amount, err := c.settlementAmount(fwm)
+	if err != nil {
+		return nil, nil, err
+	}
+	sender := fedAgent(fwm.SenderDepositoryInstitution.SenderABANumber, fwm.SenderDepositoryInstitution.SenderShortName)
+	receiver := fedAgent(fwm.ReceiverDepositoryInstitution.ReceiverABANumber, fwm.ReceiverDepositoryInstitution.ReceiverShortName)
+
+	originalID, originalUETR, originalDate := originalReference(fwm)
+	c.checkReversalSubType(fwm, false, originalDate)
+
+	pmtID := c.paymentIdentification(fwm)
+	tx := PaymentReturnTransaction{
+		ReturnID:                          pmtID.InstructionID,
+		OriginalInstructionID:             originalID,
+		OriginalEndToEndID:                pmtID.EndToEndID,
+		OriginalUETR:                      originalUETR,
+		OriginalInterbankSettlementDate:   originalDate,
+		ReturnedInterbankSettlementAmount: amount,
+		InterbankSettlementDate:           settlementDate(fwm),
+		InstructingAgent:                  sender,
+		InstructedAgent:                   receiver,
+	}
+	if bankTransfer {
+		tx.PaymentTypeInformation = &PaymentTypeInformation{LocalInstrument: &CodeOrProprietary{Proprietary: instrument}}
+		c.unmapped(fwm.Charges != nil, wire.TagCharges, "Charges")
+		c.unmapped(fwm.InstructedAmount != nil, wire.TagInstructedAmount, "InstructedAmount")
+		c.unmapped(fwm.ExchangeRate != nil, wire.TagExchangeRate, "ExchangeRate")
+	} else {
+		tx.PaymentTypeInformation = localInstrument(fwm)
+		if fwm.Charges != nil {
+			tx.ChargeBearer, tx.ChargesInformation = c.charges(fwm.Charges, sender)
+		}
+		tx.ReturnedInstructedAmount, tx.ExchangeRate = instructedAmount(fwm)
+	}
+	c.unmapped(fwm.PaymentNotification != nil, wire.TagPaymentNotification, "PaymentNotification")
+
+	chain := &TransactionParties{}
+	if bankTransfer {
+		chain.Debtor = &PartyOrAgent{Agent: sender}
+		chain.Creditor = &PartyOrAgent{Agent: receiver}
+	}
+	if fi := fwm.InstructingFI; fi != nil {
+		chain.PreviousInstructingAgent = c.agent(fi.FinancialInstitution)
+	}
+	if fi := fwm.BeneficiaryIntermediaryFI; fi != nil {
+		chain.IntermediaryAgent = c.agent(fi.FinancialInstitution)
+	}
+	if fi := fwm.OriginatorFI; fi != nil {
+		chain.DebtorAgent = c.agent(fi.FinancialInstitution)
+	}
+	if fi := fwm.BeneficiaryFI; fi != nil {
+		chain.CreditorAgent = c.agent(fi.FinancialInstitution)
+	}
+	if fwm.Originator != nil {
+		chain.Debtor, chain.DebtorAccount = c.partyOrAgent(bankTransfer, fwm.Originator.Personal)
+		c.unmapped(fwm.OriginatorOptionF != nil, wire.TagOriginatorOptionF, "OriginatorOptionF")
+	} else if fwm.OriginatorOptionF != nil {
+		if bankTransfer {
+			c.unmapped(true, wire.TagOriginatorOptionF, "OriginatorOptionF")
+		} else {
+			party, acct := c.optionF(fwm.OriginatorOptionF)
+			chain.Debtor, chain.DebtorAccount = &PartyOrAgent{Party: &party}, acct
+		}
+	}
+	if fwm.Beneficiary != nil {
+		chain.Creditor, chain.CreditorAccount = c.partyOrAgent(bankTransfer, fwm.Beneficiary.Personal)
+	}
+	if *chain != (TransactionParties{}) {
+		tx.ReturnChain = chain
+	}
+
+	if ob := fwm.OriginatorToBeneficiary; ob != nil {
+		if lines := trimLines(ob.LineOne, ob.LineTwo, ob.LineThree, ob.LineFour); len(lines) > 0 {
+			tx.ReturnReasonInformation = []ReasonInformation{{AdditionalInformation: lines}}
+		}
+	}
+	if bankTransfer {
+		c.remittanceTags(fwm)
+	} else {
+		if strd := c.structuredRemittance(fwm); strd != nil {
+			tx.OriginalTransactionReference = &OriginalTransactionReference{
+				RemittanceInformation: &RemittanceInformation{Structured: []StructuredRemittanceInformation{*strd}},
+			}
+		}
+		c.unmapped(fwm.RelatedRemittance != nil, wire.TagRelatedRemittance, "RelatedRemittance")
+	}
+	c.unmapped(fwm.UnstructuredAddenda != nil, wire.TagUnstructuredAddenda, "UnstructuredAddenda")
+	c.fiToFiInformation(fwm)
+	c.coverPayment(fwm)
+	c.unmapped(fwm.AccountDebitedDrawdown != nil, wire.TagAccountDebitedDrawdown, "AccountDebitedDrawdown")
+	c.unmapped(fwm.AccountCreditedDrawdown != nil, wire.TagAccountCreditedDrawdown, "AccountCreditedDrawdown")
+	c.unmapped(fwm.ServiceMessage != nil, wire.TagServiceMessage, "ServiceMessage")
+
+	doc := &Pacs004{
+		PaymentReturn: PaymentReturn{
+			GroupHeader:  c.groupHeader(fwm),
+			Transactions: []PaymentReturnTransaction{tx},
+		},
+	}
+	return doc, c.warnings, nil
+}
+
+// FEDWireMessage converts the document's return into a reversal.
+//
+// The business function code follows from the local instrument: BTRF, FFSF and FFRF are bank transfers, other
+// instruments customer transfers plus. Without an instrument a return chain of institutions is a bank transfer
+// and one of parties a customer transfer. It's a reversal of a prior day transfer (08) when the original settled
+// before the cycle date of the return's IMAD.
+func (doc *Pacs004) FEDWireMessage() (*wire.FEDWireMessage, []Warning, error) {
+	txs := doc.PaymentReturn.Transactions
+	if len(txs) != 1 {
+		return nil, nil, fmt.Errorf("%w: Fedwire messages carry one transaction, found %d", ErrUnsupportedMessage, len(txs))
+	}
+	tx := txs[0]
+	path := "PmtRtr/TxInf"
+
+	c := &converter{message: "pacs.004"}
+	fwm := c.newMessage(doc.PaymentReturn.GroupHeader.MessageID, tx.ReturnID, tx.OriginalEndToEndID)
+	if err := c.setAmount(fwm, path+"/RtrdIntrBkSttlmAmt", tx.ReturnedInterbankSettlementAmount); err != nil {
+		return nil, nil, err
+	}
+	if err := c.setDepositoryInstitutions(fwm, path+"/InstgAgt", tx.InstructingAgent, path+"/InstdAgt", tx.InstructedAgent); err != nil {
+		return nil, nil, err
+	}
+	if err := c.setOriginalReference(fwm, path+"/OrgnlInstrId", tx.OriginalInstructionID, path+"/OrgnlUETR", tx.OriginalUETR); err != nil {
+		return nil, nil, err
+	}
+	fwm.TypeSubType.SubTypeCode = reversalSubType(false, tx.OriginalInterbankSettlementDate, settlementDate(fwm))
+
+	chain := tx.ReturnChain
+	if chain == nil {
+		chain = &TransactionParties{}
+	}
+	instrument := ""
+	if pti := tx.PaymentTypeInformation; pti != nil {
+		if pti.LocalInstrument != nil {
+			instrument = schemeValue(pti.LocalInstrument)
+		}
+		if pti.CategoryPurpose != nil {
+			c.warnElement(path+"/PmtTpInf/CtgyPurp", "no Fedwire equivalent")
+		}
+	}
+	bankTransfer := true
+	switch instrument {
+	case LocalInstrumentBankTransfer:
+		fwm.BusinessFunctionCode.BusinessFunctionCode = wire.BankTransfer
+	case LocalInstrumentFEDFundsSold:
+		fwm.BusinessFunctionCode.BusinessFunctionCode = wire.FEDFundsSold
+		fwm.TypeSubType.TypeCode = wire.SettlementTransfer
+	case LocalInstrumentFEDFundsReturned:
+		fwm.BusinessFunctionCode.BusinessFunctionCode = wire.FEDFundsReturned
+		fwm.TypeSubType.TypeCode = wire.SettlementTransfer
+	case LocalInstrumentCover:
+		return nil, nil, fmt.Errorf("%w: pacs.004 doesn't carry the underlying customer transfer of a cover payment", ErrUnsupportedMessage)
+	case "":
+		if (chain.Debtor != nil && chain.Debtor.Party != nil) || (chain.Creditor != nil && chain.Creditor.Party != nil) {
+			fwm.BusinessFunctionCode.BusinessFunctionCode = wire.CustomerTransfer
+			bankTransfer = false
+		} else {
+			fwm.BusinessFunctionCode.BusinessFunctionCode = wire.BankTransfer
+		}
+	default:
+		fwm.BusinessFunctionCode.BusinessFunctionCode = wire.CustomerTransferPlus
+		c.setLocalInstrument(fwm, instrument)
+		bankTransfer = false
+	}
+
+	if bankTransfer {
+		if tx.ReturnedInstructedAmount != nil || tx.ExchangeRate != "" {
+			c.warnElement(path+"/RtrdInstdAmt", "bank transfers have no instructed amount")
+		}
+		if tx.ChargeBearer != "" || len(tx.ChargesInformation) > 0 {
+			c.warnElement(path+"/ChrgsInf", "bank transfers have no charges")
+		}
+	} else {
+		c.setInstructedAmount(fwm, tx.ReturnedInstructedAmount, tx.ExchangeRate)
+		c.setCharges(fwm, tx.ChargeBearer, tx.ChargesInformation)
+	}
+
+	if chain.PreviousInstructingAgent != nil {
+		fi := wire.NewInstructingFI()
+		fi.FinancialInstitution = c.financialInstitution(wire.TagInstructingFI, "InstructingFI.FinancialInstitution", chain.PreviousInstructingAgent)
+		fwm.InstructingFI = fi
+	}
+	if chain.IntermediaryAgent != nil {
+		fi := wire.NewBeneficiaryIntermediaryFI()
+		fi.FinancialInstitution = c.financialInstitution(wire.TagBeneficiaryIntermediaryFI, "BeneficiaryIntermediaryFI.FinancialInstitution", chain.IntermediaryAgent)
+		fwm.BeneficiaryIntermediaryFI = fi
+	}
+	if chain.DebtorAgent != nil {
+		fi := wire.NewOriginatorFI()
+		fi.FinancialInstitution = c.financialInstitution(wire.TagOriginatorFI, "OriginatorFI.FinancialInstitution", chain.DebtorAgent)
+		fwm.OriginatorFI = fi
+	}
+	if chain.CreditorAgent != nil {
+		fi := wire.NewBeneficiaryFI()
+		fi.FinancialInstitution = c.financialInstitution(wire.TagBeneficiaryFI, "BeneficiaryFI.FinancialInstitution", chain.CreditorAgent)
+		fwm.BeneficiaryFI = fi
+	}
+	if returnParty(chain.Debtor, chain.DebtorAccount, tx.InstructingAgent) {
+		fwm.Originator = wire.NewOriginator()
+		fwm.Originator.Personal = c.personalOf(wire.TagOriginator, "Originator.Personal", chain.Debtor, chain.DebtorAccount)
+	}
+	if returnParty(chain.Creditor, chain.CreditorAccount, tx.InstructedAgent) {
+		fwm.Beneficiary = wire.NewBeneficiary()
+		fwm.Beneficiary.Personal = c.personalOf(wire.TagBeneficiary, "Beneficiary.Personal", chain.Creditor, chain.CreditorAccount)
+	}
+
+	if lines := c.reasonLines(path+"/RtrRsnInf", tx.ReturnReasonInformation); len(lines) > 0 {
+		lines = c.lines(wire.TagOriginatorToBeneficiary, "OriginatorToBeneficiary", lines, 4, 35)
+		ob := wire.NewOriginatorToBeneficiary()
+		ob.LineOne, ob.LineTwo, ob.LineThree, ob.LineFour = lines[0], lines[1], lines[2], lines[3]
+		fwm.OriginatorToBeneficiary = ob
+	}
+	if ref := tx.OriginalTransactionReference; ref != nil {
+		if ref.PaymentTypeInformation != nil || ref.Debtor != nil || ref.DebtorAccount != nil || ref.DebtorAgent != nil ||
+			ref.CreditorAgent != nil || ref.Creditor != nil || ref.CreditorAccount != nil {
+			c.warnElement(path+"/OrgnlTxRef", "only the remittance information of the original transaction has a Fedwire equivalent")
+		}
+		if rmtInf := ref.RemittanceInformation; rmtInf != nil {
+			if len(rmtInf.Unstructured) > 0 {
+				c.warnElement(path+"/OrgnlTxRef/RmtInf/Ustrd", "no Fedwire equivalent")
+			}
+			if len(rmtInf.Structured) > 0 {
+				if bankTransfer {
+					c.warnElement(path+"/OrgnlTxRef/RmtInf/Strd", "bank transfers have no structured remittance")
+				} else {
+					c.setRemittanceInformation(fwm, path+"/OrgnlTxRef/RmtInf", &RemittanceInformation{Structured: rmtInf.Structured})
+					if fwm.LocalInstrument == nil {
+						fwm.BusinessFunctionCode.BusinessFunctionCode = wire.CustomerTransferPlus
+						fwm.LocalInstrument = wire.NewLocalInstrument()
+						fwm.LocalInstrument.LocalInstrumentCode = wire.RemittanceInformationStructured
+					}
+				}
+			}
+		}
+		c.unmappedElements(path+"/OrgnlTxRef", ref.Unmapped)
+	}
+	c.unmappedElements(path, tx.Unmapped)
+
+	return fwm, c.warnings, nil
+}
+
+// returnParty reports whether the debtor or creditor of a return chain becomes a {5000} Originator or {4200}
+// Beneficiary. An institution without an account which is the instructing or instructed agent itself doesn't.
+func returnParty(pa *PartyOrAgent, acct *CashAccount, agent *BranchAndFinancialInstitutionIdentification) bool {
+	if pa == nil && acct == nil {
+		return false
+	}
+	return acct != nil || pa == nil || pa.Agent == nil || !sameAgent(pa.Agent, agent)
+}
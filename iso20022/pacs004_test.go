@@ -0,0 +1,182 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package iso20022
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/moov-io/wire"
+	"github.com/stretchr/testify/require"
+)
+
+// pacs004RoundTrip converts fwm into a pacs.004 document, writes and reads the document and converts it back
+func pacs004RoundTrip(t *testing.T, fwm *wire.FEDWireMessage) (*Pacs004, []Warning) {
+	t.Helper()
+
+	doc, warnings, err := NewPacs004(fwm)
+	require.NoError(t, err)
+
+	bs, err := xml.MarshalIndent(doc, "", "  ")
+	require.NoError(t, err)
+
+	var read Pacs004
+	require.NoError(t, xml.Unmarshal(bs, &read))
+
+	got, back, err := read.FEDWireMessage()
+	require.NoError(t, err)
+	requireRoundTrip(t, fwm, got, warnings, back)
+
+	return doc, warnings
+}
+
+func TestPacs004_bankTransfer(t *testing.T) {
+	fwm := readMessage(t, "fedWireMessage-BankTransfer.txt")
+	fwm.TypeSubType.SubTypeCode = wire.ReversalTransfer
+	fwm.PreviousMessageIdentifier.PreviousMessageIdentifier = "20190410Source08000000"
+
+	doc, warnings := pacs004RoundTrip(t, fwm)
+	for _, w := range warnings {
+		require.NotEqual(t, wire.TagTypeSubType, w.Tag, w.String())
+		require.NotEqual(t, wire.TagPreviousMessageIdentifier, w.Tag, w.String())
+	}
+
+	tx := doc.PaymentReturn.Transactions[0]
+	require.Equal(t, "Sender Reference", tx.ReturnID)
+	require.Equal(t, "20190410Source08000000", tx.OriginalInstructionID)
+	require.Equal(t, uetr("20190410Source08000000"), tx.OriginalUETR)
+	require.Equal(t, "2019-04-10", tx.OriginalInterbankSettlementDate)
+	require.Equal(t, "2019-04-10", tx.InterbankSettlementDate)
+	require.Equal(t, LocalInstrumentBankTransfer, tx.PaymentTypeInformation.LocalInstrument.Proprietary)
+	require.Equal(t, "12345.67", tx.ReturnedInterbankSettlementAmount.Value)
+	require.Equal(t, "1234", tx.ReturnChain.Debtor.Agent.FinancialInstitutionIdentification.Other.Identification)
+	require.Nil(t, tx.ReturnChain.Debtor.Party)
+	require.NotNil(t, tx.ReturnChain.Creditor.Agent)
+	require.Equal(t, []string{"LineOne", "LineTwo", "LineThree", "LineFour"}, tx.ReturnReasonInformation[0].AdditionalInformation)
+
+	bs, err := xml.Marshal(doc)
+	require.NoError(t, err)
+	require.Contains(t, string(bs), `<Document xmlns="`+Pacs004Namespace+`"><PmtRtr><GrpHdr>`)
+}
+
+func TestPacs004_customerTransfer(t *testing.T) {
+	fwm := readMessage(t, "fedWireMessage-CustomerTransfer.txt")
+	fwm.TypeSubType.SubTypeCode = wire.ReversalPriorDayTransfer
+	fwm.PreviousMessageIdentifier.PreviousMessageIdentifier = "20190409Source08000007"
+
+	doc, warnings := pacs004RoundTrip(t, fwm)
+	for _, w := range warnings {
+		require.NotEqual(t, wire.TagTypeSubType, w.Tag, w.String())
+	}
+
+	tx := doc.PaymentReturn.Transactions[0]
+	require.Nil(t, tx.PaymentTypeInformation)
+	require.Equal(t, "2019-04-09", tx.OriginalInterbankSettlementDate)
+	require.Equal(t, ChargeBearerCreditor, tx.ChargeBearer)
+	require.Len(t, tx.ChargesInformation, 4)
+	require.Equal(t, "4567.89", tx.ReturnedInstructedAmount.Value)
+	require.Equal(t, "Name", tx.ReturnChain.Debtor.Party.Name)
+	require.Equal(t, "Name", tx.ReturnChain.Creditor.Party.Name)
+	require.Equal(t, "FI Name", tx.ReturnChain.DebtorAgent.FinancialInstitutionIdentification.Name)
+
+	// the same sub type with a same day original reads back as a same day reversal
+	fwm.PreviousMessageIdentifier.PreviousMessageIdentifier = "20190410Source08000000"
+	_, warnings, err := NewPacs004(fwm)
+	require.NoError(t, err)
+	require.Contains(t, warnings, Warning{Tag: wire.TagTypeSubType, Field: "TypeSubType.SubTypeCode", Reason: "sub type 08 doesn't follow from the settlement dates and reads back as 02"})
+}
+
+func TestPacs004_structuredRemittance(t *testing.T) {
+	fwm := readMessage(t, "fedWireMessage-CustomerTransferPlusStructuredRemittance.txt")
+	fwm.TypeSubType.SubTypeCode = wire.ReversalTransfer
+	fwm.PreviousMessageIdentifier = wire.NewPreviousMessageIdentifier()
+	fwm.PreviousMessageIdentifier.PreviousMessageIdentifier = "Original"
+
+	doc, _ := pacs004RoundTrip(t, fwm)
+
+	tx := doc.PaymentReturn.Transactions[0]
+	require.Equal(t, wire.RemittanceInformationStructured, tx.PaymentTypeInformation.LocalInstrument.Proprietary)
+	require.Len(t, tx.OriginalTransactionReference.RemittanceInformation.Structured, 1)
+}
+
+func TestPacs004_unsupported(t *testing.T) {
+	_, _, err := NewPacs004(readMessage(t, "fedWireMessage-BankTransfer.txt"))
+	require.ErrorIs(t, err, ErrUnsupportedMessage)
+
+	fwm := readMessage(t, "fedWireMessage-ServiceMessage.txt")
+	fwm.TypeSubType.SubTypeCode = wire.ReversalTransfer
+	_, _, err = NewPacs004(fwm)
+	require.ErrorIs(t, err, ErrUnsupportedMessage)
+
+	fwm = readMessage(t, "fedWireMessage-CustomerTransferPlusCOVS.txt")
+	fwm.TypeSubType.SubTypeCode = wire.ReversalTransfer
+	_, _, err = NewPacs004(fwm)
+	require.ErrorIs(t, err, ErrUnsupportedMessage)
+
+	fwm = readMessage(t, "fedWireMessage-BankTransfer.txt")
+	fwm.TypeSubType.SubTypeCode = wire.ReversalTransfer
+	fwm.PreviousMessageIdentifier = nil
+	_, _, err = NewPacs004(fwm)
+	require.ErrorIs(t, err, ErrMissingTag)
+}
+
+func TestPacs004_read(t *testing.T) {
+	input := `<?xml version="1.0" encoding="UTF-8"?>
+<Document xmlns="urn:iso:std:iso:20022:tech:xsd:pacs.004.001.09">
+  <PmtRtr>
+    <GrpHdr>
+      <MsgId>20240103MMQFMP9T000042</MsgId>
+      <CreDtTm>2024-01-03T10:00:00-05:00</CreDtTm>
+      <NbOfTxs>1</NbOfTxs>
+      <SttlmInf><SttlmMtd>CLRG</SttlmMtd><ClrSys><Cd>FDW</Cd></ClrSys></SttlmInf>
+    </GrpHdr>
+    <TxInf>
+      <RtrId>RTN-1</RtrId>
+      <OrgnlInstrId>20240102MMQFMP9T000124</OrgnlInstrId>
+      <OrgnlEndToEndId>E2E-1</OrgnlEndToEndId>
+      <OrgnlIntrBkSttlmDt>2024-01-02</OrgnlIntrBkSttlmDt>
+      <RtrdIntrBkSttlmAmt Ccy="USD">250.00</RtrdIntrBkSttlmAmt>
+      <IntrBkSttlmDt>2024-01-03</IntrBkSttlmDt>
+      <InstgAgt><FinInstnId><ClrSysMmbId><ClrSysId><Cd>USABA</Cd></ClrSysId><MmbId>231380104</MmbId></ClrSysMmbId></FinInstnId></InstgAgt>
+      <InstdAgt><FinInstnId><ClrSysMmbId><ClrSysId><Cd>USABA</Cd></ClrSysId><MmbId>121042882</MmbId></ClrSysMmbId></FinInstnId></InstdAgt>
+      <RtrChain>
+        <Dbtr><Pty><Nm>Jane Doe</Nm></Pty></Dbtr>
+        <DbtrAcct><Id><Othr><Id>987654321</Id></Othr></Id></DbtrAcct>
+        <Cdtr><Pty><Nm>John Doe</Nm></Pty></Cdtr>
+        <CdtrAcct><Id><Othr><Id>123456789</Id></Othr></Id></CdtrAcct>
+      </RtrChain>
+      <RtrRsnInf>
+        <Rsn><Cd>AC04</Cd></Rsn>
+        <AddtlInf>Account closed</AddtlInf>
+      </RtrRsnInf>
+    </TxInf>
+  </PmtRtr>
+</Document>`
+
+	var doc Pacs004
+	require.NoError(t, xml.Unmarshal([]byte(input), &doc))
+
+	fwm, warnings, err := doc.FEDWireMessage()
+	require.NoError(t, err)
+
+	require.Equal(t, wire.CustomerTransfer, fwm.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, wire.ReversalPriorDayTransfer, fwm.TypeSubType.SubTypeCode)
+	require.Equal(t, "000000025000", fwm.Amount.Amount)
+	require.Equal(t, "20240102MMQFMP9T000124", fwm.PreviousMessageIdentifier.PreviousMessageIdentifier)
+	require.Equal(t, "RTN-1", fwm.SenderReference.SenderReference)
+	require.Equal(t, "E2E-1", fwm.BeneficiaryReference.BeneficiaryReference)
+	require.Equal(t, "Jane Doe", fwm.Originator.Personal.Name)
+	require.Equal(t, "987654321", fwm.Originator.Personal.Identifier)
+	require.Equal(t, "John Doe", fwm.Beneficiary.Personal.Name)
+	require.Equal(t, "Account closed", fwm.OriginatorToBeneficiary.LineOne)
+
+	require.Equal(t, []Warning{
+		{Element: "PmtRtr/TxInf/RtrRsnInf/Rsn", Reason: "reason AC04 has no Fedwire equivalent"},
+	}, warnings)
+
+	file := wire.NewFile()
+	file.AddFEDWireMessage(*fwm)
+	require.NoError(t, file.Validate())
+}
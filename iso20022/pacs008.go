@@ -0,0 +1,476 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package iso20022
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+
+	"github.com/moov-io/wire"
+)
+
+const (
+	// Pacs008Namespace is the XML namespace of pacs.008.001.08 documents
+	Pacs008Namespace = "urn:iso:std:iso:20022:tech:xsd:pacs.008.001.08"
+
+	// ChargeBearerDebtor means all charges are borne by the debtor
+	ChargeBearerDebtor = "DEBT"
+	// ChargeBearerCreditor means all charges are borne by the creditor
+	ChargeBearerCreditor = "CRED"
+	// ChargeBearerShared means charges are shared between debtor and creditor
+	ChargeBearerShared = "SHAR"
+	// ChargeBearerServiceLevel means charges follow the rules of the service level
+	ChargeBearerServiceLevel = "SLEV"
+)
+
+// Pacs008 is a pacs.008.001.08 FIToFICustomerCreditTransfer document, the ISO 20022 equivalent of the
+// CustomerTransfer (CTR) and CustomerTransferPlus (CTP) business function codes.
+type Pacs008 struct {
+	XMLName                xml.Name                     `xml:"urn:iso:std:iso:20022:tech:xsd:pacs.008.001.08 Document"`
+	CustomerCreditTransfer FIToFICustomerCreditTransfer `xml:"FIToFICstmrCdtTrf"`
+}
+
+// FIToFICustomerCreditTransfer is the FIToFICstmrCdtTrf message of a pacs.008 document
+type FIToFICustomerCreditTransfer struct {
+	GroupHeader                GroupHeader                 `xml:"GrpHdr"`
+	CreditTransferTransactions []CreditTransferTransaction `xml:"CdtTrfTxInf"`
+}
+
+// CreditTransferTransaction is the CdtTrfTxInf of a pacs.008 document
+type CreditTransferTransaction struct {
+	PaymentIdentification        PaymentIdentification                        `xml:"PmtId"`
+	PaymentTypeInformation       *PaymentTypeInformation                      `xml:"PmtTpInf,omitempty"`
+	InterbankSettlementAmount    ActiveCurrencyAndAmount                      `xml:"IntrBkSttlmAmt"`
+	InterbankSettlementDate      string                                       `xml:"IntrBkSttlmDt,omitempty"`
+	InstructedAmount             *ActiveCurrencyAndAmount                     `xml:"InstdAmt,omitempty"`
+	ExchangeRate                 string                                       `xml:"XchgRate,omitempty"`
+	ChargeBearer                 string                                       `xml:"ChrgBr"`
+	ChargesInformation           []ChargesInformation                         `xml:"ChrgsInf,omitempty"`
+	PreviousInstructingAgent     *BranchAndFinancialInstitutionIdentification `xml:"PrvsInstgAgt1,omitempty"`
+	InstructingAgent             *BranchAndFinancialInstitutionIdentification `xml:"InstgAgt,omitempty"`
+	InstructedAgent              *BranchAndFinancialInstitutionIdentification `xml:"InstdAgt,omitempty"`
+	IntermediaryAgent            *BranchAndFinancialInstitutionIdentification `xml:"IntrmyAgt1,omitempty"`
+	Debtor                       PartyIdentification                          `xml:"Dbtr"`
+	DebtorAccount                *CashAccount                                 `xml:"DbtrAcct,omitempty"`
+	DebtorAgent                  BranchAndFinancialInstitutionIdentification  `xml:"DbtrAgt"`
+	CreditorAgent                BranchAndFinancialInstitutionIdentification  `xml:"CdtrAgt"`
+	Creditor                     PartyIdentification                          `xml:"Cdtr"`
+	CreditorAccount              *CashAccount                                 `xml:"CdtrAcct,omitempty"`
+	RelatedRemittanceInformation []RemittanceLocation                         `xml:"RltdRmtInf,omitempty"`
+	RemittanceInformation        *RemittanceInformation                       `xml:"RmtInf,omitempty"`
+
+	// Unmapped holds the elements read from a document which have no Fedwire equivalent
+	Unmapped []UnmappedElement `xml:",any"`
+}
+
+// NewPacs008 converts a customer transfer (CTR or CTP) into a pacs.008 document. Cover payments (CTP with a
+// COVS local instrument) are pacs.009 documents, see NewPacs009.
+//
+// The IMAD becomes the message and instruction id and derives the UETR, the originator becomes the debtor
+// and the beneficiary the creditor. {5100} OriginatorFI, {4100} BeneficiaryFI, {4000} BeneficiaryIntermediaryFI
+// and {5200} InstructingFI become the debtor, creditor, intermediary and previous instructing agents. {6000} and
+// the {8xxx} remittance tags become the remittance information.
+func NewPacs008(fwm *wire.FEDWireMessage) (*Pacs008, []Warning, error) {
+	if err := requireTags(fwm); err != nil {
+		return nil, nil, err
+	}
+	switch bfc := fwm.BusinessFunctionCode.BusinessFunctionCode; bfc {
+	case wire.CustomerTransfer, wire.CustomerTransferPlus:
+	default:
+		return nil, nil, fmt.Errorf("%w: pacs.008 carries customer transfers, not %s", ErrUnsupportedMessage, bfc)
+	}
+	if isCover(fwm) {
+		return nil, nil, fmt.Errorf("%w: cover payments are pacs.009 documents", ErrUnsupportedMessage)
+	}
+	if fwm.Originator == nil && fwm.OriginatorOptionF == nil {
+		return nil, nil, missingTag(wire.TagOriginator, "Originator")
+	}
+	if fwm.Beneficiary == nil {
+		return nil, nil, missingTag(wire.TagBeneficiary, "Beneficiary")
+	}
+
+	c := &converter{message: "pacs.008"}
+	c.envelope(fwm)
+	c.typeSubType(fwm, wire.FundsTransfer)
+
+	amount, err := c.settlementAmount(fwm)
+	if err != nil {
+		return nil, nil, err
+	}
+	sender := fedAgent(fwm.SenderDepositoryInstitution.SenderABANumber, fwm.SenderDepositoryInstitution.SenderShortName)
+	receiver := fedAgent(fwm.ReceiverDepositoryInstitution.ReceiverABANumber, fwm.ReceiverDepositoryInstitution.ReceiverShortName)
+
+	tx := CreditTransferTransaction{
+		PaymentIdentification:     c.paymentIdentification(fwm),
+		PaymentTypeInformation:    localInstrument(fwm),
+		InterbankSettlementAmount: amount,
+		InterbankSettlementDate:   settlementDate(fwm),
+		ChargeBearer:              ChargeBearerDebtor,
+		InstructingAgent:          sender,
+		InstructedAgent:           receiver,
+		DebtorAgent:               *sender,
+		CreditorAgent:             *receiver,
+	}
+	c.unmapped(fwm.PreviousMessageIdentifier != nil, wire.TagPreviousMessageIdentifier, "PreviousMessageIdentifier")
+	c.unmapped(fwm.PaymentNotification != nil, wire.TagPaymentNotification, "PaymentNotification")
+
+	if fwm.Charges != nil {
+		tx.ChargeBearer, tx.ChargesInformation = c.charges(fwm.Charges, sender)
+	}
+	tx.InstructedAmount, tx.ExchangeRate = instructedAmount(fwm)
+
+	if fi := fwm.InstructingFI; fi != nil {
+		tx.PreviousInstructingAgent = c.agent(fi.FinancialInstitution)
+	}
+	if fi := fwm.BeneficiaryIntermediaryFI; fi != nil {
+		tx.IntermediaryAgent = c.agent(fi.FinancialInstitution)
+	}
+	if fi := fwm.OriginatorFI; fi != nil {
+		tx.DebtorAgent = *c.agent(fi.FinancialInstitution)
+	}
+	if fi := fwm.BeneficiaryFI; fi != nil {
+		tx.CreditorAgent = *c.agent(fi.FinancialInstitution)
+	}
+
+	if fwm.Originator != nil {
+		tx.Debtor, tx.DebtorAccount = c.party(fwm.Originator.Personal)
+		c.unmapped(fwm.OriginatorOptionF != nil, wire.TagOriginatorOptionF, "OriginatorOptionF")
+	} else {
+		tx.Debtor, tx.DebtorAccount = c.optionF(fwm.OriginatorOptionF)
+	}
+	tx.Creditor, tx.CreditorAccount = c.party(fwm.Beneficiary.Personal)
+
+	tx.RelatedRemittanceInformation = c.relatedRemittance(fwm)
+	tx.RemittanceInformation = c.remittanceInformation(fwm)
+	c.unmapped(fwm.UnstructuredAddenda != nil, wire.TagUnstructuredAddenda, "UnstructuredAddenda")
+
+	c.fiToFiInformation(fwm)
+	c.coverPayment(fwm)
+	c.unmapped(fwm.AccountDebitedDrawdown != nil, wire.TagAccountDebitedDrawdown, "AccountDebitedDrawdown")
+	c.unmapped(fwm.AccountCreditedDrawdown != nil, wire.TagAccountCreditedDrawdown, "AccountCreditedDrawdown")
+	c.unmapped(fwm.ServiceMessage != nil, wire.TagServiceMessage, "ServiceMessage")
+
+	doc := &Pacs008{
+		CustomerCreditTransfer: FIToFICustomerCreditTransfer{
+			GroupHeader:                c.groupHeader(fwm),
+			CreditTransferTransactions: []CreditTransferTransaction{tx},
+		},
+	}
+	return doc, c.warnings, nil
+}
+
+// localInstrument returns the PmtTpInf of a CTP's {3610}. Proprietary local instruments carry their own code.
+func localInstrument(fwm *wire.FEDWireMessage) *PaymentTypeInformation {
+	li := fwm.LocalInstrument
+	if li == nil || li.LocalInstrumentCode == "" {
+		return nil
+	}
+	code := li.LocalInstrumentCode
+	if code == wire.ProprietaryLocalInstrumentCode && strings.TrimSpace(li.ProprietaryCode) != "" {
+		code = strings.TrimSpace(li.ProprietaryCode)
+	}
+	return &PaymentTypeInformation{LocalInstrument: &CodeOrProprietary{Proprietary: code}}
+}
+
+// setLocalInstrument sets the {3610} of a local instrument code on fwm. Codes Fedwire doesn't define are
+// written as proprietary local instruments.
+func (c *converter) setLocalInstrument(fwm *wire.FEDWireMessage, code string) {
+	li := wire.NewLocalInstrument()
+	li.LocalInstrumentCode = code
+	if !localInstrumentCodes[code] {
+		li.LocalInstrumentCode = wire.ProprietaryLocalInstrumentCode
+		li.ProprietaryCode = c.text(wire.TagLocalInstrument, "LocalInstrument.ProprietaryCode", code, 35)
+	}
+	fwm.LocalInstrument = li
+}
+
+// charges returns the charge bearer of a {3700} and the charges the sending agent took
+func (c *converter) charges(charges *wire.Charges, sender *BranchAndFinancialInstitutionIdentification) (string, []ChargesInformation) {
+	bearer := ChargeBearerDebtor
+	switch charges.ChargeDetails {
+	case wire.CDBeneficiary:
+		bearer = ChargeBearerCreditor
+	case wire.CDShared:
+		bearer = ChargeBearerShared
+	}
+	var info []ChargesInformation
+	for _, amt := range []string{charges.SendersChargesOne, charges.SendersChargesTwo, charges.SendersChargesThree, charges.SendersChargesFour} {
+		if amt = strings.TrimSpace(amt); amt != "" {
+			info = append(info, ChargesInformation{
+				Amount: splitCurrencyAmount(amt),
+				Agent:  *sender,
+			})
+		}
+	}
+	return bearer, info
+}
+
+// instructedAmount returns the amount and exchange rate of a {3710} and {3720}
+func instructedAmount(fwm *wire.FEDWireMessage) (*ActiveCurrencyAndAmount, string) {
+	var amt *ActiveCurrencyAndAmount
+	if ia := fwm.InstructedAmount; ia != nil {
+		amt = &ActiveCurrencyAndAmount{Currency: ia.CurrencyCode, Value: decimalFromComma(strings.TrimSpace(ia.Amount))}
+	}
+	rate := ""
+	if er := fwm.ExchangeRate; er != nil {
+		rate = decimalFromComma(strings.TrimSpace(er.ExchangeRate))
+	}
+	return amt, rate
+}
+
+// setInstructedAmount sets the {3710} and {3720} of an instructed amount and exchange rate on fwm
+func (c *converter) setInstructedAmount(fwm *wire.FEDWireMessage, amt *ActiveCurrencyAndAmount, rate string) {
+	if amt != nil {
+		ia := wire.NewInstructedAmount()
+		ia.CurrencyCode = amt.Currency
+		ia.Amount = c.text(wire.TagInstructedAmount, "InstructedAmount.Amount", commaFromDecimal(strings.TrimSpace(amt.Value)), 15)
+		fwm.InstructedAmount = ia
+	}
+	if rate != "" {
+		er := wire.NewExchangeRate()
+		er.ExchangeRate = c.text(wire.TagExchangeRate, "ExchangeRate.ExchangeRate", commaFromDecimal(strings.TrimSpace(rate)), 12)
+		fwm.ExchangeRate = er
+	}
+}
+
+// localInstrumentCodes are the codes of {3610} LocalInstrument
+var localInstrumentCodes = map[string]bool{
+	wire.ANSIX12format:                   true,
+	wire.SequenceBCoverPaymentStructured: true,
+	wire.GeneralXMLformat:                true,
+	wire.ISO20022XMLformat:               true,
+	wire.NarrativeText:                   true,
+	wire.ProprietaryLocalInstrumentCode:  true,
+	wire.RemittanceInformationStructured: true,
+	wire.RelatedRemittanceInformation:    true,
+	wire.STP820format:                    true,
+	wire.SWIFTfield70:                    true,
+	wire.UNEDIFACTformat:                 true,
+}
+
+// optionF returns the debtor and account of a {5010} OriginatorOptionF. The party identifier is either an
+// account (/123456) or a coded identification (TXID/123-45-6789), and each line starts with a line code.
+func (c *converter) optionF(oof *wire.OriginatorOptionF) (PartyIdentification, *CashAccount) {
+	tag := wire.TagOriginatorOptionF
+	party := PartyIdentification{Name: strings.TrimPrefix(strings.TrimSpace(oof.Name), wire.OptionFName+"/")}
+	var acct *CashAccount
+
+	id := strings.TrimSpace(oof.PartyIdentifier)
+	if strings.HasPrefix(id, "/") {
+		acct = account(id[1:])
+	} else if code, value, ok := strings.Cut(id, "/"); ok {
+		party.Identification = &Party{PrivateIdentification: &PersonIdentification{
+			Other: []GenericIdentification{{Identification: value, SchemeName: &CodeOrProprietary{Code: code}}},
+		}}
+	}
+
+	for i, line := range []string{oof.LineOne, oof.LineTwo, oof.LineThree} {
+		field := fmt.Sprintf("OriginatorOptionF.Line%s", []string{"One", "Two", "Three"}[i])
+		code, value, _ := strings.Cut(strings.TrimSpace(line), "/")
+		switch code {
+		case "":
+		case wire.OptionFName:
+			party.Name = strings.TrimSpace(party.Name + " " + value)
+		case wire.OptionFAddress:
+			if party.PostalAddress == nil {
+				party.PostalAddress = &PostalAddress{}
+			}
+			party.PostalAddress.AddressLines = append(party.PostalAddress.AddressLines, value)
+		case wire.OptionFCountryTown:
+			if party.PostalAddress == nil {
+				party.PostalAddress = &PostalAddress{}
+			}
+			country, town, _ := strings.Cut(value, "/")
+			party.PostalAddress.Country, party.PostalAddress.TownName = country, town
+		default:
+			c.warn(tag, field, "line code %s has no %s equivalent", code, c.message)
+		}
+	}
+	return party, acct
+}
+
+// FEDWireMessage converts the document's transaction into a customer transfer.
+//
+// The message is a CustomerTransferPlus when the transaction has a local instrument or structured remittance
+// and a CustomerTransfer otherwise. The agents which are the same as the instructing and instructed agents
+// are the sender and receiver and don't become {5100} OriginatorFI and {4100} BeneficiaryFI.
+func (doc *Pacs008) FEDWireMessage() (*wire.FEDWireMessage, []Warning, error) {
+	txs := doc.CustomerCreditTransfer.CreditTransferTransactions
+	if len(txs) != 1 {
+		return nil, nil, fmt.Errorf("%w: Fedwire messages carry one transaction, found %d", ErrUnsupportedMessage, len(txs))
+	}
+	tx := txs[0]
+	path := "FIToFICstmrCdtTrf/CdtTrfTxInf"
+
+	c := &converter{message: "pacs.008"}
+	fwm, err := c.transfer(doc.CustomerCreditTransfer.GroupHeader, tx.PaymentIdentification, tx.InterbankSettlementAmount,
+		tx.InstructingAgent, tx.InstructedAgent, path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fwm.BusinessFunctionCode.BusinessFunctionCode = wire.CustomerTransfer
+	if tx.PaymentTypeInformation != nil && tx.PaymentTypeInformation.LocalInstrument != nil {
+		code := schemeValue(tx.PaymentTypeInformation.LocalInstrument)
+		if code == wire.SequenceBCoverPaymentStructured {
+			return nil, nil, fmt.Errorf("%w: cover payments are pacs.009 documents", ErrUnsupportedMessage)
+		}
+		c.setLocalInstrument(fwm, code)
+	}
+	if fwm.LocalInstrument == nil && tx.RemittanceInformation != nil && len(tx.RemittanceInformation.Structured) > 0 {
+		fwm.LocalInstrument = wire.NewLocalInstrument()
+		fwm.LocalInstrument.LocalInstrumentCode = wire.RemittanceInformationStructured
+	}
+	if fwm.LocalInstrument == nil && len(tx.RelatedRemittanceInformation) > 0 {
+		fwm.LocalInstrument = wire.NewLocalInstrument()
+		fwm.LocalInstrument.LocalInstrumentCode = wire.RelatedRemittanceInformation
+	}
+	if fwm.LocalInstrument != nil {
+		fwm.BusinessFunctionCode.BusinessFunctionCode = wire.CustomerTransferPlus
+	}
+	if tx.PaymentTypeInformation != nil && tx.PaymentTypeInformation.CategoryPurpose != nil {
+		c.warnElement(path+"/PmtTpInf/CtgyPurp", "no Fedwire equivalent")
+	}
+
+	c.setInstructedAmount(fwm, tx.InstructedAmount, tx.ExchangeRate)
+	c.setCharges(fwm, tx.ChargeBearer, tx.ChargesInformation)
+
+	if tx.PreviousInstructingAgent != nil {
+		fi := wire.NewInstructingFI()
+		fi.FinancialInstitution = c.financialInstitution(wire.TagInstructingFI, "InstructingFI.FinancialInstitution", tx.PreviousInstructingAgent)
+		fwm.InstructingFI = fi
+	}
+	if tx.IntermediaryAgent != nil {
+		fi := wire.NewBeneficiaryIntermediaryFI()
+		fi.FinancialInstitution = c.financialInstitution(wire.TagBeneficiaryIntermediaryFI, "BeneficiaryIntermediaryFI.FinancialInstitution", tx.IntermediaryAgent)
+		fwm.BeneficiaryIntermediaryFI = fi
+	}
+	if !sameAgent(&tx.DebtorAgent, tx.InstructingAgent) {
+		fi := wire.NewOriginatorFI()
+		fi.FinancialInstitution = c.financialInstitution(wire.TagOriginatorFI, "OriginatorFI.FinancialInstitution", &tx.DebtorAgent)
+		fwm.OriginatorFI = fi
+	}
+	if !sameAgent(&tx.CreditorAgent, tx.InstructedAgent) {
+		fi := wire.NewBeneficiaryFI()
+		fi.FinancialInstitution = c.financialInstitution(wire.TagBeneficiaryFI, "BeneficiaryFI.FinancialInstitution", &tx.CreditorAgent)
+		fwm.BeneficiaryFI = fi
+	}
+
+	fwm.Originator = wire.NewOriginator()
+	fwm.Originator.Personal = c.personal(wire.TagOriginator, "Originator.Personal", tx.Debtor, tx.DebtorAccount)
+	fwm.Beneficiary = wire.NewBeneficiary()
+	fwm.Beneficiary.Personal = c.personal(wire.TagBeneficiary, "Beneficiary.Personal", tx.Creditor, tx.CreditorAccount)
+
+	c.setRelatedRemittance(fwm, tx.RelatedRemittanceInformation)
+	c.setRemittanceInformation(fwm, path+"/RmtInf", tx.RemittanceInformation)
+	c.unmappedElements(path, tx.Unmapped)
+
+	return fwm, c.warnings, nil
+}
+
+// transfer returns a FEDWireMessage with the tags every ISO 20022 credit transfer has in common
+func (c *converter) transfer(hdr GroupHeader, pmtID PaymentIdentification, amt ActiveCurrencyAndAmount,
+	instg, instd *BranchAndFinancialInstitutionIdentification, path string) (*wire.FEDWireMessage, error) {
+	fwm := c.newMessage(hdr.MessageID, pmtID.InstructionID, pmtID.EndToEndID)
+	if pmtID.UETR != "" && pmtID.UETR != uetr(hdr.MessageID) {
+		c.warnElement(path+"/PmtId/UETR", "no Fedwire equivalent")
+	}
+	if err := c.setAmount(fwm, path+"/IntrBkSttlmAmt", amt); err != nil {
+		return nil, err
+	}
+	if err := c.setDepositoryInstitutions(fwm, path+"/InstgAgt", instg, path+"/InstdAgt", instd); err != nil {
+		return nil, err
+	}
+	return fwm, nil
+}
+
+// newMessage returns a basic funds transfer identified by an IMAD, with a {3320} SenderReference and {4320}
+// BeneficiaryReference unless the references are the IMAD itself or not provided.
+func (c *converter) newMessage(imad, senderReference, beneficiaryReference string) *wire.FEDWireMessage {
+	fwm := &wire.FEDWireMessage{
+		SenderSupplied:       wire.NewSenderSupplied(),
+		TypeSubType:          wire.NewTypeSubType(),
+		BusinessFunctionCode: wire.NewBusinessFunctionCode(),
+	}
+	fwm.TypeSubType.TypeCode = wire.FundsTransfer
+	fwm.TypeSubType.SubTypeCode = wire.BasicFundsTransfer
+
+	if parsed := parseIMAD(imad); parsed != nil {
+		fwm.InputMessageAccountabilityData = parsed
+	} else {
+		c.warn(wire.TagInputMessageAccountabilityData, "InputMessageAccountabilityData", "message id %q is not an IMAD", imad)
+	}
+	if ref := strings.TrimSpace(senderReference); ref != "" && ref != imad {
+		fwm.SenderReference = wire.NewSenderReference()
+		fwm.SenderReference.SenderReference = c.text(wire.TagSenderReference, "SenderReference.SenderReference", ref, 16)
+	}
+	if ref := strings.TrimSpace(beneficiaryReference); ref != "" && ref != NotProvided {
+		fwm.BeneficiaryReference = wire.NewBeneficiaryReference()
+		fwm.BeneficiaryReference.BeneficiaryReference = c.text(wire.TagBeneficiaryReference, "BeneficiaryReference.BeneficiaryReference", ref, 16)
+	}
+	return fwm
+}
+
+// setAmount sets the {2000} of a US dollar amount on fwm
+func (c *converter) setAmount(fwm *wire.FEDWireMessage, path string, amt ActiveCurrencyAndAmount) error {
+	if amt.Currency != currencyUSD {
+		return fmt.Errorf("%s: %w: currency %s is not USD", path, ErrInvalidAmount, amt.Currency)
+	}
+	amount, err := impliedFromAmount(amt.Value)
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	fwm.Amount = wire.NewAmount()
+	fwm.Amount.Amount = amount
+	return nil
+}
+
+// setDepositoryInstitutions sets the {3100} and {3400} of the instructing and instructed agents on fwm
+func (c *converter) setDepositoryInstitutions(fwm *wire.FEDWireMessage, instgPath string, instg *BranchAndFinancialInstitutionIdentification,
+	instdPath string, instd *BranchAndFinancialInstitutionIdentification) error {
+	aba, name, err := c.routingNumber(instgPath, wire.TagSenderDepositoryInstitution, "SenderDepositoryInstitution.Sender", instg)
+	if err != nil {
+		return err
+	}
+	fwm.SenderDepositoryInstitution = wire.NewSenderDepositoryInstitution()
+	fwm.SenderDepositoryInstitution.SenderABANumber = aba
+	fwm.SenderDepositoryInstitution.SenderShortName = name
+
+	aba, name, err = c.routingNumber(instdPath, wire.TagReceiverDepositoryInstitution, "ReceiverDepositoryInstitution.Receiver", instd)
+	if err != nil {
+		return err
+	}
+	fwm.ReceiverDepositoryInstitution = wire.NewReceiverDepositoryInstitution()
+	fwm.ReceiverDepositoryInstitution.ReceiverABANumber = aba
+	fwm.ReceiverDepositoryInstitution.ReceiverShortName = name
+	return nil
+}
+
+// setCharges sets the {3700} of a charge bearer and the charges taken by agents on fwm
+func (c *converter) setCharges(fwm *wire.FEDWireMessage, bearer string, info []ChargesInformation) {
+	charges := wire.NewCharges()
+	switch bearer {
+	case ChargeBearerCreditor:
+		charges.ChargeDetails = wire.CDBeneficiary
+	case ChargeBearerShared:
+		charges.ChargeDetails = wire.CDShared
+	case ChargeBearerServiceLevel:
+		charges.ChargeDetails = wire.CDShared
+		c.warn(wire.TagCharges, "Charges.ChargeDetails", "charge bearer %s written as shared", bearer)
+	}
+	if len(info) > 4 {
+		c.warn(wire.TagCharges, "Charges", "only 4 of %d charges fit", len(info))
+		info = info[:4]
+	}
+	amounts := make([]string, 4)
+	for i := range info {
+		amounts[i] = c.text(wire.TagCharges, "Charges.SendersCharges", info[i].Amount.Currency+commaFromDecimal(strings.TrimSpace(info[i].Amount.Value)), 15)
+	}
+	charges.SendersChargesOne, charges.SendersChargesTwo, charges.SendersChargesThree, charges.SendersChargesFour = amounts[0], amounts[1], amounts[2], amounts[3]
+	if charges.ChargeDetails != "" || len(info) > 0 {
+		fwm.Charges = charges
+	}
+}
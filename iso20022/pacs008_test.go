@@ -0,0 +1,268 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package iso20022
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/moov-io/wire"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPacs008_roundTrip(t *testing.T) {
+	for _, name := range []string{
+		"fedWireMessage-CustomerTransfer.txt",
+		"fedWireMessage-CustomerTransferPlusStructuredRemittance.txt",
+	} {
+		t.Run(name, func(t *testing.T) {
+			fwm := readMessage(t, name)
+
+			doc, warnings, err := NewPacs008(fwm)
+			require.NoError(t, err)
+
+			bs, err := xml.MarshalIndent(doc, "", "  ")
+			require.NoError(t, err)
+
+			var read Pacs008
+			require.NoError(t, xml.Unmarshal(bs, &read))
+
+			got, back, err := read.FEDWireMessage()
+			require.NoError(t, err)
+			requireRoundTrip(t, fwm, got, warnings, back)
+		})
+	}
+}
+
+func TestPacs008_mapping(t *testing.T) {
+	fwm := readMessage(t, "fedWireMessage-CustomerTransfer.txt")
+
+	doc, warnings, err := NewPacs008(fwm)
+	require.NoError(t, err)
+
+	hdr := doc.CustomerCreditTransfer.GroupHeader
+	require.Equal(t, "20190410Source08000001", hdr.MessageID)
+	require.Equal(t, ClearingSystemFedwire, hdr.SettlementInformation.ClearingSystem.Code)
+
+	tx := doc.CustomerCreditTransfer.CreditTransferTransactions[0]
+	require.Equal(t, "Sender Reference", tx.PaymentIdentification.InstructionID)
+	require.Equal(t, "Reference", tx.PaymentIdentification.EndToEndID)
+	require.Equal(t, uetr("20190410Source08000001"), tx.PaymentIdentification.UETR)
+	require.Equal(t, ActiveCurrencyAndAmount{Currency: "USD", Value: "12345.67"}, tx.InterbankSettlementAmount)
+	require.Equal(t, "2019-04-10", tx.InterbankSettlementDate)
+	require.Equal(t, ChargeBearerCreditor, tx.ChargeBearer)
+	require.Len(t, tx.ChargesInformation, 4)
+	require.Equal(t, "4567.89", tx.InstructedAmount.Value)
+	require.Equal(t, "1.2345", tx.ExchangeRate)
+
+	require.Equal(t, "121042882", tx.InstructingAgent.FinancialInstitutionIdentification.ClearingSystemMemberIdentification.MemberIdentification)
+	require.Equal(t, "231380104", tx.InstructedAgent.FinancialInstitutionIdentification.ClearingSystemMemberIdentification.MemberIdentification)
+
+	require.Equal(t, "Name", tx.Debtor.Name)
+	require.Equal(t, []string{"Address One", "", "Address Three"}, tx.Debtor.PostalAddress.AddressLines)
+	require.Equal(t, "CCPT", tx.Debtor.Identification.PrivateIdentification.Other[0].SchemeName.Code)
+	require.Equal(t, "DRLC", tx.Creditor.Identification.PrivateIdentification.Other[0].SchemeName.Code)
+	require.Equal(t, "D", tx.DebtorAgent.FinancialInstitutionIdentification.Other.SchemeName.Proprietary)
+
+	require.Equal(t, []string{"LineOne", "LineTwo", "LineThree", "LineFour"}, tx.RemittanceInformation.Unstructured)
+
+	require.Contains(t, warnings, Warning{Tag: wire.TagFIReceiverFI, Field: "FIReceiverFI", Reason: "no pacs.008 equivalent"})
+	require.Contains(t, warnings, Warning{Tag: wire.TagPreviousMessageIdentifier, Field: "PreviousMessageIdentifier", Reason: "no pacs.008 equivalent"})
+	require.Contains(t, warnings, Warning{Tag: wire.TagSenderSupplied, Field: "SenderSupplied.TestProductionCode", Reason: "no pacs.008 equivalent"})
+
+	bs, err := xml.Marshal(doc)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(string(bs), `<Document xmlns="`+Pacs008Namespace+`"><FIToFICstmrCdtTrf><GrpHdr>`))
+	require.Contains(t, string(bs), `<IntrBkSttlmAmt Ccy="USD">12345.67</IntrBkSttlmAmt>`)
+}
+
+func TestPacs008_structuredRemittance(t *testing.T) {
+	fwm := readMessage(t, "fedWireMessage-CustomerTransferPlusStructuredRemittance.txt")
+
+	doc, _, err := NewPacs008(fwm)
+	require.NoError(t, err)
+
+	tx := doc.CustomerCreditTransfer.CreditTransferTransactions[0]
+	require.Equal(t, wire.RemittanceInformationStructured, tx.PaymentTypeInformation.LocalInstrument.Proprietary)
+
+	strd := tx.RemittanceInformation.Structured[0]
+	require.Equal(t, "AROI", strd.ReferredDocumentInformation[0].Type.CodeOrProprietary.Code)
+	require.Equal(t, "111111", strd.ReferredDocumentInformation[0].Number)
+	require.Equal(t, "2019-05-09", strd.ReferredDocumentInformation[0].RelatedDate)
+	require.Equal(t, "1234.56", strd.ReferredDocumentAmount.RemittedAmount.Value)
+	require.Equal(t, "CRDT", strd.ReferredDocumentAmount.AdjustmentAmountAndReason[0].CreditDebitIndicator)
+	require.Equal(t, "222222", strd.CreditorReferenceInformation.Reference)
+	require.Equal(t, "Name", strd.Invoicee.Name)
+	require.Equal(t, "CUST", strd.Invoicee.Identification.OrganisationIdentification.Other[0].SchemeName.Code)
+	require.Equal(t, "Contact Name", strd.Invoicee.ContactDetails.Name)
+	require.Equal(t, "Name", strd.Invoicer.Name)
+	require.Len(t, strd.AdditionalRemittanceInformation, 3)
+}
+
+func TestPacs008_relatedRemittance(t *testing.T) {
+	fwm := readMessage(t, "fedWireMessage-CustomerTransferPlusStructuredRemittance.txt")
+	fwm.LocalInstrument.LocalInstrumentCode = wire.RelatedRemittanceInformation
+	fwm.RemittanceOriginator = nil
+	fwm.RemittanceBeneficiary = nil
+	fwm.PrimaryRemittanceDocument = nil
+	fwm.ActualAmountPaid = nil
+	fwm.GrossAmountRemittanceDocument = nil
+	fwm.AmountNegotiatedDiscount = nil
+	fwm.Adjustment = nil
+	fwm.DateRemittanceDocument = nil
+	fwm.SecondaryRemittanceDocument = nil
+	fwm.RemittanceFreeText = nil
+
+	rr := wire.NewRelatedRemittance()
+	rr.RemittanceIdentification = "Remittance Identification"
+	rr.RemittanceLocationMethod = wire.RLMElectronicDataExchange
+	rr.RemittanceLocationElectronicAddress = "http://moov.io"
+	rr.RemittanceData = wire.RemittanceData{
+		Name:                    "Name",
+		AddressType:             wire.CompletePostalAddress,
+		Department:              "Department",
+		SubDepartment:           "Sub-Department",
+		BuildingNumber:          "16",
+		PostCode:                "19405",
+		TownName:                "AnyTown",
+		CountrySubDivisionState: "PA",
+		Country:                 "UA",
+		AddressLineOne:          "Address Line One",
+		AddressLineTwo:          "Address Line Two",
+	}
+	fwm.RelatedRemittance = rr
+
+	doc, warnings, err := NewPacs008(fwm)
+	require.NoError(t, err)
+
+	tx := doc.CustomerCreditTransfer.CreditTransferTransactions[0]
+	require.Empty(t, tx.RemittanceInformation.Structured)
+	require.Equal(t, "Remittance Identification", tx.RelatedRemittanceInformation[0].RemittanceIdentification)
+	require.Equal(t, "EDIC", tx.RelatedRemittanceInformation[0].RemittanceLocationDetails[0].Method)
+
+	// without a local instrument the related remittance still makes the message a CTP
+	tx.PaymentTypeInformation = nil
+	got, back, err := doc.FEDWireMessage()
+	require.NoError(t, err)
+	requireRoundTrip(t, fwm, got, warnings, back)
+}
+
+func TestPacs008_originatorOptionF(t *testing.T) {
+	fwm := readMessage(t, "fedWireMessage-CustomerTransferPlusStructuredRemittance.txt")
+	fwm.Originator = nil
+
+	doc, warnings, err := NewPacs008(fwm)
+	require.NoError(t, err)
+
+	dbtr := doc.CustomerCreditTransfer.CreditTransferTransactions[0].Debtor
+	require.Equal(t, "Name 1234", dbtr.Name)
+	require.Equal(t, []string{"1000 Colonial Farm Rd"}, dbtr.PostalAddress.AddressLines)
+	require.Equal(t, "123-45-6789", dbtr.Identification.PrivateIdentification.Other[0].Identification)
+	require.Equal(t, "TXID", dbtr.Identification.PrivateIdentification.Other[0].SchemeName.Code)
+	require.Contains(t, warnings, Warning{Tag: wire.TagOriginatorOptionF, Field: "OriginatorOptionF.LineThree", Reason: "line code 5 has no pacs.008 equivalent"})
+
+	got, _, err := doc.FEDWireMessage()
+	require.NoError(t, err)
+	require.Equal(t, wire.TaxIdentificationNumber, got.Originator.Personal.IdentificationCode)
+	require.Equal(t, "123-45-6789", got.Originator.Personal.Identifier)
+	require.Equal(t, "Name 1234", got.Originator.Personal.Name)
+}
+
+func TestPacs008_unsupported(t *testing.T) {
+	fwm := readMessage(t, "fedWireMessage-BankTransfer.txt")
+	_, _, err := NewPacs008(fwm)
+	require.ErrorIs(t, err, ErrUnsupportedMessage)
+
+	fwm = readMessage(t, "fedWireMessage-CustomerTransfer.txt")
+	fwm.Amount = nil
+	_, _, err = NewPacs008(fwm)
+	require.ErrorIs(t, err, ErrMissingTag)
+
+	fwm = readMessage(t, "fedWireMessage-CustomerTransfer.txt")
+	doc, _, err := NewPacs008(fwm)
+	require.NoError(t, err)
+	doc.CustomerCreditTransfer.CreditTransferTransactions[0].InterbankSettlementAmount.Currency = "EUR"
+	_, _, err = doc.FEDWireMessage()
+	require.ErrorIs(t, err, ErrInvalidAmount)
+
+	doc.CustomerCreditTransfer.CreditTransferTransactions = nil
+	_, _, err = doc.FEDWireMessage()
+	require.ErrorIs(t, err, ErrUnsupportedMessage)
+}
+
+func TestPacs008_read(t *testing.T) {
+	input := `<?xml version="1.0" encoding="UTF-8"?>
+<Document xmlns="urn:iso:std:iso:20022:tech:xsd:pacs.008.001.08">
+  <FIToFICstmrCdtTrf>
+    <GrpHdr>
+      <MsgId>20240102MMQFMP9T000123</MsgId>
+      <CreDtTm>2024-01-02T10:00:00-05:00</CreDtTm>
+      <NbOfTxs>1</NbOfTxs>
+      <SttlmInf><SttlmMtd>CLRG</SttlmMtd><ClrSys><Cd>FDW</Cd></ClrSys></SttlmInf>
+    </GrpHdr>
+    <CdtTrfTxInf>
+      <PmtId>
+        <InstrId>Invoice 99</InstrId>
+        <EndToEndId>NOTPROVIDED</EndToEndId>
+        <UETR>8a562c67-ca16-48ba-b074-65581be6f011</UETR>
+      </PmtId>
+      <IntrBkSttlmAmt Ccy="USD">1500.5</IntrBkSttlmAmt>
+      <IntrBkSttlmDt>2024-01-02</IntrBkSttlmDt>
+      <ChrgBr>SHAR</ChrgBr>
+      <InstgAgt><FinInstnId><ClrSysMmbId><ClrSysId><Cd>USABA</Cd></ClrSysId><MmbId>121042882</MmbId></ClrSysMmbId></FinInstnId></InstgAgt>
+      <InstdAgt><FinInstnId><ClrSysMmbId><ClrSysId><Cd>USABA</Cd></ClrSysId><MmbId>231380104</MmbId></ClrSysMmbId></FinInstnId></InstdAgt>
+      <UltmtDbtr><Nm>Parent Company</Nm></UltmtDbtr>
+      <Dbtr>
+        <Nm>Debtor Name</Nm>
+        <PstlAdr><StrtNm>Main Street</StrtNm><BldgNb>1</BldgNb><TwnNm>Springfield</TwnNm><Ctry>US</Ctry></PstlAdr>
+      </Dbtr>
+      <DbtrAcct><Id><Othr><Id>12345678</Id></Othr></Id></DbtrAcct>
+      <DbtrAgt><FinInstnId><ClrSysMmbId><ClrSysId><Cd>USABA</Cd></ClrSysId><MmbId>121042882</MmbId></ClrSysMmbId></FinInstnId></DbtrAgt>
+      <CdtrAgt><FinInstnId><BICFI>CITIUS33XXX</BICFI></FinInstnId></CdtrAgt>
+      <Cdtr><Nm>A creditor name which is longer than Fedwire allows</Nm></Cdtr>
+      <CdtrAcct><Id><IBAN>DE89370400440532013000</IBAN></Id></CdtrAcct>
+    </CdtTrfTxInf>
+  </FIToFICstmrCdtTrf>
+</Document>`
+
+	var doc Pacs008
+	require.NoError(t, xml.Unmarshal([]byte(input), &doc))
+
+	fwm, warnings, err := doc.FEDWireMessage()
+	require.NoError(t, err)
+
+	require.Equal(t, "20240102", fwm.InputMessageAccountabilityData.InputCycleDate)
+	require.Equal(t, "000000150050", fwm.Amount.Amount)
+	require.Equal(t, wire.CustomerTransfer, fwm.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, "Invoice 99", fwm.SenderReference.SenderReference)
+	require.Nil(t, fwm.BeneficiaryReference)
+	require.Equal(t, wire.CDShared, fwm.Charges.ChargeDetails)
+	require.Nil(t, fwm.OriginatorFI)
+	require.Equal(t, wire.SWIFTBankIdentifierCode, fwm.BeneficiaryFI.FinancialInstitution.IdentificationCode)
+
+	require.Equal(t, wire.DemandDepositAccountNumber, fwm.Originator.Personal.IdentificationCode)
+	require.Equal(t, "12345678", fwm.Originator.Personal.Identifier)
+	require.Equal(t, "1 Main Street", fwm.Originator.Personal.Address.AddressLineOne)
+	require.Equal(t, "Springfield", fwm.Originator.Personal.Address.AddressLineTwo)
+	require.Equal(t, "US", fwm.Originator.Personal.Address.AddressLineThree)
+	require.Equal(t, "DE89370400440532013000", fwm.Beneficiary.Personal.Identifier)
+	require.Equal(t, "A creditor name which is longer th", fwm.Beneficiary.Personal.Name[:34])
+
+	require.ElementsMatch(t, []Warning{
+		{Element: "FIToFICstmrCdtTrf/CdtTrfTxInf/PmtId/UETR", Reason: "no Fedwire equivalent"},
+		{Tag: wire.TagBeneficiary, Field: "Beneficiary.Personal.Name", Reason: "truncated to 35 characters"},
+		{Element: "FIToFICstmrCdtTrf/CdtTrfTxInf/UltmtDbtr", Reason: "no Fedwire equivalent"},
+	}, warnings)
+
+	// the unmapped element is kept when the document is written again
+	bs, err := xml.Marshal(&doc)
+	require.NoError(t, err)
+	require.Contains(t, string(bs), "<Nm>Parent Company</Nm></UltmtDbtr>")
+
+	file := wire.NewFile()
+	file.AddFEDWireMessage(*fwm)
+	require.NoError(t, file.Validate())
+}
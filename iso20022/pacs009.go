@@ -0,0 +1,551 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package iso20022
+
+import (
+	"encoding/xml"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/moov-io/wire"
+)
+
+const (
+	// Pacs009Namespace is the XML namespace of pacs.009.001.08 documents
+	Pacs009Namespace = "urn:iso:std:iso:20022:tech:xsd:pacs.009.001.08"
+
+	// LocalInstrumentBankTransfer is the pacs.009 local instrument of a BankTransfer (BTR)
+	LocalInstrumentBankTransfer = "BTRF"
+	// LocalInstrumentFEDFundsSold is the pacs.009 local instrument of a FEDFundsSold (FFS)
+	LocalInstrumentFEDFundsSold = "FFSF"
+	// LocalInstrumentFEDFundsReturned is the pacs.009 local instrument of a FEDFundsReturned (FFR)
+	LocalInstrumentFEDFundsReturned = "FFRF"
+	// LocalInstrumentCover is the pacs.009 local instrument of a cover payment, a CTP with a COVS {3610}
+	LocalInstrumentCover = wire.SequenceBCoverPaymentStructured
+)
+
+// Pacs009 is a pacs.009.001.08 FinancialInstitutionCreditTransfer document, the ISO 20022 equivalent of the
+// BankTransfer (BTR), FEDFundsSold (FFS) and FEDFundsReturned (FFR) business function codes and of cover
+// payments (CTP with a COVS local instrument).
+type Pacs009 struct {
+	XMLName                            xml.Name                           `xml:"urn:iso:std:iso:20022:tech:xsd:pacs.009.001.08 Document"`
+	FinancialInstitutionCreditTransfer FinancialInstitutionCreditTransfer `xml:"FICdtTrf"`
+}
+
+// FinancialInstitutionCreditTransfer is the FICdtTrf message of a pacs.009 document
+type FinancialInstitutionCreditTransfer struct {
+	GroupHeader                GroupHeader                   `xml:"GrpHdr"`
+	CreditTransferTransactions []FICreditTransferTransaction `xml:"CdtTrfTxInf"`
+}
+
+// FICreditTransferTransaction is the CdtTrfTxInf of a pacs.009 document. The debtor and creditor are
+// financial institutions.
+type FICreditTransferTransaction struct {
+	PaymentIdentification     PaymentIdentification                        `xml:"PmtId"`
+	PaymentTypeInformation    *PaymentTypeInformation                      `xml:"PmtTpInf,omitempty"`
+	InterbankSettlementAmount ActiveCurrencyAndAmount                      `xml:"IntrBkSttlmAmt"`
+	InterbankSettlementDate   string                                       `xml:"IntrBkSttlmDt,omitempty"`
+	PreviousInstructingAgent  *BranchAndFinancialInstitutionIdentification `xml:"PrvsInstgAgt1,omitempty"`
+	InstructingAgent          *BranchAndFinancialInstitutionIdentification `xml:"InstgAgt,omitempty"`
+	InstructedAgent           *BranchAndFinancialInstitutionIdentification `xml:"InstdAgt,omitempty"`
+	IntermediaryAgent         *BranchAndFinancialInstitutionIdentification `xml:"IntrmyAgt1,omitempty"`
+	Debtor                    BranchAndFinancialInstitutionIdentification  `xml:"Dbtr"`
+	DebtorAccount             *CashAccount                                 `xml:"DbtrAcct,omitempty"`
+	DebtorAgent               *BranchAndFinancialInstitutionIdentification `xml:"DbtrAgt,omitempty"`
+	CreditorAgent             *BranchAndFinancialInstitutionIdentification `xml:"CdtrAgt,omitempty"`
+	Creditor                  BranchAndFinancialInstitutionIdentification  `xml:"Cdtr"`
+	CreditorAccount           *CashAccount                                 `xml:"CdtrAcct,omitempty"`
+	RemittanceInformation     *RemittanceInformation                       `xml:"RmtInf,omitempty"`
+
+	// UnderlyingCustomerCreditTransfer is the customer transfer a cover payment settles
+	UnderlyingCustomerCreditTransfer *UnderlyingCustomerCreditTransfer `xml:"UndrlygCstmrCdtTrf,omitempty"`
+
+	// Unmapped holds the elements read from a document which have no Fedwire equivalent
+	Unmapped []UnmappedElement `xml:",any"`
+}
+
+// UnderlyingCustomerCreditTransfer is the UndrlygCstmrCdtTrf of a pacs.009 cover payment, which carries the
+// {7033}-{7072} cover payment tags.
+type UnderlyingCustomerCreditTransfer struct {
+	Debtor                    *PartyIdentification                         `xml:"Dbtr,omitempty"`
+	DebtorAccount             *CashAccount                                 `xml:"DbtrAcct,omitempty"`
+	DebtorAgent               *BranchAndFinancialInstitutionIdentification `xml:"DbtrAgt,omitempty"`
+	IntermediaryAgent         *BranchAndFinancialInstitutionIdentification `xml:"IntrmyAgt1,omitempty"`
+	CreditorAgent             *BranchAndFinancialInstitutionIdentification `xml:"CdtrAgt,omitempty"`
+	Creditor                  *PartyIdentification                         `xml:"Cdtr,omitempty"`
+	CreditorAccount           *CashAccount                                 `xml:"CdtrAcct,omitempty"`
+	InstructionsForNextAgents []InstructionForNextAgent                    `xml:"InstrForNxtAgt,omitempty"`
+	RemittanceInformation     *RemittanceInformation                       `xml:"RmtInf,omitempty"`
+	InstructedAmount          *ActiveCurrencyAndAmount                     `xml:"InstdAmt,omitempty"`
+
+	// Unmapped holds the elements read from a document which have no Fedwire equivalent
+	Unmapped []UnmappedElement `xml:",any"`
+}
+
+// NewPacs009 converts a bank transfer (BTR), FEDFundsSold (FFS), FEDFundsReturned (FFR) or cover payment (CTP
+// with a COVS local instrument) into a pacs.009 document.
+//
+// The business function code becomes the proprietary local instrument. {5000} Originator and {4200} Beneficiary
+// are the debtor and creditor institutions, which default to the sender and receiver. A cover payment's
+// {7050} OrderingCustomer, {7052} OrderingInstitution, {7056} IntermediaryInstitution, {7057} InstitutionAccount
+// and {7059} BeneficiaryCustomer become the debtor, debtor agent, intermediary, creditor agent and creditor of
+// the underlying customer credit transfer.
+func NewPacs009(fwm *wire.FEDWireMessage) (*Pacs009, []Warning, error) {
+	if err := requireTags(fwm); err != nil {
+		return nil, nil, err
+	}
+	var instrument, typeCode string
+	switch bfc := fwm.BusinessFunctionCode.BusinessFunctionCode; bfc {
+	case wire.BankTransfer:
+		instrument, typeCode = LocalInstrumentBankTransfer, wire.FundsTransfer
+	case wire.FEDFundsSold:
+		instrument, typeCode = LocalInstrumentFEDFundsSold, wire.SettlementTransfer
+	case wire.FEDFundsReturned:
+		instrument, typeCode = LocalInstrumentFEDFundsReturned, wire.SettlementTransfer
+	case wire.CustomerTransferPlus:
+		if !isCover(fwm) {
+			return nil, nil, fmt.Errorf("%w: customer transfers which aren't cover payments are pacs.008 documents", ErrUnsupportedMessage)
+		}
+		instrument, typeCode = LocalInstrumentCover, wire.FundsTransfer
+	default:
+		return nil, nil, fmt.Errorf("%w: pacs.009 carries bank transfers and cover payments, not %s", ErrUnsupportedMessage, bfc)
+	}
+
+	c := &converter{message: "pacs.009"}
+	c.envelope(fwm)
+	c.typeSubType(fwm, typeCode)
+
+	amount, err := c.settlementAmount(fwm)
+	if err != nil {
+		return nil, nil, err
+	}
+	sender := fedAgent(fwm.SenderDepositoryInstitution.SenderABANumber, fwm.SenderDepositoryInstitution.SenderShortName)
+	receiver := fedAgent(fwm.ReceiverDepositoryInstitution.ReceiverABANumber, fwm.ReceiverDepositoryInstitution.ReceiverShortName)
+
+	tx := FICreditTransferTransaction{
+		PaymentIdentification: c.paymentIdentification(fwm),
+		PaymentTypeInformation: &PaymentTypeInformation{
+			LocalInstrument: &CodeOrProprietary{Proprietary: instrument},
+		},
+		InterbankSettlementAmount: amount,
+		InterbankSettlementDate:   settlementDate(fwm),
+		InstructingAgent:          sender,
+		InstructedAgent:           receiver,
+		Debtor:                    *sender,
+		Creditor:                  *receiver,
+	}
+	c.unmapped(fwm.PreviousMessageIdentifier != nil, wire.TagPreviousMessageIdentifier, "PreviousMessageIdentifier")
+	c.unmapped(fwm.PaymentNotification != nil, wire.TagPaymentNotification, "PaymentNotification")
+	c.unmapped(fwm.Charges != nil, wire.TagCharges, "Charges")
+	c.unmapped(fwm.InstructedAmount != nil, wire.TagInstructedAmount, "InstructedAmount")
+	c.unmapped(fwm.ExchangeRate != nil, wire.TagExchangeRate, "ExchangeRate")
+
+	if fi := fwm.InstructingFI; fi != nil {
+		tx.PreviousInstructingAgent = c.agent(fi.FinancialInstitution)
+	}
+	if fi := fwm.BeneficiaryIntermediaryFI; fi != nil {
+		tx.IntermediaryAgent = c.agent(fi.FinancialInstitution)
+	}
+	if fi := fwm.OriginatorFI; fi != nil {
+		tx.DebtorAgent = c.agent(fi.FinancialInstitution)
+	}
+	if fi := fwm.BeneficiaryFI; fi != nil {
+		tx.CreditorAgent = c.agent(fi.FinancialInstitution)
+	}
+	if fwm.Originator != nil {
+		tx.Debtor, tx.DebtorAccount = c.institution(fwm.Originator.Personal)
+	}
+	c.unmapped(fwm.OriginatorOptionF != nil, wire.TagOriginatorOptionF, "OriginatorOptionF")
+	if fwm.Beneficiary != nil {
+		tx.Creditor, tx.CreditorAccount = c.institution(fwm.Beneficiary.Personal)
+	}
+	if ob := fwm.OriginatorToBeneficiary; ob != nil {
+		if lines := trimLines(ob.LineOne, ob.LineTwo, ob.LineThree, ob.LineFour); len(lines) > 0 {
+			tx.RemittanceInformation = &RemittanceInformation{Unstructured: lines}
+		}
+	}
+
+	if instrument == LocalInstrumentCover {
+		tx.UnderlyingCustomerCreditTransfer = c.underlyingCustomerCreditTransfer(fwm)
+	} else {
+		c.coverPayment(fwm)
+	}
+	c.unmapped(fwm.UnstructuredAddenda != nil, wire.TagUnstructuredAddenda, "UnstructuredAddenda")
+	c.remittanceTags(fwm)
+	c.fiToFiInformation(fwm)
+	c.unmapped(fwm.AccountDebitedDrawdown != nil, wire.TagAccountDebitedDrawdown, "AccountDebitedDrawdown")
+	c.unmapped(fwm.AccountCreditedDrawdown != nil, wire.TagAccountCreditedDrawdown, "AccountCreditedDrawdown")
+	c.unmapped(fwm.ServiceMessage != nil, wire.TagServiceMessage, "ServiceMessage")
+
+	doc := &Pacs009{
+		FinancialInstitutionCreditTransfer: FinancialInstitutionCreditTransfer{
+			GroupHeader:                c.groupHeader(fwm),
+			CreditTransferTransactions: []FICreditTransferTransaction{tx},
+		},
+	}
+	return doc, c.warnings, nil
+}
+
+// isCover reports if fwm is a cover payment
+func isCover(fwm *wire.FEDWireMessage) bool {
+	return fwm.BusinessFunctionCode != nil && fwm.BusinessFunctionCode.BusinessFunctionCode == wire.CustomerTransferPlus &&
+		fwm.LocalInstrument != nil && fwm.LocalInstrument.LocalInstrumentCode == wire.SequenceBCoverPaymentStructured
+}
+
+// institution returns the financial institution and account of a {5000} Originator or {4200} Beneficiary. A
+// demand deposit account number identifies the account, any other identification code the institution.
+func (c *converter) institution(p wire.Personal) (BranchAndFinancialInstitutionIdentification, *CashAccount) {
+	fi := wire.FinancialInstitution{
+		IdentificationCode: p.IdentificationCode,
+		Identifier:         p.Identifier,
+		Name:               p.Name,
+		Address:            p.Address,
+	}
+	if p.IdentificationCode != wire.DemandDepositAccountNumber {
+		return *c.agent(fi), nil
+	}
+	fi.IdentificationCode, fi.Identifier = "", ""
+	return *c.agent(fi), account(p.Identifier)
+}
+
+// underlyingCustomerCreditTransfer returns the UndrlygCstmrCdtTrf of a cover payment's {7xxx} tags
+func (c *converter) underlyingCustomerCreditTransfer(fwm *wire.FEDWireMessage) *UnderlyingCustomerCreditTransfer {
+	u := &UnderlyingCustomerCreditTransfer{}
+	if oc := fwm.OrderingCustomer; oc != nil {
+		u.Debtor, u.DebtorAccount = c.coverParty(wire.TagOrderingCustomer, "OrderingCustomer", oc.CoverPayment)
+	}
+	if oi := fwm.OrderingInstitution; oi != nil {
+		u.DebtorAgent = c.coverAgent(wire.TagOrderingInstitution, "OrderingInstitution", oi.CoverPayment)
+	}
+	if ii := fwm.IntermediaryInstitution; ii != nil {
+		u.IntermediaryAgent = c.coverAgent(wire.TagIntermediaryInstitution, "IntermediaryInstitution", ii.CoverPayment)
+	}
+	if ia := fwm.InstitutionAccount; ia != nil {
+		u.CreditorAgent = c.coverAgent(wire.TagInstitutionAccount, "InstitutionAccount", ia.CoverPayment)
+	}
+	if bc := fwm.BeneficiaryCustomer; bc != nil {
+		u.Creditor, u.CreditorAccount = c.coverParty(wire.TagBeneficiaryCustomer, "BeneficiaryCustomer", bc.CoverPayment)
+	}
+	if ri := fwm.Remittance; ri != nil {
+		c.swiftFieldTag(wire.TagRemittance, "Remittance", ri.CoverPayment)
+		cp := ri.CoverPayment
+		if lines := trimLines(cp.SwiftLineOne, cp.SwiftLineTwo, cp.SwiftLineThree, cp.SwiftLineFour); len(lines) > 0 {
+			u.RemittanceInformation = &RemittanceInformation{Unstructured: lines}
+		}
+	}
+	if sr := fwm.SenderToReceiver; sr != nil {
+		c.swiftFieldTag(wire.TagSenderToReceiver, "SenderToReceiver", sr.CoverPayment)
+		cp := sr.CoverPayment
+		for _, line := range trimLines(cp.SwiftLineOne, cp.SwiftLineTwo, cp.SwiftLineThree, cp.SwiftLineFour, cp.SwiftLineFive, cp.SwiftLineSix) {
+			u.InstructionsForNextAgents = append(u.InstructionsForNextAgents, InstructionForNextAgent{InstructionInformation: line})
+		}
+	}
+	if cia := fwm.CurrencyInstructedAmount; cia != nil {
+		c.unmapped(strings.TrimSpace(cia.SwiftFieldTag) != "", wire.TagCurrencyInstructedAmount, "CurrencyInstructedAmount.SwiftFieldTag")
+		u.InstructedAmount = &ActiveCurrencyAndAmount{Currency: currencyUSD, Value: coverAmount(cia.Amount)}
+	}
+	return u
+}
+
+// coverAmount returns the ISO 20022 amount of a {7033} amount, which is zero padded and has no currency of
+// its own. Cover payments settle in US dollars.
+func coverAmount(amt string) string {
+	value := strings.TrimLeft(strings.TrimSpace(amt), "0")
+	if value == "" || strings.HasPrefix(value, ",") {
+		value = "0" + value
+	}
+	return decimalFromComma(value)
+}
+
+// swiftFieldTag records the SWIFT field tag of a cover payment tag, which ISO 20022 has no element for
+func (c *converter) swiftFieldTag(tag, field string, cp wire.CoverPayment) {
+	c.unmapped(strings.TrimSpace(cp.SwiftFieldTag) != "", tag, field+".SwiftFieldTag")
+}
+
+// coverParty returns the party of a {7050} OrderingCustomer or {7059} BeneficiaryCustomer. Like SWIFT fields
+// 50 and 59, an optional first line of /account is followed by the name and address lines.
+func (c *converter) coverParty(tag, field string, cp wire.CoverPayment) (*PartyIdentification, *CashAccount) {
+	c.swiftFieldTag(tag, field, cp)
+	lines := trimLines(cp.SwiftLineOne, cp.SwiftLineTwo, cp.SwiftLineThree, cp.SwiftLineFour, cp.SwiftLineFive)
+	var acct *CashAccount
+	if len(lines) > 0 && strings.HasPrefix(lines[0], "/") {
+		acct, lines = account(lines[0][1:]), lines[1:]
+	}
+	party := &PartyIdentification{}
+	if len(lines) > 0 {
+		party.Name = lines[0]
+		if len(lines) > 1 {
+			party.PostalAddress = &PostalAddress{AddressLines: lines[1:]}
+		}
+	}
+	return party, acct
+}
+
+// bicPattern matches a BIC of 8 or 11 characters
+var bicPattern = regexp.MustCompile(`^[A-Z]{6}[A-Z0-9]{2}([A-Z0-9]{3})?$`)
+
+// fedwireLinePrefix starts a SWIFT party identifier line carrying an ABA routing number, e.g. //FW121042882
+const fedwireLinePrefix = "//FW"
+
+// coverAgent returns the agent of a {7052}, {7056} or {7057} institution. The first line is the institution's
+// BIC, a //FW routing number or its name, and the remaining lines are its name and address.
+func (c *converter) coverAgent(tag, field string, cp wire.CoverPayment) *BranchAndFinancialInstitutionIdentification {
+	c.swiftFieldTag(tag, field, cp)
+	lines := trimLines(cp.SwiftLineOne, cp.SwiftLineTwo, cp.SwiftLineThree, cp.SwiftLineFour, cp.SwiftLineFive)
+	id := FinancialInstitutionIdentification{}
+	switch {
+	case len(lines) == 0:
+	case bicPattern.MatchString(lines[0]):
+		id.BICFI, lines = lines[0], lines[1:]
+	case strings.HasPrefix(lines[0], fedwireLinePrefix):
+		id.ClearingSystemMemberIdentification = &ClearingSystemMemberIdentification{
+			ClearingSystemIdentification: &CodeOrProprietary{Code: ClearingSystemABA},
+			MemberIdentification:         strings.TrimPrefix(lines[0], fedwireLinePrefix),
+		}
+		lines = lines[1:]
+	}
+	if len(lines) > 0 {
+		id.Name = lines[0]
+		if len(lines) > 1 {
+			id.PostalAddress = &PostalAddress{AddressLines: lines[1:]}
+		}
+	}
+	return &BranchAndFinancialInstitutionIdentification{FinancialInstitutionIdentification: id}
+}
+
+// FEDWireMessage converts the document's transaction into a bank transfer, FEDFundsSold, FEDFundsReturned or
+// cover payment, depending on its local instrument. Transactions without a local instrument are cover
+// payments when they have an underlying customer credit transfer and bank transfers otherwise.
+func (doc *Pacs009) FEDWireMessage() (*wire.FEDWireMessage, []Warning, error) {
+	txs := doc.FinancialInstitutionCreditTransfer.CreditTransferTransactions
+	if len(txs) != 1 {
+		return nil, nil, fmt.Errorf("%w: Fedwire messages carry one transaction, found %d", ErrUnsupportedMessage, len(txs))
+	}
+	tx := txs[0]
+	path := "FICdtTrf/CdtTrfTxInf"
+
+	c := &converter{message: "pacs.009"}
+	fwm, err := c.transfer(doc.FinancialInstitutionCreditTransfer.GroupHeader, tx.PaymentIdentification, tx.InterbankSettlementAmount,
+		tx.InstructingAgent, tx.InstructedAgent, path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	instrument := ""
+	if pti := tx.PaymentTypeInformation; pti != nil {
+		if pti.LocalInstrument != nil {
+			instrument = schemeValue(pti.LocalInstrument)
+		}
+		if pti.CategoryPurpose != nil {
+			c.warnElement(path+"/PmtTpInf/CtgyPurp", "no Fedwire equivalent")
+		}
+	}
+	if instrument == "" && tx.UnderlyingCustomerCreditTransfer != nil {
+		instrument = LocalInstrumentCover
+	}
+	switch instrument {
+	case "", LocalInstrumentBankTransfer:
+		fwm.BusinessFunctionCode.BusinessFunctionCode = wire.BankTransfer
+	case LocalInstrumentFEDFundsSold:
+		fwm.BusinessFunctionCode.BusinessFunctionCode = wire.FEDFundsSold
+		fwm.TypeSubType.TypeCode = wire.SettlementTransfer
+	case LocalInstrumentFEDFundsReturned:
+		fwm.BusinessFunctionCode.BusinessFunctionCode = wire.FEDFundsReturned
+		fwm.TypeSubType.TypeCode = wire.SettlementTransfer
+	case LocalInstrumentCover:
+		fwm.BusinessFunctionCode.BusinessFunctionCode = wire.CustomerTransferPlus
+		fwm.LocalInstrument = wire.NewLocalInstrument()
+		fwm.LocalInstrument.LocalInstrumentCode = wire.SequenceBCoverPaymentStructured
+	default:
+		fwm.BusinessFunctionCode.BusinessFunctionCode = wire.BankTransfer
+		c.warnElement(path+"/PmtTpInf/LclInstrm", "local instrument %s has no Fedwire business function code, written as a bank transfer", instrument)
+	}
+
+	if tx.PreviousInstructingAgent != nil {
+		fi := wire.NewInstructingFI()
+		fi.FinancialInstitution = c.financialInstitution(wire.TagInstructingFI, "InstructingFI.FinancialInstitution", tx.PreviousInstructingAgent)
+		fwm.InstructingFI = fi
+	}
+	if tx.IntermediaryAgent != nil {
+		fi := wire.NewBeneficiaryIntermediaryFI()
+		fi.FinancialInstitution = c.financialInstitution(wire.TagBeneficiaryIntermediaryFI, "BeneficiaryIntermediaryFI.FinancialInstitution", tx.IntermediaryAgent)
+		fwm.BeneficiaryIntermediaryFI = fi
+	}
+	if tx.DebtorAgent != nil {
+		fi := wire.NewOriginatorFI()
+		fi.FinancialInstitution = c.financialInstitution(wire.TagOriginatorFI, "OriginatorFI.FinancialInstitution", tx.DebtorAgent)
+		fwm.OriginatorFI = fi
+	}
+	if tx.CreditorAgent != nil {
+		fi := wire.NewBeneficiaryFI()
+		fi.FinancialInstitution = c.financialInstitution(wire.TagBeneficiaryFI, "BeneficiaryFI.FinancialInstitution", tx.CreditorAgent)
+		fwm.BeneficiaryFI = fi
+	}
+	if tx.DebtorAccount != nil || !sameAgent(&tx.Debtor, tx.InstructingAgent) {
+		fwm.Originator = wire.NewOriginator()
+		fwm.Originator.Personal = c.institutionParty(wire.TagOriginator, "Originator.Personal", tx.Debtor, tx.DebtorAccount)
+	}
+	if tx.CreditorAccount != nil || !sameAgent(&tx.Creditor, tx.InstructedAgent) {
+		fwm.Beneficiary = wire.NewBeneficiary()
+		fwm.Beneficiary.Personal = c.institutionParty(wire.TagBeneficiary, "Beneficiary.Personal", tx.Creditor, tx.CreditorAccount)
+	}
+
+	if rmtInf := tx.RemittanceInformation; rmtInf != nil {
+		if len(rmtInf.Structured) > 0 {
+			c.warnElement(path+"/RmtInf/Strd", "no Fedwire equivalent")
+		}
+		c.setRemittanceInformation(fwm, path+"/RmtInf", &RemittanceInformation{Unstructured: rmtInf.Unstructured})
+	}
+
+	if u := tx.UnderlyingCustomerCreditTransfer; u != nil {
+		if instrument == LocalInstrumentCover {
+			c.setCoverPayment(fwm, path+"/UndrlygCstmrCdtTrf", u)
+		} else {
+			c.warnElement(path+"/UndrlygCstmrCdtTrf", "only cover payments have an underlying customer credit transfer")
+		}
+	}
+	c.unmappedElements(path, tx.Unmapped)
+
+	return fwm, c.warnings, nil
+}
+
+// institutionParty returns the {5000} Originator or {4200} Beneficiary of a debtor or creditor institution
+func (c *converter) institutionParty(tag, field string, agent BranchAndFinancialInstitutionIdentification, acct *CashAccount) wire.Personal {
+	fi := c.financialInstitution(tag, field, &agent)
+	p := wire.Personal{
+		IdentificationCode: fi.IdentificationCode,
+		Identifier:         fi.Identifier,
+		Name:               fi.Name,
+		Address:            fi.Address,
+	}
+	if acct != nil {
+		if p.Identifier != "" {
+			c.warn(tag, field+".Identifier", "identifier %s dropped in favour of the account", p.Identifier)
+		}
+		p.IdentificationCode = wire.DemandDepositAccountNumber
+		p.Identifier = c.text(tag, field+".Identifier", accountNumber(acct), 34)
+	}
+	return p
+}
+
+// setCoverPayment sets the {7033}-{7072} cover payment tags of an underlying customer credit transfer on fwm
+func (c *converter) setCoverPayment(fwm *wire.FEDWireMessage, path string, u *UnderlyingCustomerCreditTransfer) {
+	if u.InstructedAmount != nil {
+		if u.InstructedAmount.Currency != currencyUSD {
+			c.warn(wire.TagCurrencyInstructedAmount, "CurrencyInstructedAmount.Amount", "currency %s dropped", u.InstructedAmount.Currency)
+		}
+		cia := wire.NewCurrencyInstructedAmount()
+		amt := commaFromDecimal(strings.TrimSpace(u.InstructedAmount.Value))
+		if len(amt) < 18 {
+			amt = strings.Repeat("0", 18-len(amt)) + amt
+		}
+		cia.Amount = c.text(wire.TagCurrencyInstructedAmount, "CurrencyInstructedAmount.Amount", amt, 18)
+		fwm.CurrencyInstructedAmount = cia
+	}
+	if u.Debtor != nil || u.DebtorAccount != nil {
+		oc := wire.NewOrderingCustomer()
+		oc.CoverPayment = c.coverPartyLines(wire.TagOrderingCustomer, "OrderingCustomer.CoverPayment", u.Debtor, u.DebtorAccount)
+		fwm.OrderingCustomer = oc
+	}
+	if u.DebtorAgent != nil {
+		oi := wire.NewOrderingInstitution()
+		oi.CoverPayment = c.coverAgentLines(wire.TagOrderingInstitution, "OrderingInstitution.CoverPayment", u.DebtorAgent)
+		fwm.OrderingInstitution = oi
+	}
+	if u.IntermediaryAgent != nil {
+		ii := wire.NewIntermediaryInstitution()
+		ii.CoverPayment = c.coverAgentLines(wire.TagIntermediaryInstitution, "IntermediaryInstitution.CoverPayment", u.IntermediaryAgent)
+		fwm.IntermediaryInstitution = ii
+	}
+	if u.CreditorAgent != nil {
+		ia := wire.NewInstitutionAccount()
+		ia.CoverPayment = c.coverAgentLines(wire.TagInstitutionAccount, "InstitutionAccount.CoverPayment", u.CreditorAgent)
+		fwm.InstitutionAccount = ia
+	}
+	if u.Creditor != nil || u.CreditorAccount != nil {
+		bc := wire.NewBeneficiaryCustomer()
+		bc.CoverPayment = c.coverPartyLines(wire.TagBeneficiaryCustomer, "BeneficiaryCustomer.CoverPayment", u.Creditor, u.CreditorAccount)
+		fwm.BeneficiaryCustomer = bc
+	}
+	if rmtInf := u.RemittanceInformation; rmtInf != nil {
+		if len(rmtInf.Structured) > 0 {
+			c.warnElement(path+"/RmtInf/Strd", "no Fedwire equivalent")
+		}
+		if len(rmtInf.Unstructured) > 0 {
+			lines := c.lines(wire.TagRemittance, "Remittance.CoverPayment", rmtInf.Unstructured, 4, 35)
+			ri := wire.NewRemittance()
+			ri.CoverPayment = wire.CoverPayment{SwiftLineOne: lines[0], SwiftLineTwo: lines[1], SwiftLineThree: lines[2], SwiftLineFour: lines[3]}
+			fwm.Remittance = ri
+		}
+	}
+	if len(u.InstructionsForNextAgents) > 0 {
+		var instructions []string
+		for _, instr := range u.InstructionsForNextAgents {
+			instructions = append(instructions, strings.TrimSpace(instr.Code+" "+instr.InstructionInformation))
+		}
+		lines := c.lines(wire.TagSenderToReceiver, "SenderToReceiver.CoverPayment", instructions, 6, 35)
+		sr := wire.NewSenderToReceiver()
+		sr.CoverPayment = wire.CoverPayment{
+			SwiftLineOne: lines[0], SwiftLineTwo: lines[1], SwiftLineThree: lines[2],
+			SwiftLineFour: lines[3], SwiftLineFive: lines[4], SwiftLineSix: lines[5],
+		}
+		fwm.SenderToReceiver = sr
+	}
+	c.unmappedElements(path, u.Unmapped)
+}
+
+// coverPartyLines returns the SWIFT lines of an underlying debtor or creditor, see coverParty
+func (c *converter) coverPartyLines(tag, field string, party *PartyIdentification, acct *CashAccount) wire.CoverPayment {
+	var values []string
+	if acct != nil {
+		values = append(values, "/"+accountNumber(acct))
+	}
+	if party != nil {
+		values = append(values, party.Name)
+		values = append(values, partyAddressLines(party.PostalAddress)...)
+	}
+	return c.coverLines(tag, field, values)
+}
+
+// coverAgentLines returns the SWIFT lines of an underlying agent, see coverAgent
+func (c *converter) coverAgentLines(tag, field string, agent *BranchAndFinancialInstitutionIdentification) wire.CoverPayment {
+	id := agent.FinancialInstitutionIdentification
+	var values []string
+	switch mmb := id.ClearingSystemMemberIdentification; {
+	case id.BICFI != "":
+		values = append(values, id.BICFI)
+	case mmb != nil && mmb.ClearingSystemIdentification != nil && mmb.ClearingSystemIdentification.Code == ClearingSystemABA:
+		values = append(values, fedwireLinePrefix+mmb.MemberIdentification)
+	case mmb != nil || id.Other != nil:
+		c.warn(tag, field, "institution identification dropped")
+	}
+	values = append(values, id.Name)
+	values = append(values, partyAddressLines(id.PostalAddress)...)
+	return c.coverLines(tag, field, values)
+}
+
+// partyAddressLines returns the address lines of pa, or its structured address written out as lines
+func partyAddressLines(pa *PostalAddress) []string {
+	if pa == nil {
+		return nil
+	}
+	if len(pa.AddressLines) > 0 {
+		return pa.AddressLines
+	}
+	return addressLines(pa)
+}
+
+// coverLines returns the five SWIFT lines of a cover payment party or institution
+func (c *converter) coverLines(tag, field string, values []string) wire.CoverPayment {
+	lines := c.lines(tag, field, trimLines(values...), 5, 35)
+	return wire.CoverPayment{
+		SwiftLineOne:   lines[0],
+		SwiftLineTwo:   lines[1],
+		SwiftLineThree: lines[2],
+		SwiftLineFour:  lines[3],
+		SwiftLineFive:  lines[4],
+	}
+}
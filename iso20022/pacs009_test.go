@@ -0,0 +1,210 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package iso20022
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/moov-io/wire"
+	"github.com/stretchr/testify/require"
+)
+
+// pacs009RoundTrip converts fwm into a pacs.009 document, writes and reads the document and converts it back
+func pacs009RoundTrip(t *testing.T, fwm *wire.FEDWireMessage) (*Pacs009, []Warning) {
+	t.Helper()
+
+	doc, warnings, err := NewPacs009(fwm)
+	require.NoError(t, err)
+
+	bs, err := xml.MarshalIndent(doc, "", "  ")
+	require.NoError(t, err)
+
+	var read Pacs009
+	require.NoError(t, xml.Unmarshal(bs, &read))
+
+	got, back, err := read.FEDWireMessage()
+	require.NoError(t, err)
+	requireRoundTrip(t, fwm, got, warnings, back)
+
+	return doc, warnings
+}
+
+func TestPacs009_bankTransfer(t *testing.T) {
+	fwm := readMessage(t, "fedWireMessage-BankTransfer.txt")
+
+	doc, warnings := pacs009RoundTrip(t, fwm)
+
+	tx := doc.FinancialInstitutionCreditTransfer.CreditTransferTransactions[0]
+	require.Equal(t, LocalInstrumentBankTransfer, tx.PaymentTypeInformation.LocalInstrument.Proprietary)
+	require.Equal(t, "12345.67", tx.InterbankSettlementAmount.Value)
+	require.Equal(t, "Sender Reference", tx.PaymentIdentification.InstructionID)
+	require.Equal(t, "Reference", tx.PaymentIdentification.EndToEndID)
+
+	require.Equal(t, "Name", tx.Debtor.FinancialInstitutionIdentification.Name)
+	require.Equal(t, "1", tx.Debtor.FinancialInstitutionIdentification.Other.SchemeName.Proprietary)
+	require.Equal(t, "1234", tx.Debtor.FinancialInstitutionIdentification.Other.Identification)
+	require.Equal(t, "3", tx.Creditor.FinancialInstitutionIdentification.Other.SchemeName.Proprietary)
+	require.Equal(t, "D", tx.DebtorAgent.FinancialInstitutionIdentification.Other.SchemeName.Proprietary)
+	require.NotNil(t, tx.CreditorAgent)
+	require.NotNil(t, tx.IntermediaryAgent)
+	require.NotNil(t, tx.PreviousInstructingAgent)
+	require.Equal(t, []string{"LineOne", "LineTwo", "LineThree", "LineFour"}, tx.RemittanceInformation.Unstructured)
+	require.Nil(t, tx.UnderlyingCustomerCreditTransfer)
+
+	require.Contains(t, warnings, Warning{Tag: wire.TagPreviousMessageIdentifier, Field: "PreviousMessageIdentifier", Reason: "no pacs.009 equivalent"})
+	require.Contains(t, warnings, Warning{Tag: wire.TagFIReceiverFI, Field: "FIReceiverFI", Reason: "no pacs.009 equivalent"})
+
+	bs, err := xml.Marshal(doc)
+	require.NoError(t, err)
+	require.Contains(t, string(bs), `<Document xmlns="`+Pacs009Namespace+`"><FICdtTrf><GrpHdr>`)
+}
+
+func TestPacs009_fedFunds(t *testing.T) {
+	for bfc, instrument := range map[string]string{
+		wire.FEDFundsSold:     LocalInstrumentFEDFundsSold,
+		wire.FEDFundsReturned: LocalInstrumentFEDFundsReturned,
+	} {
+		t.Run(bfc, func(t *testing.T) {
+			fwm := readMessage(t, "fedWireMessage-BankTransfer.txt")
+			fwm.BusinessFunctionCode.BusinessFunctionCode = bfc
+			fwm.TypeSubType.TypeCode = wire.SettlementTransfer
+			fwm.PreviousMessageIdentifier = nil
+
+			doc, warnings := pacs009RoundTrip(t, fwm)
+			require.Equal(t, instrument, doc.FinancialInstitutionCreditTransfer.CreditTransferTransactions[0].PaymentTypeInformation.LocalInstrument.Proprietary)
+			for _, w := range warnings {
+				require.NotEqual(t, wire.TagTypeSubType, w.Tag)
+			}
+		})
+	}
+}
+
+func TestPacs009_cover(t *testing.T) {
+	fwm := readMessage(t, "fedWireMessage-CustomerTransferPlusCOVS.txt")
+
+	// SWIFT field tags have no ISO 20022 element
+	_, warnings, err := NewPacs009(fwm)
+	require.NoError(t, err)
+	require.Contains(t, warnings, Warning{Tag: wire.TagOrderingCustomer, Field: "OrderingCustomer.SwiftFieldTag", Reason: "no pacs.009 equivalent"})
+	require.Contains(t, warnings, Warning{Tag: wire.TagCurrencyInstructedAmount, Field: "CurrencyInstructedAmount.SwiftFieldTag", Reason: "no pacs.009 equivalent"})
+
+	fwm.CurrencyInstructedAmount.SwiftFieldTag = ""
+	fwm.OrderingCustomer.CoverPayment.SwiftFieldTag = ""
+	fwm.OrderingInstitution.CoverPayment.SwiftFieldTag = ""
+	fwm.IntermediaryInstitution.CoverPayment.SwiftFieldTag = ""
+	fwm.InstitutionAccount.CoverPayment.SwiftFieldTag = ""
+	fwm.BeneficiaryCustomer.CoverPayment.SwiftFieldTag = ""
+	fwm.Remittance.CoverPayment.SwiftFieldTag = ""
+	fwm.SenderToReceiver.CoverPayment.SwiftFieldTag = ""
+
+	doc, warnings := pacs009RoundTrip(t, fwm)
+	for _, w := range warnings {
+		require.NotContains(t, w.Tag, "{70", w.String())
+	}
+
+	tx := doc.FinancialInstitutionCreditTransfer.CreditTransferTransactions[0]
+	require.Equal(t, LocalInstrumentCover, tx.PaymentTypeInformation.LocalInstrument.Proprietary)
+
+	u := tx.UnderlyingCustomerCreditTransfer
+	require.Equal(t, &ActiveCurrencyAndAmount{Currency: "USD", Value: "1500.49"}, u.InstructedAmount)
+	require.Equal(t, "Swift Line One", u.Debtor.Name)
+	require.Len(t, u.Debtor.PostalAddress.AddressLines, 4)
+	require.Equal(t, "Swift Line One", u.DebtorAgent.FinancialInstitutionIdentification.Name)
+	require.Equal(t, "Swift Line One", u.IntermediaryAgent.FinancialInstitutionIdentification.Name)
+	require.Equal(t, "Swift Line One", u.CreditorAgent.FinancialInstitutionIdentification.Name)
+	require.Equal(t, "Swift Line One", u.Creditor.Name)
+	require.Len(t, u.RemittanceInformation.Unstructured, 4)
+	require.Len(t, u.InstructionsForNextAgents, 6)
+
+	_, _, err = NewPacs008(fwm)
+	require.ErrorIs(t, err, ErrUnsupportedMessage)
+}
+
+func TestPacs009_coverLines(t *testing.T) {
+	c := &converter{message: "pacs.009"}
+
+	party, acct := c.coverParty(wire.TagOrderingCustomer, "OrderingCustomer", wire.CoverPayment{
+		SwiftLineOne: "/123456789", SwiftLineTwo: "Jane Doe", SwiftLineThree: "1 Main St",
+	})
+	require.Equal(t, "123456789", accountNumber(acct))
+	require.Equal(t, "Jane Doe", party.Name)
+	require.Equal(t, []string{"1 Main St"}, party.PostalAddress.AddressLines)
+	require.Equal(t, wire.CoverPayment{SwiftLineOne: "/123456789", SwiftLineTwo: "Jane Doe", SwiftLineThree: "1 Main St"},
+		c.coverPartyLines(wire.TagOrderingCustomer, "OrderingCustomer", party, acct))
+
+	agent := c.coverAgent(wire.TagOrderingInstitution, "OrderingInstitution", wire.CoverPayment{SwiftLineOne: "CITIUS33XXX"})
+	require.Equal(t, "CITIUS33XXX", agent.FinancialInstitutionIdentification.BICFI)
+	require.Equal(t, wire.CoverPayment{SwiftLineOne: "CITIUS33XXX"}, c.coverAgentLines(wire.TagOrderingInstitution, "OrderingInstitution", agent))
+
+	agent = c.coverAgent(wire.TagInstitutionAccount, "InstitutionAccount", wire.CoverPayment{SwiftLineOne: "//FW121042882", SwiftLineTwo: "Wells Fargo NA"})
+	require.Equal(t, "121042882", agent.FinancialInstitutionIdentification.ClearingSystemMemberIdentification.MemberIdentification)
+	require.Equal(t, "Wells Fargo NA", agent.FinancialInstitutionIdentification.Name)
+	require.Equal(t, wire.CoverPayment{SwiftLineOne: "//FW121042882", SwiftLineTwo: "Wells Fargo NA"}, c.coverAgentLines(wire.TagInstitutionAccount, "InstitutionAccount", agent))
+
+	require.Empty(t, c.warnings)
+}
+
+func TestPacs009_unsupported(t *testing.T) {
+	_, _, err := NewPacs009(readMessage(t, "fedWireMessage-CustomerTransfer.txt"))
+	require.ErrorIs(t, err, ErrUnsupportedMessage)
+
+	_, _, err = NewPacs009(readMessage(t, "fedWireMessage-CustomerTransferPlusStructuredRemittance.txt"))
+	require.ErrorIs(t, err, ErrUnsupportedMessage)
+
+	_, _, err = NewPacs009(readMessage(t, "fedWireMessage-ServiceMessage.txt"))
+	require.ErrorIs(t, err, ErrUnsupportedMessage)
+}
+
+func TestPacs009_read(t *testing.T) {
+	input := `<?xml version="1.0" encoding="UTF-8"?>
+<Document xmlns="urn:iso:std:iso:20022:tech:xsd:pacs.009.001.08">
+  <FICdtTrf>
+    <GrpHdr>
+      <MsgId>20240102MMQFMP9T000124</MsgId>
+      <CreDtTm>2024-01-02T10:00:00-05:00</CreDtTm>
+      <NbOfTxs>1</NbOfTxs>
+      <SttlmInf><SttlmMtd>CLRG</SttlmMtd><ClrSys><Cd>FDW</Cd></ClrSys></SttlmInf>
+    </GrpHdr>
+    <CdtTrfTxInf>
+      <PmtId>
+        <InstrId>20240102MMQFMP9T000124</InstrId>
+        <EndToEndId>NOTPROVIDED</EndToEndId>
+      </PmtId>
+      <IntrBkSttlmAmt Ccy="USD">1000000</IntrBkSttlmAmt>
+      <IntrBkSttlmDt>2024-01-02</IntrBkSttlmDt>
+      <SttlmPrty>HIGH</SttlmPrty>
+      <InstgAgt><FinInstnId><ClrSysMmbId><ClrSysId><Cd>USABA</Cd></ClrSysId><MmbId>121042882</MmbId></ClrSysMmbId></FinInstnId></InstgAgt>
+      <InstdAgt><FinInstnId><ClrSysMmbId><ClrSysId><Cd>USABA</Cd></ClrSysId><MmbId>231380104</MmbId></ClrSysMmbId></FinInstnId></InstdAgt>
+      <Dbtr><FinInstnId><ClrSysMmbId><ClrSysId><Cd>USABA</Cd></ClrSysId><MmbId>121042882</MmbId></ClrSysMmbId></FinInstnId></Dbtr>
+      <Cdtr><FinInstnId><BICFI>CITIUS33XXX</BICFI><Nm>Citibank</Nm></FinInstnId></Cdtr>
+      <CdtrAcct><Id><Othr><Id>987654321</Id></Othr></Id></CdtrAcct>
+    </CdtTrfTxInf>
+  </FICdtTrf>
+</Document>`
+
+	var doc Pacs009
+	require.NoError(t, xml.Unmarshal([]byte(input), &doc))
+
+	fwm, warnings, err := doc.FEDWireMessage()
+	require.NoError(t, err)
+
+	require.Equal(t, wire.BankTransfer, fwm.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, "000100000000", fwm.Amount.Amount)
+	require.Nil(t, fwm.SenderReference)
+	require.Nil(t, fwm.Originator)
+	require.Equal(t, wire.DemandDepositAccountNumber, fwm.Beneficiary.Personal.IdentificationCode)
+	require.Equal(t, "987654321", fwm.Beneficiary.Personal.Identifier)
+	require.Equal(t, "Citibank", fwm.Beneficiary.Personal.Name)
+
+	require.ElementsMatch(t, []Warning{
+		{Tag: wire.TagBeneficiary, Field: "Beneficiary.Personal.Identifier", Reason: "identifier CITIUS33XXX dropped in favour of the account"},
+		{Element: "FICdtTrf/CdtTrfTxInf/SttlmPrty", Reason: "no Fedwire equivalent"},
+	}, warnings)
+
+	file := wire.NewFile()
+	file.AddFEDWireMessage(*fwm)
+	require.NoError(t, file.Validate())
+}
@@ -0,0 +1,209 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package iso20022
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	"github.com/moov-io/wire"
+)
+
+const (
+	// Pain013Namespace is the XML namespace of pain.013.001.07 documents
+	Pain013Namespace = "urn:iso:std:iso:20022:tech:xsd:pain.013.001.07"
+
+	// PaymentMethodTransfer is the payment method of a credit transfer
+	PaymentMethodTransfer = "TRF"
+)
+
+// Pain013 is a pain.013.001.07 CreditorPaymentActivationRequest document, the ISO 20022 equivalent of the
+// CustomerCorporateDrawdownRequest (DRC) and BankDrawDownRequest (DRB) business function codes.
+type Pain013 struct {
+	XMLName           xml.Name                         `xml:"urn:iso:std:iso:20022:tech:xsd:pain.013.001.07 Document"`
+	ActivationRequest CreditorPaymentActivationRequest `xml:"CdtrPmtActvtnReq"`
+}
+
+// CreditorPaymentActivationRequest is the CdtrPmtActvtnReq message of a pain.013 document
+type CreditorPaymentActivationRequest struct {
+	GroupHeader        ActivationGroupHeader          `xml:"GrpHdr"`
+	PaymentInformation []ActivationPaymentInformation `xml:"PmtInf"`
+}
+
+// ActivationGroupHeader is the GrpHdr of a pain.013 document
+type ActivationGroupHeader struct {
+	// MessageID is the Fedwire IMAD of the request
+	MessageID            string `xml:"MsgId"`
+	CreationDateTime     string `xml:"CreDtTm"`
+	NumberOfTransactions string `xml:"NbOfTxs"`
+	// InitiatingParty is the {5000} Originator of the request
+	InitiatingParty PartyIdentification `xml:"InitgPty"`
+}
+
+// ActivationPaymentInformation is the PmtInf of a pain.013 document, the debtor side of the requested
+// transfers
+type ActivationPaymentInformation struct {
+	PaymentInformationID   string                                      `xml:"PmtInfId"`
+	PaymentMethod          string                                      `xml:"PmtMtd"`
+	PaymentTypeInformation *PaymentTypeInformation                     `xml:"PmtTpInf,omitempty"`
+	RequestedExecutionDate DateAndDateTime                             `xml:"ReqdExctnDt"`
+	Debtor                 PartyIdentification                         `xml:"Dbtr"`
+	DebtorAccount          *CashAccount                                `xml:"DbtrAcct,omitempty"`
+	DebtorAgent            BranchAndFinancialInstitutionIdentification `xml:"DbtrAgt"`
+	CreditTransfers        []ActivationCreditTransfer                  `xml:"CdtTrfTx"`
+
+	// Unmapped holds the elements read from a document which have no Fedwire equivalent
+	Unmapped []UnmappedElement `xml:",any"`
+}
+
+// ActivationCreditTransfer is the CdtTrfTx of a pain.013 document, a transfer the debtor is requested to make
+type ActivationCreditTransfer struct {
+	PaymentIdentification PaymentIdentification                       `xml:"PmtId"`
+	Amount                AmountType                                  `xml:"Amt"`
+	ChargeBearer          string                                      `xml:"ChrgBr"`
+	CreditorAgent         BranchAndFinancialInstitutionIdentification `xml:"CdtrAgt"`
+	Creditor              PartyIdentification                         `xml:"Cdtr"`
+	CreditorAccount       *CashAccount                                `xml:"CdtrAcct,omitempty"`
+	RemittanceInformation *RemittanceInformation                      `xml:"RmtInf,omitempty"`
+
+	// Unmapped holds the elements read from a document which have no Fedwire equivalent
+	Unmapped []UnmappedElement `xml:",any"`
+}
+
+// NewPain013 converts a drawdown request (DRC or DRB with sub type 31) into a pain.013 document.
+//
+// The receiver is the debtor agent and the sender, which is paid, the creditor agent. {4400}
+// AccountDebitedDrawdown becomes the debtor and its account, {4200} Beneficiary the creditor and {5400}
+// AccountCreditedDrawdown the creditor's account. {5000} Originator is the initiating party.
+func NewPain013(fwm *wire.FEDWireMessage) (*Pain013, []Warning, error) {
+	if err := requireTags(fwm); err != nil {
+		return nil, nil, err
+	}
+	var typeCode string
+	switch bfc := fwm.BusinessFunctionCode.BusinessFunctionCode; bfc {
+	case wire.CustomerCorporateDrawdownRequest:
+		typeCode = wire.FundsTransfer
+	case wire.BankDrawDownRequest:
+		typeCode = wire.SettlementTransfer
+	default:
+		return nil, nil, fmt.Errorf("%w: pain.013 carries drawdown requests, not %s", ErrUnsupportedMessage, bfc)
+	}
+	if sub := fwm.TypeSubType.SubTypeCode; sub != wire.RequestCredit {
+		return nil, nil, fmt.Errorf("%w: pain.013 carries drawdown requests, not sub type %s", ErrUnsupportedMessage, sub)
+	}
+
+	c := &converter{message: "pain.013"}
+	c.envelope(fwm)
+	c.unmapped(fwm.TypeSubType.TypeCode != typeCode, wire.TagTypeSubType, "TypeSubType.TypeCode")
+
+	amount, err := c.settlementAmount(fwm)
+	if err != nil {
+		return nil, nil, err
+	}
+	sender := fedAgent(fwm.SenderDepositoryInstitution.SenderABANumber, fwm.SenderDepositoryInstitution.SenderShortName)
+	receiver := fedAgent(fwm.ReceiverDepositoryInstitution.ReceiverABANumber, fwm.ReceiverDepositoryInstitution.ReceiverShortName)
+	hdr := c.groupHeader(fwm)
+	c.unmapped(fwm.PreviousMessageIdentifier != nil, wire.TagPreviousMessageIdentifier, "PreviousMessageIdentifier")
+
+	tx := ActivationCreditTransfer{
+		PaymentIdentification: c.paymentIdentification(fwm),
+		Amount:                AmountType{InstructedAmount: amount},
+		ChargeBearer:          ChargeBearerDebtor,
+		CreditorAgent:         *sender,
+		CreditorAccount:       accountCredited(fwm),
+		RemittanceInformation: drawdownRemittance(fwm),
+	}
+	if fwm.Beneficiary != nil {
+		tx.Creditor = c.identifiedParty(fwm.Beneficiary.Personal)
+	}
+
+	pmtInf := ActivationPaymentInformation{
+		PaymentInformationID:   hdr.MessageID,
+		PaymentMethod:          PaymentMethodTransfer,
+		PaymentTypeInformation: drawdownInstrument(fwm),
+		RequestedExecutionDate: DateAndDateTime{Date: settlementDate(fwm)},
+		DebtorAgent:            *receiver,
+		CreditTransfers:        []ActivationCreditTransfer{tx},
+	}
+	if debtor, acct := accountDebited(fwm); debtor != nil {
+		pmtInf.Debtor, pmtInf.DebtorAccount = *debtor, acct
+	}
+
+	doc := &Pain013{
+		ActivationRequest: CreditorPaymentActivationRequest{
+			GroupHeader: ActivationGroupHeader{
+				MessageID:            hdr.MessageID,
+				CreationDateTime:     hdr.CreationDateTime,
+				NumberOfTransactions: "1",
+			},
+			PaymentInformation: []ActivationPaymentInformation{pmtInf},
+		},
+	}
+	if fwm.Originator != nil {
+		doc.ActivationRequest.GroupHeader.InitiatingParty = c.identifiedParty(fwm.Originator.Personal)
+	}
+	c.drawdownTags(fwm)
+	c.unmapped(fwm.ServiceMessage != nil, wire.TagServiceMessage, "ServiceMessage")
+
+	return doc, c.warnings, nil
+}
+
+// FEDWireMessage converts the document's request into a drawdown request. It's a BankDrawDownRequest (DRB)
+// when the local instrument is DRBF and a CustomerCorporateDrawdownRequest (DRC) otherwise.
+func (doc *Pain013) FEDWireMessage() (*wire.FEDWireMessage, []Warning, error) {
+	req := doc.ActivationRequest
+	if len(req.PaymentInformation) != 1 || len(req.PaymentInformation[0].CreditTransfers) != 1 {
+		n := 0
+		for _, pmtInf := range req.PaymentInformation {
+			n += len(pmtInf.CreditTransfers)
+		}
+		return nil, nil, fmt.Errorf("%w: Fedwire messages carry one transaction, found %d", ErrUnsupportedMessage, n)
+	}
+	pmtInf := req.PaymentInformation[0]
+	tx := pmtInf.CreditTransfers[0]
+	path := "CdtrPmtActvtnReq/PmtInf"
+	txPath := path + "/CdtTrfTx"
+
+	c := &converter{message: "pain.013"}
+	fwm := c.newMessage(req.GroupHeader.MessageID, tx.PaymentIdentification.InstructionID, tx.PaymentIdentification.EndToEndID)
+	if id := tx.PaymentIdentification.UETR; id != "" && id != uetr(req.GroupHeader.MessageID) {
+		c.warnElement(txPath+"/PmtId/UETR", "no Fedwire equivalent")
+	}
+	if err := c.setAmount(fwm, txPath+"/Amt/InstdAmt", tx.Amount.InstructedAmount); err != nil {
+		return nil, nil, err
+	}
+	if err := c.setDepositoryInstitutions(fwm, txPath+"/CdtrAgt", &tx.CreditorAgent, path+"/DbtrAgt", &pmtInf.DebtorAgent); err != nil {
+		return nil, nil, err
+	}
+
+	fwm.TypeSubType.SubTypeCode = wire.RequestCredit
+	switch code := localInstrumentCode(pmtInf.PaymentTypeInformation); code {
+	case LocalInstrumentBankDrawdown:
+		fwm.BusinessFunctionCode.BusinessFunctionCode = wire.BankDrawDownRequest
+		fwm.TypeSubType.TypeCode = wire.SettlementTransfer
+	case "", LocalInstrumentCustomerDrawdown:
+		fwm.BusinessFunctionCode.BusinessFunctionCode = wire.CustomerCorporateDrawdownRequest
+	default:
+		fwm.BusinessFunctionCode.BusinessFunctionCode = wire.CustomerCorporateDrawdownRequest
+		c.warnElement(path+"/PmtTpInf/LclInstrm", "local instrument %s has no Fedwire business function code, written as a customer drawdown", code)
+	}
+	if date := pmtInf.RequestedExecutionDate.Date; date != "" && date != settlementDate(fwm) {
+		c.warnElement(path+"/ReqdExctnDt", "execution date %s differs from the IMAD cycle date", date)
+	}
+	if tx.ChargeBearer != "" && tx.ChargeBearer != ChargeBearerDebtor {
+		c.warnElement(txPath+"/ChrgBr", "charge bearer %s has no Fedwire equivalent", tx.ChargeBearer)
+	}
+
+	c.setBeneficiary(fwm, &tx.Creditor)
+	c.setAccountDebited(fwm, &pmtInf.Debtor, pmtInf.DebtorAccount)
+	c.setAccountCredited(fwm, tx.CreditorAccount)
+	c.setOriginator(fwm, &req.GroupHeader.InitiatingParty)
+	c.setDrawdownRemittance(fwm, txPath+"/RmtInf", tx.RemittanceInformation)
+
+	c.unmappedElements(path, pmtInf.Unmapped)
+	c.unmappedElements(txPath, tx.Unmapped)
+
+	return fwm, c.warnings, nil
+}
@@ -0,0 +1,151 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package iso20022
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/moov-io/wire"
+	"github.com/stretchr/testify/require"
+)
+
+// pain013RoundTrip converts fwm into a pain.013 document, writes and reads the document and converts it back
+func pain013RoundTrip(t *testing.T, fwm *wire.FEDWireMessage) (*Pain013, []Warning) {
+	t.Helper()
+
+	doc, warnings, err := NewPain013(fwm)
+	require.NoError(t, err)
+
+	bs, err := xml.MarshalIndent(doc, "", "  ")
+	require.NoError(t, err)
+
+	var read Pain013
+	require.NoError(t, xml.Unmarshal(bs, &read))
+
+	got, back, err := read.FEDWireMessage()
+	require.NoError(t, err)
+	requireRoundTrip(t, fwm, got, warnings, back)
+
+	return doc, warnings
+}
+
+func TestPain013_customerDrawdown(t *testing.T) {
+	fwm := readMessage(t, "fedWireMessage-CustomerCorporateDrawDownRequest.txt")
+
+	doc, warnings := pain013RoundTrip(t, fwm)
+
+	req := doc.ActivationRequest
+	require.Equal(t, "20190410Source08000001", req.GroupHeader.MessageID)
+	require.Equal(t, "1", req.GroupHeader.NumberOfTransactions)
+	require.Equal(t, "Name", req.GroupHeader.InitiatingParty.Name)
+
+	pmtInf := req.PaymentInformation[0]
+	require.Equal(t, "20190410Source08000001", pmtInf.PaymentInformationID)
+	require.Equal(t, PaymentMethodTransfer, pmtInf.PaymentMethod)
+	require.Equal(t, LocalInstrumentCustomerDrawdown, pmtInf.PaymentTypeInformation.LocalInstrument.Proprietary)
+	require.Equal(t, "2019-04-10", pmtInf.RequestedExecutionDate.Date)
+	require.Equal(t, "debitDD Name", pmtInf.Debtor.Name)
+	require.Equal(t, "123456789", pmtInf.DebtorAccount.Identification.Other.Identification)
+	require.Equal(t, "231380104", pmtInf.DebtorAgent.FinancialInstitutionIdentification.ClearingSystemMemberIdentification.MemberIdentification)
+
+	tx := pmtInf.CreditTransfers[0]
+	require.Equal(t, "Sender Reference", tx.PaymentIdentification.InstructionID)
+	require.Equal(t, "Reference", tx.PaymentIdentification.EndToEndID)
+	require.Equal(t, "12345.67", tx.Amount.InstructedAmount.Value)
+	require.Equal(t, ChargeBearerDebtor, tx.ChargeBearer)
+	require.Equal(t, "121042882", tx.CreditorAgent.FinancialInstitutionIdentification.ClearingSystemMemberIdentification.MemberIdentification)
+	require.Equal(t, "Name", tx.Creditor.Name)
+	require.Equal(t, "123456789", tx.CreditorAccount.Identification.Other.Identification)
+	require.Equal(t, []string{"LineOne", "LineTwo", "LineThree", "LineFour"}, tx.RemittanceInformation.Unstructured)
+
+	require.Contains(t, warnings, Warning{Tag: wire.TagPreviousMessageIdentifier, Field: "PreviousMessageIdentifier", Reason: "no pain.013 equivalent"})
+	require.Contains(t, warnings, Warning{Tag: wire.TagBeneficiaryFI, Field: "BeneficiaryFI", Reason: "no pain.013 equivalent"})
+	for _, w := range warnings {
+		require.NotEqual(t, wire.TagAccountDebitedDrawdown, w.Tag, w.String())
+		require.NotEqual(t, wire.TagAccountCreditedDrawdown, w.Tag, w.String())
+	}
+
+	bs, err := xml.Marshal(doc)
+	require.NoError(t, err)
+	require.Contains(t, string(bs), `<Document xmlns="`+Pain013Namespace+`"><CdtrPmtActvtnReq><GrpHdr>`)
+}
+
+func TestPain013_bankDrawdown(t *testing.T) {
+	fwm := readMessage(t, "fedWireMessage-BankDrawDownRequest.txt")
+
+	doc, _ := pain013RoundTrip(t, fwm)
+
+	pmtInf := doc.ActivationRequest.PaymentInformation[0]
+	require.Equal(t, LocalInstrumentBankDrawdown, pmtInf.PaymentTypeInformation.LocalInstrument.Proprietary)
+	require.Equal(t, "debitDD Name", pmtInf.Debtor.Name)
+}
+
+func TestPain013_unsupported(t *testing.T) {
+	_, _, err := NewPain013(readMessage(t, "fedWireMessage-DrawdownResponse.txt"))
+	require.ErrorIs(t, err, ErrUnsupportedMessage)
+
+	fwm := readMessage(t, "fedWireMessage-CustomerCorporateDrawDownRequest.txt")
+	fwm.TypeSubType.SubTypeCode = wire.RefusalRequestCredit
+	_, _, err = NewPain013(fwm)
+	require.ErrorIs(t, err, ErrUnsupportedMessage)
+}
+
+func TestPain013_read(t *testing.T) {
+	input := `<?xml version="1.0" encoding="UTF-8"?>
+<Document xmlns="urn:iso:std:iso:20022:tech:xsd:pain.013.001.07">
+  <CdtrPmtActvtnReq>
+    <GrpHdr>
+      <MsgId>20240103MMQFMP9T000042</MsgId>
+      <CreDtTm>2024-01-03T10:00:00-05:00</CreDtTm>
+      <NbOfTxs>1</NbOfTxs>
+      <InitgPty><Nm>Corporate Treasury</Nm></InitgPty>
+    </GrpHdr>
+    <PmtInf>
+      <PmtInfId>20240103MMQFMP9T000042</PmtInfId>
+      <PmtMtd>TRF</PmtMtd>
+      <PmtTpInf><LclInstrm><Prtry>DRCF</Prtry></LclInstrm></PmtTpInf>
+      <ReqdExctnDt><Dt>2024-01-04</Dt></ReqdExctnDt>
+      <Dbtr><Nm>Subsidiary Inc</Nm></Dbtr>
+      <DbtrAcct><Id><Othr><Id>5551234</Id></Othr></Id></DbtrAcct>
+      <DbtrAgt><FinInstnId><ClrSysMmbId><ClrSysId><Cd>USABA</Cd></ClrSysId><MmbId>231380104</MmbId></ClrSysMmbId></FinInstnId></DbtrAgt>
+      <CdtTrfTx>
+        <PmtId><EndToEndId>NOTPROVIDED</EndToEndId></PmtId>
+        <Amt><InstdAmt Ccy="USD">1000000.00</InstdAmt></Amt>
+        <ChrgBr>SHAR</ChrgBr>
+        <CdtrAgt><FinInstnId><ClrSysMmbId><ClrSysId><Cd>USABA</Cd></ClrSysId><MmbId>121042882</MmbId></ClrSysMmbId></FinInstnId></CdtrAgt>
+        <Cdtr><Nm>Corporate Treasury</Nm></Cdtr>
+        <CdtrAcct><Id><Othr><Id>987654321</Id></Othr></Id></CdtrAcct>
+      </CdtTrfTx>
+    </PmtInf>
+  </CdtrPmtActvtnReq>
+</Document>`
+
+	var doc Pain013
+	require.NoError(t, xml.Unmarshal([]byte(input), &doc))
+
+	fwm, warnings, err := doc.FEDWireMessage()
+	require.NoError(t, err)
+
+	require.Equal(t, wire.CustomerCorporateDrawdownRequest, fwm.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, wire.RequestCredit, fwm.TypeSubType.SubTypeCode)
+	require.Equal(t, "000100000000", fwm.Amount.Amount)
+	require.Equal(t, "121042882", fwm.SenderDepositoryInstitution.SenderABANumber)
+	require.Equal(t, "231380104", fwm.ReceiverDepositoryInstitution.ReceiverABANumber)
+	require.Equal(t, "5551234", fwm.AccountDebitedDrawdown.Identifier)
+	require.Equal(t, "Subsidiary Inc", fwm.AccountDebitedDrawdown.Name)
+	require.Equal(t, "987654321", fwm.AccountCreditedDrawdown.DrawdownCreditAccountNumber)
+	require.Equal(t, "Corporate Treasury", fwm.Beneficiary.Personal.Name)
+	require.Equal(t, "Corporate Treasury", fwm.Originator.Personal.Name)
+
+	require.Equal(t, []Warning{
+		{Element: "CdtrPmtActvtnReq/PmtInf/ReqdExctnDt", Reason: "execution date 2024-01-04 differs from the IMAD cycle date"},
+		{Element: "CdtrPmtActvtnReq/PmtInf/CdtTrfTx/ChrgBr", Reason: "charge bearer SHAR has no Fedwire equivalent"},
+	}, warnings)
+
+	file := wire.NewFile()
+	file.AddFEDWireMessage(*fwm)
+	require.NoError(t, file.Validate())
+}
@@ -0,0 +1,288 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package iso20022
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	"github.com/moov-io/wire"
+)
+
+const (
+	// Pain014Namespace is the XML namespace of pain.014.001.07 documents
+	Pain014Namespace = "urn:iso:std:iso:20022:tech:xsd:pain.014.001.07"
+
+	// TransactionStatusAccepted is the status of a drawdown request which was honored (sub type 32)
+	TransactionStatusAccepted = "ACCP"
+	// TransactionStatusRejected is the status of a drawdown request which was refused (sub type 33)
+	TransactionStatusRejected = "RJCT"
+
+	// pain013MessageName is the message name id of the requests pain.014 documents report on
+	pain013MessageName = "pain.013.001.07"
+)
+
+// Pain014 is a pain.014.001.07 CreditorPaymentActivationRequestStatusReport document, the ISO 20022 equivalent
+// of the reply to a drawdown request: a DrawdownResponse (DRW) payment honoring it (sub type 32) or its refusal
+// (sub type 33).
+type Pain014 struct {
+	XMLName      xml.Name                                     `xml:"urn:iso:std:iso:20022:tech:xsd:pain.014.001.07 Document"`
+	StatusReport CreditorPaymentActivationRequestStatusReport `xml:"CdtrPmtActvtnReqStsRpt"`
+}
+
+// CreditorPaymentActivationRequestStatusReport is the CdtrPmtActvtnReqStsRpt message of a pain.014 document
+type CreditorPaymentActivationRequestStatusReport struct {
+	GroupHeader                StatusReportGroupHeader            `xml:"GrpHdr"`
+	OriginalGroupInformation   OriginalGroupInformation           `xml:"OrgnlGrpInfAndSts"`
+	OriginalPaymentInformation []OriginalPaymentInformationStatus `xml:"OrgnlPmtInfAndSts"`
+}
+
+// StatusReportGroupHeader is the GrpHdr of a pain.014 document. The debtor agent sends the report to the
+// creditor agent.
+type StatusReportGroupHeader struct {
+	// MessageID is the Fedwire IMAD of the reply
+	MessageID        string `xml:"MsgId"`
+	CreationDateTime string `xml:"CreDtTm"`
+	// InitiatingParty is the {5000} Originator of the reply
+	InitiatingParty *PartyIdentification                         `xml:"InitgPty,omitempty"`
+	DebtorAgent     *BranchAndFinancialInstitutionIdentification `xml:"DbtrAgt,omitempty"`
+	CreditorAgent   *BranchAndFinancialInstitutionIdentification `xml:"CdtrAgt,omitempty"`
+}
+
+// OriginalGroupInformation identifies the pain.013 request a pain.014 document reports on
+type OriginalGroupInformation struct {
+	OriginalMessageID     string `xml:"OrgnlMsgId"`
+	OriginalMessageNameID string `xml:"OrgnlMsgNmId"`
+}
+
+// OriginalPaymentInformationStatus is the OrgnlPmtInfAndSts of a pain.014 document
+type OriginalPaymentInformationStatus struct {
+	OriginalPaymentInformationID string                     `xml:"OrgnlPmtInfId"`
+	Transactions                 []PaymentTransactionStatus `xml:"TxInfAndSts"`
+}
+
+// PaymentTransactionStatus is the TxInfAndSts of a pain.014 document
+type PaymentTransactionStatus struct {
+	StatusID                     string                          `xml:"StsId,omitempty"`
+	OriginalInstructionID        string                          `xml:"OrgnlInstrId,omitempty"`
+	OriginalEndToEndID           string                          `xml:"OrgnlEndToEndId,omitempty"`
+	OriginalUETR                 string                          `xml:"OrgnlUETR,omitempty"`
+	TransactionStatus            string                          `xml:"TxSts,omitempty"`
+	StatusReasonInformation      []ReasonInformation             `xml:"StsRsnInf,omitempty"`
+	OriginalTransactionReference *ActivationTransactionReference `xml:"OrgnlTxRef,omitempty"`
+
+	// Unmapped holds the elements read from a document which have no Fedwire equivalent
+	Unmapped []UnmappedElement `xml:",any"`
+}
+
+// ActivationTransactionReference is the OrgnlTxRef of a pain.014 document, the transfer which was requested
+type ActivationTransactionReference struct {
+	Amount                 *AmountType                                  `xml:"Amt,omitempty"`
+	RequestedExecutionDate *DateAndDateTime                             `xml:"ReqdExctnDt,omitempty"`
+	PaymentTypeInformation *PaymentTypeInformation                      `xml:"PmtTpInf,omitempty"`
+	RemittanceInformation  *RemittanceInformation                       `xml:"RmtInf,omitempty"`
+	Debtor                 *PartyIdentification                         `xml:"Dbtr,omitempty"`
+	DebtorAccount          *CashAccount                                 `xml:"DbtrAcct,omitempty"`
+	DebtorAgent            *BranchAndFinancialInstitutionIdentification `xml:"DbtrAgt,omitempty"`
+	CreditorAgent          *BranchAndFinancialInstitutionIdentification `xml:"CdtrAgt,omitempty"`
+	Creditor               *PartyIdentification                         `xml:"Cdtr,omitempty"`
+	CreditorAccount        *CashAccount                                 `xml:"CdtrAcct,omitempty"`
+
+	// Unmapped holds the elements read from a document which have no Fedwire equivalent
+	Unmapped []UnmappedElement `xml:",any"`
+}
+
+// NewPain014 converts the reply to a drawdown request into a pain.014 document. A DrawdownResponse (DRW, sub
+// type 32) is reported as accepted and a refusal (DRC, DRB or SVC with sub type 33) as rejected.
+//
+// {3500} PreviousMessageIdentifier is the IMAD of the request, which is the message and payment information id
+// of its pain.013 document. The sender is the debtor agent and the receiver the creditor agent. {9000}
+// ServiceMessage becomes the status reason and the other tags map as they do in NewPain013.
+func NewPain014(fwm *wire.FEDWireMessage) (*Pain014, []Warning, error) {
+	if err := requireTags(fwm); err != nil {
+		return nil, nil, err
+	}
+	bfc, sub := fwm.BusinessFunctionCode.BusinessFunctionCode, fwm.TypeSubType.SubTypeCode
+	var status, typeCode string
+	switch {
+	case bfc == wire.DrawdownResponse && sub == wire.FundsTransferRequestCredit:
+		status, typeCode = TransactionStatusAccepted, fwm.TypeSubType.TypeCode
+	case bfc == wire.CustomerCorporateDrawdownRequest && sub == wire.RefusalRequestCredit,
+		bfc == wire.BFCServiceMessage && sub == wire.RefusalRequestCredit:
+		status, typeCode = TransactionStatusRejected, wire.FundsTransfer
+	case bfc == wire.BankDrawDownRequest && sub == wire.RefusalRequestCredit:
+		status, typeCode = TransactionStatusRejected, wire.SettlementTransfer
+	default:
+		return nil, nil, fmt.Errorf("%w: pain.014 carries replies to drawdown requests, not %s sub type %s", ErrUnsupportedMessage, bfc, sub)
+	}
+	if fwm.PreviousMessageIdentifier == nil {
+		return nil, nil, missingTag(wire.TagPreviousMessageIdentifier, "PreviousMessageIdentifier")
+	}
+
+	c := &converter{message: "pain.014"}
+	c.envelope(fwm)
+	c.unmapped(fwm.TypeSubType.TypeCode != typeCode, wire.TagTypeSubType, "TypeSubType.TypeCode")
+
+	amount, err := c.settlementAmount(fwm)
+	if err != nil {
+		return nil, nil, err
+	}
+	sender := fedAgent(fwm.SenderDepositoryInstitution.SenderABANumber, fwm.SenderDepositoryInstitution.SenderShortName)
+	receiver := fedAgent(fwm.ReceiverDepositoryInstitution.ReceiverABANumber, fwm.ReceiverDepositoryInstitution.ReceiverShortName)
+	hdr := c.groupHeader(fwm)
+	originalID, originalUETR, originalDate := originalReference(fwm)
+
+	pmtID := c.paymentIdentification(fwm)
+	tx := PaymentTransactionStatus{
+		StatusID:           pmtID.InstructionID,
+		OriginalEndToEndID: pmtID.EndToEndID,
+		OriginalUETR:       originalUETR,
+		TransactionStatus:  status,
+	}
+	if sm := fwm.ServiceMessage; sm != nil {
+		lines := trimLines(sm.LineOne, sm.LineTwo, sm.LineThree, sm.LineFour, sm.LineFive, sm.LineSix,
+			sm.LineSeven, sm.LineEight, sm.LineNine, sm.LineTen, sm.LineEleven, sm.LineTwelve)
+		if len(lines) > 0 {
+			tx.StatusReasonInformation = []ReasonInformation{{AdditionalInformation: lines}}
+		}
+	}
+
+	ref := &ActivationTransactionReference{
+		Amount:                &AmountType{InstructedAmount: amount},
+		RemittanceInformation: drawdownRemittance(fwm),
+		CreditorAccount:       accountCredited(fwm),
+	}
+	if originalDate != "" {
+		ref.RequestedExecutionDate = &DateAndDateTime{Date: originalDate}
+	}
+	if bfc != wire.BFCServiceMessage {
+		ref.PaymentTypeInformation = drawdownInstrument(fwm)
+	}
+	ref.Debtor, ref.DebtorAccount = accountDebited(fwm)
+	if fwm.Beneficiary != nil {
+		party := c.identifiedParty(fwm.Beneficiary.Personal)
+		ref.Creditor = &party
+	}
+	tx.OriginalTransactionReference = ref
+
+	doc := &Pain014{
+		StatusReport: CreditorPaymentActivationRequestStatusReport{
+			GroupHeader: StatusReportGroupHeader{
+				MessageID:        hdr.MessageID,
+				CreationDateTime: hdr.CreationDateTime,
+				DebtorAgent:      sender,
+				CreditorAgent:    receiver,
+			},
+			OriginalGroupInformation: OriginalGroupInformation{
+				OriginalMessageID:     originalID,
+				OriginalMessageNameID: pain013MessageName,
+			},
+			OriginalPaymentInformation: []OriginalPaymentInformationStatus{{
+				OriginalPaymentInformationID: originalID,
+				Transactions:                 []PaymentTransactionStatus{tx},
+			}},
+		},
+	}
+	if fwm.Originator != nil {
+		party := c.identifiedParty(fwm.Originator.Personal)
+		doc.StatusReport.GroupHeader.InitiatingParty = &party
+	}
+	c.drawdownTags(fwm)
+
+	return doc, c.warnings, nil
+}
+
+// FEDWireMessage converts the document's status into the reply to a drawdown request. An accepted request is
+// a DrawdownResponse (DRW, sub type 32). A rejected request is a refusal (sub type 33) with the business function
+// code of the request's local instrument, or a ServiceMessage without one.
+func (doc *Pain014) FEDWireMessage() (*wire.FEDWireMessage, []Warning, error) {
+	rpt := doc.StatusReport
+	var txs []PaymentTransactionStatus
+	for _, pmtInf := range rpt.OriginalPaymentInformation {
+		txs = append(txs, pmtInf.Transactions...)
+	}
+	if len(txs) != 1 {
+		return nil, nil, fmt.Errorf("%w: Fedwire messages carry one transaction, found %d", ErrUnsupportedMessage, len(txs))
+	}
+	tx := txs[0]
+	path := "CdtrPmtActvtnReqStsRpt/OrgnlPmtInfAndSts"
+	txPath := path + "/TxInfAndSts"
+	ref := tx.OriginalTransactionReference
+	if ref == nil || ref.Amount == nil {
+		return nil, nil, missingElement(txPath + "/OrgnlTxRef/Amt/InstdAmt")
+	}
+
+	c := &converter{message: "pain.014"}
+	fwm := c.newMessage(rpt.GroupHeader.MessageID, tx.StatusID, tx.OriginalEndToEndID)
+	if err := c.setAmount(fwm, txPath+"/OrgnlTxRef/Amt/InstdAmt", ref.Amount.InstructedAmount); err != nil {
+		return nil, nil, err
+	}
+	if err := c.setDepositoryInstitutions(fwm, "CdtrPmtActvtnReqStsRpt/GrpHdr/DbtrAgt", rpt.GroupHeader.DebtorAgent,
+		"CdtrPmtActvtnReqStsRpt/GrpHdr/CdtrAgt", rpt.GroupHeader.CreditorAgent); err != nil {
+		return nil, nil, err
+	}
+	idPath, originalID := path+"/OrgnlPmtInfId", rpt.OriginalPaymentInformation[0].OriginalPaymentInformationID
+	if originalID == "" {
+		idPath, originalID = "CdtrPmtActvtnReqStsRpt/OrgnlGrpInfAndSts/OrgnlMsgId", rpt.OriginalGroupInformation.OriginalMessageID
+	}
+	if err := c.setOriginalReference(fwm, idPath, originalID, txPath+"/OrgnlUETR", tx.OriginalUETR); err != nil {
+		return nil, nil, err
+	}
+	if tx.OriginalInstructionID != "" {
+		c.warnElement(txPath+"/OrgnlInstrId", "no Fedwire equivalent")
+	}
+
+	code := localInstrumentCode(ref.PaymentTypeInformation)
+	switch tx.TransactionStatus {
+	case TransactionStatusAccepted:
+		fwm.BusinessFunctionCode.BusinessFunctionCode = wire.DrawdownResponse
+		fwm.TypeSubType.SubTypeCode = wire.FundsTransferRequestCredit
+	case TransactionStatusRejected:
+		fwm.BusinessFunctionCode.BusinessFunctionCode = wire.BFCServiceMessage
+		fwm.TypeSubType.SubTypeCode = wire.RefusalRequestCredit
+		switch code {
+		case LocalInstrumentCustomerDrawdown:
+			fwm.BusinessFunctionCode.BusinessFunctionCode = wire.CustomerCorporateDrawdownRequest
+		case LocalInstrumentBankDrawdown:
+			fwm.BusinessFunctionCode.BusinessFunctionCode = wire.BankDrawDownRequest
+		}
+	default:
+		return nil, nil, fmt.Errorf("%w: transaction status %q is neither accepted nor rejected", ErrUnsupportedMessage, tx.TransactionStatus)
+	}
+	switch code {
+	case "", LocalInstrumentCustomerDrawdown:
+	case LocalInstrumentBankDrawdown:
+		fwm.TypeSubType.TypeCode = wire.SettlementTransfer
+	default:
+		c.warnElement(txPath+"/OrgnlTxRef/PmtTpInf/LclInstrm", "local instrument %s has no Fedwire business function code", code)
+	}
+
+	if lines := c.reasonLines(txPath+"/StsRsnInf", tx.StatusReasonInformation); len(lines) > 0 {
+		if fwm.BusinessFunctionCode.BusinessFunctionCode == wire.BFCServiceMessage {
+			lines = c.lines(wire.TagServiceMessage, "ServiceMessage", lines, 12, 35)
+			sm := wire.NewServiceMessage()
+			sm.LineOne, sm.LineTwo, sm.LineThree, sm.LineFour = lines[0], lines[1], lines[2], lines[3]
+			sm.LineFive, sm.LineSix, sm.LineSeven, sm.LineEight = lines[4], lines[5], lines[6], lines[7]
+			sm.LineNine, sm.LineTen, sm.LineEleven, sm.LineTwelve = lines[8], lines[9], lines[10], lines[11]
+			fwm.ServiceMessage = sm
+		} else {
+			c.warnElement(txPath+"/StsRsnInf", "only service messages carry a status reason")
+		}
+	}
+
+	if ref.DebtorAgent != nil || ref.CreditorAgent != nil {
+		c.warnElement(txPath+"/OrgnlTxRef", "the debtor and creditor agents are the sender and receiver")
+	}
+	c.setBeneficiary(fwm, ref.Creditor)
+	c.setAccountDebited(fwm, ref.Debtor, ref.DebtorAccount)
+	c.setAccountCredited(fwm, ref.CreditorAccount)
+	c.setOriginator(fwm, rpt.GroupHeader.InitiatingParty)
+	c.setDrawdownRemittance(fwm, txPath+"/OrgnlTxRef/RmtInf", ref.RemittanceInformation)
+
+	c.unmappedElements(txPath+"/OrgnlTxRef", ref.Unmapped)
+	c.unmappedElements(txPath, tx.Unmapped)
+
+	return fwm, c.warnings, nil
+}
@@ -0,0 +1,183 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package iso20022
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/moov-io/wire"
+	"github.com/stretchr/testify/require"
+)
+
+// pain014RoundTrip converts fwm into a pain.014 document, writes and reads the document and converts it back
+func pain014RoundTrip(t *testing.T, fwm *wire.FEDWireMessage) (*Pain014, []Warning) {
+	t.Helper()
+
+	doc, warnings, err := NewPain014(fwm)
+	require.NoError(t, err)
+
+	bs, err := xml.MarshalIndent(doc, "", "  ")
+	require.NoError(t, err)
+
+	var read Pain014
+	require.NoError(t, xml.Unmarshal(bs, &read))
+
+	got, back, err := read.FEDWireMessage()
+	require.NoError(t, err)
+	requireRoundTrip(t, fwm, got, warnings, back)
+
+	return doc, warnings
+}
+
+func TestPain014_drawdownResponse(t *testing.T) {
+	fwm := readMessage(t, "fedWireMessage-DrawdownResponse.txt")
+
+	doc, warnings := pain014RoundTrip(t, fwm)
+
+	rpt := doc.StatusReport
+	require.Equal(t, "20190410Source08000001", rpt.GroupHeader.MessageID)
+	require.Equal(t, "Name", rpt.GroupHeader.InitiatingParty.Name)
+	require.Equal(t, "121042882", rpt.GroupHeader.DebtorAgent.FinancialInstitutionIdentification.ClearingSystemMemberIdentification.MemberIdentification)
+	require.Equal(t, "231380104", rpt.GroupHeader.CreditorAgent.FinancialInstitutionIdentification.ClearingSystemMemberIdentification.MemberIdentification)
+	require.Equal(t, "Previous Message Ident", rpt.OriginalGroupInformation.OriginalMessageID)
+	require.Equal(t, "pain.013.001.07", rpt.OriginalGroupInformation.OriginalMessageNameID)
+	require.Equal(t, "Previous Message Ident", rpt.OriginalPaymentInformation[0].OriginalPaymentInformationID)
+
+	tx := rpt.OriginalPaymentInformation[0].Transactions[0]
+	require.Equal(t, TransactionStatusAccepted, tx.TransactionStatus)
+	require.Equal(t, "Sender Reference", tx.StatusID)
+	require.Equal(t, "Reference", tx.OriginalEndToEndID)
+	require.Empty(t, tx.StatusReasonInformation)
+
+	ref := tx.OriginalTransactionReference
+	require.Equal(t, "12345.67", ref.Amount.InstructedAmount.Value)
+	require.Equal(t, LocalInstrumentCustomerDrawdown, ref.PaymentTypeInformation.LocalInstrument.Proprietary)
+	require.Equal(t, "Name", ref.Creditor.Name)
+	require.Nil(t, ref.Debtor)
+	require.Equal(t, []string{"LineOne", "LineTwo", "LineThree", "LineFour"}, ref.RemittanceInformation.Unstructured)
+
+	for _, w := range warnings {
+		require.NotEqual(t, wire.TagPreviousMessageIdentifier, w.Tag, w.String())
+		require.NotEqual(t, wire.TagBusinessFunctionCode, w.Tag, w.String())
+	}
+
+	bs, err := xml.Marshal(doc)
+	require.NoError(t, err)
+	require.Contains(t, string(bs), `<Document xmlns="`+Pain014Namespace+`"><CdtrPmtActvtnReqStsRpt><GrpHdr>`)
+}
+
+func TestPain014_refusal(t *testing.T) {
+	fwm := readMessage(t, "fedWireMessage-BankDrawDownRequest.txt")
+	fwm.TypeSubType.SubTypeCode = wire.RefusalRequestCredit
+
+	doc, _ := pain014RoundTrip(t, fwm)
+
+	tx := doc.StatusReport.OriginalPaymentInformation[0].Transactions[0]
+	require.Equal(t, TransactionStatusRejected, tx.TransactionStatus)
+	require.Equal(t, LocalInstrumentBankDrawdown, tx.OriginalTransactionReference.PaymentTypeInformation.LocalInstrument.Proprietary)
+	require.Equal(t, "debitDD Name", tx.OriginalTransactionReference.Debtor.Name)
+	require.Equal(t, "123456789", tx.OriginalTransactionReference.CreditorAccount.Identification.Other.Identification)
+}
+
+func TestPain014_serviceMessage(t *testing.T) {
+	fwm := readMessage(t, "fedWireMessage-ServiceMessage.txt")
+	fwm.TypeSubType.SubTypeCode = wire.RefusalRequestCredit
+
+	doc, warnings := pain014RoundTrip(t, fwm)
+
+	tx := doc.StatusReport.OriginalPaymentInformation[0].Transactions[0]
+	require.Equal(t, TransactionStatusRejected, tx.TransactionStatus)
+	require.Nil(t, tx.OriginalTransactionReference.PaymentTypeInformation)
+	require.Len(t, tx.StatusReasonInformation[0].AdditionalInformation, 12)
+	for _, w := range warnings {
+		require.NotEqual(t, wire.TagServiceMessage, w.Tag, w.String())
+	}
+}
+
+func TestPain014_request(t *testing.T) {
+	request := readMessage(t, "fedWireMessage-CustomerCorporateDrawDownRequest.txt")
+	req, _, err := NewPain013(request)
+	require.NoError(t, err)
+
+	reply := readMessage(t, "fedWireMessage-DrawdownResponse.txt")
+	reply.InputMessageAccountabilityData.InputSequenceNumber = "000002"
+	reply.PreviousMessageIdentifier.PreviousMessageIdentifier = req.ActivationRequest.GroupHeader.MessageID
+	rpt, _, err := NewPain014(reply)
+	require.NoError(t, err)
+
+	// the reply reports on the request it refers to
+	require.Equal(t, req.ActivationRequest.GroupHeader.MessageID, rpt.StatusReport.OriginalGroupInformation.OriginalMessageID)
+	require.Equal(t, req.ActivationRequest.PaymentInformation[0].PaymentInformationID, rpt.StatusReport.OriginalPaymentInformation[0].OriginalPaymentInformationID)
+	tx := rpt.StatusReport.OriginalPaymentInformation[0].Transactions[0]
+	require.Equal(t, req.ActivationRequest.PaymentInformation[0].CreditTransfers[0].PaymentIdentification.UETR, tx.OriginalUETR)
+	require.Equal(t, "2019-04-10", tx.OriginalTransactionReference.RequestedExecutionDate.Date)
+}
+
+func TestPain014_unsupported(t *testing.T) {
+	_, _, err := NewPain014(readMessage(t, "fedWireMessage-CustomerCorporateDrawDownRequest.txt"))
+	require.ErrorIs(t, err, ErrUnsupportedMessage)
+
+	_, _, err = NewPain014(readMessage(t, "fedWireMessage-ServiceMessage.txt"))
+	require.ErrorIs(t, err, ErrUnsupportedMessage)
+
+	fwm := readMessage(t, "fedWireMessage-DrawdownResponse.txt")
+	fwm.PreviousMessageIdentifier = nil
+	_, _, err = NewPain014(fwm)
+	require.ErrorIs(t, err, ErrMissingTag)
+}
+
+func TestPain014_read(t *testing.T) {
+	input := `<?xml version="1.0" encoding="UTF-8"?>
+<Document xmlns="urn:iso:std:iso:20022:tech:xsd:pain.014.001.07">
+  <CdtrPmtActvtnReqStsRpt>
+    <GrpHdr>
+      <MsgId>20240103QMGFT015000007</MsgId>
+      <CreDtTm>2024-01-03T11:00:00-05:00</CreDtTm>
+      <DbtrAgt><FinInstnId><ClrSysMmbId><ClrSysId><Cd>USABA</Cd></ClrSysId><MmbId>231380104</MmbId></ClrSysMmbId></FinInstnId></DbtrAgt>
+      <CdtrAgt><FinInstnId><ClrSysMmbId><ClrSysId><Cd>USABA</Cd></ClrSysId><MmbId>121042882</MmbId></ClrSysMmbId></FinInstnId></CdtrAgt>
+    </GrpHdr>
+    <OrgnlGrpInfAndSts>
+      <OrgnlMsgId>20240103MMQFMP9T000042</OrgnlMsgId>
+      <OrgnlMsgNmId>pain.013.001.07</OrgnlMsgNmId>
+    </OrgnlGrpInfAndSts>
+    <OrgnlPmtInfAndSts>
+      <OrgnlPmtInfId>20240103MMQFMP9T000042</OrgnlPmtInfId>
+      <TxInfAndSts>
+        <OrgnlEndToEndId>NOTPROVIDED</OrgnlEndToEndId>
+        <TxSts>RJCT</TxSts>
+        <StsRsnInf>
+          <Rsn><Cd>AC04</Cd></Rsn>
+          <AddtlInf>Account closed</AddtlInf>
+        </StsRsnInf>
+        <OrgnlTxRef>
+          <Amt><InstdAmt Ccy="USD">1000000.00</InstdAmt></Amt>
+        </OrgnlTxRef>
+      </TxInfAndSts>
+    </OrgnlPmtInfAndSts>
+  </CdtrPmtActvtnReqStsRpt>
+</Document>`
+
+	var doc Pain014
+	require.NoError(t, xml.Unmarshal([]byte(input), &doc))
+
+	fwm, warnings, err := doc.FEDWireMessage()
+	require.NoError(t, err)
+
+	require.Equal(t, wire.BFCServiceMessage, fwm.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, wire.RefusalRequestCredit, fwm.TypeSubType.SubTypeCode)
+	require.Equal(t, "000100000000", fwm.Amount.Amount)
+	require.Equal(t, "231380104", fwm.SenderDepositoryInstitution.SenderABANumber)
+	require.Equal(t, "20240103MMQFMP9T000042", fwm.PreviousMessageIdentifier.PreviousMessageIdentifier)
+	require.Equal(t, "Account closed", fwm.ServiceMessage.LineOne)
+
+	require.Equal(t, []Warning{
+		{Element: "CdtrPmtActvtnReqStsRpt/OrgnlPmtInfAndSts/TxInfAndSts/StsRsnInf/Rsn", Reason: "reason AC04 has no Fedwire equivalent"},
+	}, warnings)
+
+	file := wire.NewFile()
+	file.AddFEDWireMessage(*fwm)
+	require.NoError(t, file.Validate())
+}
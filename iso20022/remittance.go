@@ -0,0 +1,445 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package iso20022
+
+import (
+	"strings"
+
+	"github.com/moov-io/wire"
+)
+
+// The structured remittance tags ({8250}-{8750}) were modeled after ISO 20022, so most of their fields map to
+// an element of the same length. {8300} RemittanceOriginator is the party paying the referred document, the
+// invoicee, and {8350} RemittanceBeneficiary is the invoicer.
+
+// remittanceInformation returns the RmtInf of fwm, or nil when it has no remittance tags.
+// {6000} OriginatorToBeneficiary becomes the unstructured remittance.
+func (c *converter) remittanceInformation(fwm *wire.FEDWireMessage) *RemittanceInformation {
+	rmtInf := &RemittanceInformation{}
+	if ob := fwm.OriginatorToBeneficiary; ob != nil {
+		rmtInf.Unstructured = trimLines(ob.LineOne, ob.LineTwo, ob.LineThree, ob.LineFour)
+	}
+	if strd := c.structuredRemittance(fwm); strd != nil {
+		rmtInf.Structured = append(rmtInf.Structured, *strd)
+	}
+	if len(rmtInf.Unstructured) == 0 && len(rmtInf.Structured) == 0 {
+		return nil
+	}
+	return rmtInf
+}
+
+func (c *converter) structuredRemittance(fwm *wire.FEDWireMessage) *StructuredRemittanceInformation {
+	strd := StructuredRemittanceInformation{}
+	empty := true
+
+	if prd := fwm.PrimaryRemittanceDocument; prd != nil || fwm.DateRemittanceDocument != nil {
+		doc := ReferredDocumentInformation{}
+		if prd != nil {
+			doc.Type = &DocumentType{
+				CodeOrProprietary: documentTypeCode(prd.DocumentTypeCode, prd.ProprietaryDocumentTypeCode),
+				Issuer:            prd.Issuer,
+			}
+			doc.Number = prd.DocumentIdentificationNumber
+		}
+		if drd := fwm.DateRemittanceDocument; drd != nil {
+			doc.RelatedDate = isoDateFromFed(drd.DateRemittanceDocument)
+		}
+		strd.ReferredDocumentInformation = []ReferredDocumentInformation{doc}
+		empty = false
+	}
+
+	amt := RemittanceAmount{}
+	if gross := fwm.GrossAmountRemittanceDocument; gross != nil {
+		amt.DuePayableAmount = remittanceAmount(gross.RemittanceAmount)
+	}
+	if discount := fwm.AmountNegotiatedDiscount; discount != nil {
+		amt.DiscountAppliedAmount = []DiscountAmountAndType{{Amount: *remittanceAmount(discount.RemittanceAmount)}}
+	}
+	if adj := fwm.Adjustment; adj != nil {
+		amt.AdjustmentAmountAndReason = []DocumentAdjustment{{
+			Amount:                *remittanceAmount(adj.RemittanceAmount),
+			CreditDebitIndicator:  adj.CreditDebitIndicator,
+			Reason:                adj.AdjustmentReasonCode,
+			AdditionalInformation: adj.AdditionalInfo,
+		}}
+	}
+	if paid := fwm.ActualAmountPaid; paid != nil {
+		amt.RemittedAmount = remittanceAmount(paid.RemittanceAmount)
+	}
+	if amt.DuePayableAmount != nil || amt.DiscountAppliedAmount != nil || amt.AdjustmentAmountAndReason != nil || amt.RemittedAmount != nil {
+		strd.ReferredDocumentAmount = &amt
+		empty = false
+	}
+
+	if srd := fwm.SecondaryRemittanceDocument; srd != nil {
+		strd.CreditorReferenceInformation = &CreditorReferenceInformation{
+			Type: &DocumentType{
+				CodeOrProprietary: documentTypeCode(srd.DocumentTypeCode, srd.ProprietaryDocumentTypeCode),
+				Issuer:            srd.Issuer,
+			},
+			Reference: srd.DocumentIdentificationNumber,
+		}
+		empty = false
+	}
+
+	if ro := fwm.RemittanceOriginator; ro != nil {
+		party := c.remittanceParty(wire.TagRemittanceOriginator, "RemittanceOriginator", ro.IdentificationType,
+			ro.IdentificationCode, ro.IdentificationNumber, ro.IdentificationNumberIssuer, ro.RemittanceData)
+		contact := Contact{
+			Name:         ro.ContactName,
+			PhoneNumber:  ro.ContactPhoneNumber,
+			MobileNumber: ro.ContactMobileNumber,
+			FaxNumber:    ro.ContactFaxNumber,
+			EmailAddress: ro.ContactElectronicAddress,
+		}
+		if ro.ContactOther != "" {
+			contact.Other = &OtherContact{ChannelType: "OTHR", Identification: ro.ContactOther}
+		}
+		if contact != (Contact{}) {
+			party.ContactDetails = &contact
+		}
+		strd.Invoicee = party
+		empty = false
+	}
+	if rb := fwm.RemittanceBeneficiary; rb != nil {
+		strd.Invoicer = c.remittanceParty(wire.TagRemittanceBeneficiary, "RemittanceBeneficiary", rb.IdentificationType,
+			rb.IdentificationCode, rb.IdentificationNumber, rb.IdentificationNumberIssuer, rb.RemittanceData)
+		empty = false
+	}
+
+	if rft := fwm.RemittanceFreeText; rft != nil {
+		strd.AdditionalRemittanceInformation = trimLines(rft.LineOne, rft.LineTwo, rft.LineThree)
+		empty = false
+	}
+
+	if empty {
+		return nil
+	}
+	return &strd
+}
+
+// relatedRemittance returns the RltdRmtInf of fwm's {8250}
+func (c *converter) relatedRemittance(fwm *wire.FEDWireMessage) []RemittanceLocation {
+	rr := fwm.RelatedRemittance
+	if rr == nil {
+		return nil
+	}
+	loc := RemittanceLocationData{
+		Method:            rr.RemittanceLocationMethod,
+		ElectronicAddress: rr.RemittanceLocationElectronicAddress,
+	}
+	if addr := remittanceAddress(rr.RemittanceData); rr.RemittanceData.Name != "" || addr != nil {
+		loc.PostalAddress = &NameAndAddress{Name: rr.RemittanceData.Name}
+		if addr != nil {
+			loc.PostalAddress.Address = *addr
+		}
+	}
+	c.unmapped(rr.RemittanceData.DateBirthPlace != "", wire.TagRelatedRemittance, "RelatedRemittance.RemittanceData.DateBirthPlace")
+	c.unmapped(rr.RemittanceData.CountryOfResidence != "", wire.TagRelatedRemittance, "RelatedRemittance.RemittanceData.CountryOfResidence")
+	return []RemittanceLocation{{
+		RemittanceIdentification:  rr.RemittanceIdentification,
+		RemittanceLocationDetails: []RemittanceLocationData{loc},
+	}}
+}
+
+// remittanceParty returns the invoicer or invoicee of a {8300} or {8350}
+func (c *converter) remittanceParty(tag, field, idType, idCode, idNumber, issuer string, rd wire.RemittanceData) *PartyIdentification {
+	party := &PartyIdentification{
+		Name:               rd.Name,
+		PostalAddress:      remittanceAddress(rd),
+		CountryOfResidence: rd.CountryOfResidence,
+	}
+	if idCode != "" {
+		other := []GenericIdentification{{
+			Identification: idNumber,
+			SchemeName:     remittanceScheme(idCode),
+			Issuer:         issuer,
+		}}
+		if idType == wire.PrivateID {
+			party.Identification = &Party{PrivateIdentification: &PersonIdentification{Other: other}}
+		} else {
+			party.Identification = &Party{OrganisationIdentification: &OrganisationIdentification{Other: other}}
+		}
+	}
+	c.unmapped(rd.DateBirthPlace != "", tag, field+".RemittanceData.DateBirthPlace")
+	return party
+}
+
+// remittanceScheme returns the scheme name of a remittance identification code. Fedwire codes which are not
+// ISO 20022 external codes are proprietary.
+func remittanceScheme(code string) *CodeOrProprietary {
+	switch code {
+	case wire.OICProprietaryIdentificationNumber, wire.OICSWIFTBICORBEI, wire.PICDateBirthPlace:
+		return &CodeOrProprietary{Proprietary: code}
+	}
+	return &CodeOrProprietary{Code: code}
+}
+
+// remittanceAddress returns the postal address of remittance data, or nil when it has none
+func remittanceAddress(rd wire.RemittanceData) *PostalAddress {
+	addr := &PostalAddress{
+		Department:         rd.Department,
+		SubDepartment:      rd.SubDepartment,
+		StreetName:         rd.StreetName,
+		BuildingNumber:     rd.BuildingNumber,
+		PostCode:           rd.PostCode,
+		TownName:           rd.TownName,
+		CountrySubDivision: rd.CountrySubDivisionState,
+		Country:            rd.Country,
+		AddressLines: trimLines(rd.AddressLineOne, rd.AddressLineTwo, rd.AddressLineThree, rd.AddressLineFour,
+			rd.AddressLineFive, rd.AddressLineSix, rd.AddressLineSeven),
+	}
+	if rd.AddressType != "" {
+		addr.AddressType = &CodeOrProprietary{Code: rd.AddressType}
+	}
+	if addr.AddressType == nil && len(addr.AddressLines) == 0 && len(addressLines(addr)) == 0 {
+		return nil
+	}
+	return addr
+}
+
+// remittanceData returns the remittance data of a name and postal address
+func (c *converter) remittanceData(tag, field, name string, addr *PostalAddress) wire.RemittanceData {
+	rd := wire.RemittanceData{Name: c.text(tag, field+".Name", name, 140)}
+	if addr == nil {
+		return rd
+	}
+	if addr.AddressType != nil {
+		rd.AddressType = schemeValue(addr.AddressType)
+	}
+	rd.Department = c.text(tag, field+".Department", addr.Department, 70)
+	rd.SubDepartment = c.text(tag, field+".SubDepartment", addr.SubDepartment, 70)
+	rd.StreetName = c.text(tag, field+".StreetName", addr.StreetName, 70)
+	rd.BuildingNumber = c.text(tag, field+".BuildingNumber", addr.BuildingNumber, 16)
+	rd.PostCode = c.text(tag, field+".PostCode", addr.PostCode, 16)
+	rd.TownName = c.text(tag, field+".TownName", addr.TownName, 35)
+	rd.CountrySubDivisionState = c.text(tag, field+".CountrySubDivisionState", addr.CountrySubDivision, 35)
+	rd.Country = c.text(tag, field+".Country", addr.Country, 2)
+	lines := c.lines(tag, field+".AddressLine", addr.AddressLines, 7, 70)
+	rd.AddressLineOne, rd.AddressLineTwo, rd.AddressLineThree = lines[0], lines[1], lines[2]
+	rd.AddressLineFour, rd.AddressLineFive, rd.AddressLineSix, rd.AddressLineSeven = lines[3], lines[4], lines[5], lines[6]
+	return rd
+}
+
+// remittanceIdentification is the identification of a {8300} or {8350} party
+type remittanceIdentification struct {
+	idType, idCode, idNumber, issuer string
+}
+
+// remittanceIdentity returns the identification of an invoicer or invoicee
+func (c *converter) remittanceIdentity(tag, field string, party *PartyIdentification) remittanceIdentification {
+	var id remittanceIdentification
+	if party.Identification == nil {
+		return id
+	}
+	var others []GenericIdentification
+	if org := party.Identification.OrganisationIdentification; org != nil {
+		id.idType = wire.OrganizationID
+		others = org.Other
+		if org.AnyBIC != "" {
+			others = append([]GenericIdentification{{
+				Identification: org.AnyBIC,
+				SchemeName:     &CodeOrProprietary{Proprietary: wire.OICSWIFTBICORBEI},
+			}}, others...)
+		}
+	}
+	if prvt := party.Identification.PrivateIdentification; prvt != nil && len(others) == 0 {
+		id.idType = wire.PrivateID
+		others = prvt.Other
+	}
+	if len(others) == 0 {
+		return remittanceIdentification{}
+	}
+	if len(others) > 1 {
+		c.warn(tag, field+".IdentificationNumber", "only the first of %d identifications fits", len(others))
+	}
+	id.idCode = c.text(tag, field+".IdentificationCode", schemeValue(others[0].SchemeName), 4)
+	id.idNumber = c.text(tag, field+".IdentificationNumber", others[0].Identification, 35)
+	id.issuer = c.text(tag, field+".IdentificationNumberIssuer", others[0].Issuer, 35)
+	return id
+}
+
+// documentTypeCode returns the type of a referred document. Proprietary types carry their own code.
+func documentTypeCode(code, proprietary string) CodeOrProprietary {
+	if code == wire.ProprietaryDocumentType {
+		return CodeOrProprietary{Proprietary: proprietary}
+	}
+	return CodeOrProprietary{Code: code}
+}
+
+// documentType returns the Fedwire document type code and proprietary code of a referred document type
+func documentType(tp *DocumentType) (string, string) {
+	if tp == nil {
+		return "", ""
+	}
+	if tp.CodeOrProprietary.Code == "" && tp.CodeOrProprietary.Proprietary != "" {
+		return wire.ProprietaryDocumentType, tp.CodeOrProprietary.Proprietary
+	}
+	return tp.CodeOrProprietary.Code, ""
+}
+
+// remittanceAmount returns the amount of a Fedwire remittance amount
+func remittanceAmount(amt wire.RemittanceAmount) *ActiveCurrencyAndAmount {
+	return &ActiveCurrencyAndAmount{Currency: amt.CurrencyCode, Value: amt.Amount}
+}
+
+// fedRemittanceAmount returns the Fedwire remittance amount of an amount
+func (c *converter) fedRemittanceAmount(tag, field string, amt ActiveCurrencyAndAmount) wire.RemittanceAmount {
+	return wire.RemittanceAmount{
+		CurrencyCode: amt.Currency,
+		Amount:       c.text(tag, field+".Amount", strings.TrimSpace(amt.Value), 19),
+	}
+}
+
+// setRemittanceInformation sets the {6000} and {8xxx} tags of an RmtInf on fwm
+func (c *converter) setRemittanceInformation(fwm *wire.FEDWireMessage, path string, rmtInf *RemittanceInformation) {
+	if rmtInf == nil {
+		return
+	}
+	if len(rmtInf.Unstructured) > 0 {
+		lines := c.lines(wire.TagOriginatorToBeneficiary, "OriginatorToBeneficiary", rmtInf.Unstructured, 4, 35)
+		ob := wire.NewOriginatorToBeneficiary()
+		ob.LineOne, ob.LineTwo, ob.LineThree, ob.LineFour = lines[0], lines[1], lines[2], lines[3]
+		fwm.OriginatorToBeneficiary = ob
+	}
+	if len(rmtInf.Structured) == 0 {
+		return
+	}
+	if len(rmtInf.Structured) > 1 {
+		c.warnElement(path+"/Strd", "only the first of %d structured remittances fits", len(rmtInf.Structured))
+	}
+	strd := rmtInf.Structured[0]
+
+	if docs := strd.ReferredDocumentInformation; len(docs) > 0 {
+		if len(docs) > 1 {
+			c.warn(wire.TagPrimaryRemittanceDocument, "PrimaryRemittanceDocument", "only the first of %d referred documents fits", len(docs))
+		}
+		doc := docs[0]
+		if doc.Type != nil || doc.Number != "" {
+			prd := wire.NewPrimaryRemittanceDocument()
+			prd.DocumentTypeCode, prd.ProprietaryDocumentTypeCode = documentType(doc.Type)
+			prd.ProprietaryDocumentTypeCode = c.text(wire.TagPrimaryRemittanceDocument, "PrimaryRemittanceDocument.ProprietaryDocumentTypeCode", prd.ProprietaryDocumentTypeCode, 35)
+			prd.DocumentIdentificationNumber = c.text(wire.TagPrimaryRemittanceDocument, "PrimaryRemittanceDocument.DocumentIdentificationNumber", doc.Number, 35)
+			if doc.Type != nil {
+				prd.Issuer = c.text(wire.TagPrimaryRemittanceDocument, "PrimaryRemittanceDocument.Issuer", doc.Type.Issuer, 35)
+			}
+			fwm.PrimaryRemittanceDocument = prd
+		}
+		if doc.RelatedDate != "" {
+			drd := wire.NewDateRemittanceDocument()
+			drd.DateRemittanceDocument = fedDateFromISO(doc.RelatedDate)
+			fwm.DateRemittanceDocument = drd
+		}
+	}
+
+	if amt := strd.ReferredDocumentAmount; amt != nil {
+		if amt.DuePayableAmount != nil {
+			gross := wire.NewGrossAmountRemittanceDocument()
+			gross.RemittanceAmount = c.fedRemittanceAmount(wire.TagGrossAmountRemittanceDocument, "GrossAmountRemittanceDocument", *amt.DuePayableAmount)
+			fwm.GrossAmountRemittanceDocument = gross
+		}
+		if len(amt.DiscountAppliedAmount) > 0 {
+			if len(amt.DiscountAppliedAmount) > 1 {
+				c.warn(wire.TagAmountNegotiatedDiscount, "AmountNegotiatedDiscount", "only the first of %d discounts fits", len(amt.DiscountAppliedAmount))
+			}
+			discount := wire.NewAmountNegotiatedDiscount()
+			discount.RemittanceAmount = c.fedRemittanceAmount(wire.TagAmountNegotiatedDiscount, "AmountNegotiatedDiscount", amt.DiscountAppliedAmount[0].Amount)
+			fwm.AmountNegotiatedDiscount = discount
+		}
+		if len(amt.AdjustmentAmountAndReason) > 0 {
+			if len(amt.AdjustmentAmountAndReason) > 1 {
+				c.warn(wire.TagAdjustment, "Adjustment", "only the first of %d adjustments fits", len(amt.AdjustmentAmountAndReason))
+			}
+			a := amt.AdjustmentAmountAndReason[0]
+			adj := wire.NewAdjustment()
+			adj.AdjustmentReasonCode = c.text(wire.TagAdjustment, "Adjustment.AdjustmentReasonCode", a.Reason, 2)
+			adj.CreditDebitIndicator = a.CreditDebitIndicator
+			adj.RemittanceAmount = c.fedRemittanceAmount(wire.TagAdjustment, "Adjustment", a.Amount)
+			adj.AdditionalInfo = c.text(wire.TagAdjustment, "Adjustment.AdditionalInfo", a.AdditionalInformation, 140)
+			fwm.Adjustment = adj
+		}
+		if amt.RemittedAmount != nil {
+			paid := wire.NewActualAmountPaid()
+			paid.RemittanceAmount = c.fedRemittanceAmount(wire.TagActualAmountPaid, "ActualAmountPaid", *amt.RemittedAmount)
+			fwm.ActualAmountPaid = paid
+		}
+	}
+
+	if ref := strd.CreditorReferenceInformation; ref != nil {
+		srd := wire.NewSecondaryRemittanceDocument()
+		srd.DocumentTypeCode, srd.ProprietaryDocumentTypeCode = documentType(ref.Type)
+		srd.ProprietaryDocumentTypeCode = c.text(wire.TagSecondaryRemittanceDocument, "SecondaryRemittanceDocument.ProprietaryDocumentTypeCode", srd.ProprietaryDocumentTypeCode, 35)
+		srd.DocumentIdentificationNumber = c.text(wire.TagSecondaryRemittanceDocument, "SecondaryRemittanceDocument.DocumentIdentificationNumber", ref.Reference, 35)
+		if ref.Type != nil {
+			srd.Issuer = c.text(wire.TagSecondaryRemittanceDocument, "SecondaryRemittanceDocument.Issuer", ref.Type.Issuer, 35)
+		}
+		fwm.SecondaryRemittanceDocument = srd
+	}
+
+	if party := strd.Invoicee; party != nil {
+		tag, field := wire.TagRemittanceOriginator, "RemittanceOriginator"
+		id := c.remittanceIdentity(tag, field, party)
+		ro := wire.NewRemittanceOriginator()
+		ro.IdentificationType, ro.IdentificationCode = id.idType, id.idCode
+		ro.IdentificationNumber, ro.IdentificationNumberIssuer = id.idNumber, id.issuer
+		ro.RemittanceData = c.remittanceData(tag, field+".RemittanceData", party.Name, party.PostalAddress)
+		ro.RemittanceData.CountryOfResidence = c.text(tag, field+".RemittanceData.CountryOfResidence", party.CountryOfResidence, 2)
+		if ctct := party.ContactDetails; ctct != nil {
+			ro.ContactName = c.text(tag, field+".ContactName", ctct.Name, 140)
+			ro.ContactPhoneNumber = c.text(tag, field+".ContactPhoneNumber", ctct.PhoneNumber, 35)
+			ro.ContactMobileNumber = c.text(tag, field+".ContactMobileNumber", ctct.MobileNumber, 35)
+			ro.ContactFaxNumber = c.text(tag, field+".ContactFaxNumber", ctct.FaxNumber, 35)
+			ro.ContactElectronicAddress = c.text(tag, field+".ContactElectronicAddress", ctct.EmailAddress, 2048)
+			if ctct.Other != nil {
+				ro.ContactOther = c.text(tag, field+".ContactOther", ctct.Other.Identification, 35)
+			}
+		}
+		fwm.RemittanceOriginator = ro
+	}
+	if party := strd.Invoicer; party != nil {
+		tag, field := wire.TagRemittanceBeneficiary, "RemittanceBeneficiary"
+		id := c.remittanceIdentity(tag, field, party)
+		rb := wire.NewRemittanceBeneficiary()
+		rb.IdentificationType, rb.IdentificationCode = id.idType, id.idCode
+		rb.IdentificationNumber, rb.IdentificationNumberIssuer = id.idNumber, id.issuer
+		rb.RemittanceData = c.remittanceData(tag, field+".RemittanceData", party.Name, party.PostalAddress)
+		rb.RemittanceData.CountryOfResidence = c.text(tag, field+".RemittanceData.CountryOfResidence", party.CountryOfResidence, 2)
+		c.unmapped(party.ContactDetails != nil, tag, field+".ContactDetails")
+		fwm.RemittanceBeneficiary = rb
+	}
+
+	if len(strd.AdditionalRemittanceInformation) > 0 {
+		lines := c.lines(wire.TagRemittanceFreeText, "RemittanceFreeText", strd.AdditionalRemittanceInformation, 3, 140)
+		rft := wire.NewRemittanceFreeText()
+		rft.LineOne, rft.LineTwo, rft.LineThree = lines[0], lines[1], lines[2]
+		fwm.RemittanceFreeText = rft
+	}
+}
+
+// setRelatedRemittance sets the {8250} of an RltdRmtInf on fwm
+func (c *converter) setRelatedRemittance(fwm *wire.FEDWireMessage, locations []RemittanceLocation) {
+	if len(locations) == 0 {
+		return
+	}
+	tag, field := wire.TagRelatedRemittance, "RelatedRemittance"
+	if len(locations) > 1 {
+		c.warn(tag, field, "only the first of %d related remittances fits", len(locations))
+	}
+	loc := locations[0]
+	rr := wire.NewRelatedRemittance()
+	rr.RemittanceIdentification = c.text(tag, field+".RemittanceIdentification", loc.RemittanceIdentification, 35)
+	if len(loc.RemittanceLocationDetails) > 0 {
+		if len(loc.RemittanceLocationDetails) > 1 {
+			c.warn(tag, field, "only the first of %d remittance locations fits", len(loc.RemittanceLocationDetails))
+		}
+		data := loc.RemittanceLocationDetails[0]
+		rr.RemittanceLocationMethod = data.Method
+		rr.RemittanceLocationElectronicAddress = c.text(tag, field+".RemittanceLocationElectronicAddress", data.ElectronicAddress, 2048)
+		if data.PostalAddress != nil {
+			rr.RemittanceData = c.remittanceData(tag, field+".RemittanceData", data.PostalAddress.Name, &data.PostalAddress.Address)
+		}
+	}
+	fwm.RelatedRemittance = rr
+}
@@ -0,0 +1,107 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package iso20022
+
+import (
+	"strings"
+
+	"github.com/moov-io/wire"
+)
+
+// A reversal (sub type 02 or 08) or request for reversal (sub type 01 or 07) refers to the original transfer by
+// its IMAD in {3500} PreviousMessageIdentifier. The original's IMAD is its instruction id here, and since
+// NewPacs008 and NewPacs009 derive the UETR from the IMAD, the original's UETR is known as well.
+
+// originalReference returns the instruction id, UETR and settlement date of the transfer fwm refers to. The
+// UETR and date are empty when PreviousMessageIdentifier isn't an IMAD.
+func originalReference(fwm *wire.FEDWireMessage) (string, string, string) {
+	if fwm.PreviousMessageIdentifier == nil {
+		return "", "", ""
+	}
+	id := strings.TrimSpace(fwm.PreviousMessageIdentifier.PreviousMessageIdentifier)
+	imad := parseIMAD(id)
+	if imad == nil {
+		return id, "", ""
+	}
+	return id, uetr(id), isoDateFromFed(imad.InputCycleDate)
+}
+
+// setOriginalReference sets the {3500} of an original message or instruction id on fwm. idPath and uetrPath
+// are the elements the id and the original's UETR were read from.
+func (c *converter) setOriginalReference(fwm *wire.FEDWireMessage, idPath, id, uetrPath, originalUETR string) error {
+	id = strings.TrimSpace(id)
+	if id == "" {
+		return missingElement(idPath)
+	}
+	if originalUETR != "" && originalUETR != uetr(id) {
+		c.warnElement(uetrPath, "no Fedwire equivalent")
+	}
+	fwm.PreviousMessageIdentifier = wire.NewPreviousMessageIdentifier()
+	fwm.PreviousMessageIdentifier.PreviousMessageIdentifier = c.text(wire.TagPreviousMessageIdentifier, "PreviousMessageIdentifier", id, 22)
+	return nil
+}
+
+// reversalSubType returns the {1510} sub type of a reversal or request for reversal. It's a prior day sub type
+// when the original transfer settled before the date of the reversal.
+func reversalSubType(request bool, originalDate, date string) string {
+	prior := originalDate != "" && date != "" && originalDate < date
+	switch {
+	case request && prior:
+		return wire.RequestReversalPriorDayTransfer
+	case request:
+		return wire.RequestReversal
+	case prior:
+		return wire.ReversalPriorDayTransfer
+	}
+	return wire.ReversalTransfer
+}
+
+// checkReversalSubType records a sub type which doesn't follow from the settlement dates, as it reads back as
+// a different sub type
+func (c *converter) checkReversalSubType(fwm *wire.FEDWireMessage, request bool, originalDate string) {
+	sub := fwm.TypeSubType.SubTypeCode
+	if want := reversalSubType(request, originalDate, settlementDate(fwm)); want != sub {
+		c.warn(wire.TagTypeSubType, "TypeSubType.SubTypeCode", "sub type %s doesn't follow from the settlement dates and reads back as %s", sub, want)
+	}
+}
+
+// partyOrAgent returns the debtor or creditor of a {5000} Originator or {4200} Beneficiary, which are
+// institutions in bank transfers and parties otherwise
+func (c *converter) partyOrAgent(bankTransfer bool, p wire.Personal) (*PartyOrAgent, *CashAccount) {
+	if bankTransfer {
+		agent, acct := c.institution(p)
+		return &PartyOrAgent{Agent: &agent}, acct
+	}
+	party, acct := c.party(p)
+	return &PartyOrAgent{Party: &party}, acct
+}
+
+// personalOf returns the {5000} Originator or {4200} Beneficiary of a debtor or creditor
+func (c *converter) personalOf(tag, field string, pa *PartyOrAgent, acct *CashAccount) wire.Personal {
+	if pa != nil && pa.Agent != nil {
+		return c.institutionParty(tag, field, *pa.Agent, acct)
+	}
+	party := PartyIdentification{}
+	if pa != nil && pa.Party != nil {
+		party = *pa.Party
+	}
+	return c.personal(tag, field, party, acct)
+}
+
+// reasonLines returns the additional information of reasons as lines, recording the reason codes and
+// originators which have no Fedwire equivalent
+func (c *converter) reasonLines(path string, reasons []ReasonInformation) []string {
+	var lines []string
+	for _, rsn := range reasons {
+		if rsn.Reason != nil {
+			c.warnElement(path+"/Rsn", "reason %s has no Fedwire equivalent", schemeValue(rsn.Reason))
+		}
+		if rsn.Originator != nil {
+			c.warnElement(path+"/Orgtr", "no Fedwire equivalent")
+		}
+		lines = append(lines, rsn.AdditionalInformation...)
+	}
+	return lines
+}
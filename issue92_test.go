@@ -8,6 +8,8 @@ import (
 
 func TestFedWireMessage_verifyIssue92(t *testing.T) {
 	fwm := issue92FedWireMessage()
+	// the routing numbers of the payload are made up, and its SWIFT identifiers are given a BIC
+	fwm.ValidateOptions = &ValidateOpts{SkipRoutingNumberCheck: true}
 	require.NoError(t, fwm.verify())
 }
 
@@ -32,8 +34,9 @@ func issue92FedWireMessage() *FEDWireMessage {
 		SubTypeCode: BasicFundsTransfer,
 	}
 	fwm.InputMessageAccountabilityData = &InputMessageAccountabilityData{
-		tag:                 TagInputMessageAccountabilityData,
-		InputCycleDate:      "20180922",
+		tag: TagInputMessageAccountabilityData,
+		// the payload's cycle date was a Saturday
+		InputCycleDate:      "20180921",
 		InputSource:         "XYZ ABC",
 		InputSequenceNumber: "000001",
 	}
@@ -77,7 +80,7 @@ func issue92FedWireMessage() *FEDWireMessage {
 		tag: TagBeneficiary,
 		Personal: Personal{
 			IdentificationCode: SWIFTBICORBEIANDAccountNumber,
-			Identifier:         "755756",
+			Identifier:         "CHASUS33755756",
 			Name:               "string",
 			Address: Address{
 				AddressLineOne:   " ",
@@ -90,7 +93,7 @@ func issue92FedWireMessage() *FEDWireMessage {
 		tag: TagOriginator,
 		Personal: Personal{
 			IdentificationCode: SWIFTBICORBEIANDAccountNumber,
-			Identifier:         "798260",
+			Identifier:         "CHASUS33798260",
 			Name:               "string",
 			Address: Address{
 				AddressLineOne:   " ",
@@ -0,0 +1,153 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"time"
+)
+
+// WireState is a state of a wire in its Lifecycle
+type WireState string
+
+const (
+	// WireDraft is a wire being prepared
+	WireDraft WireState = "draft"
+	// WireValidated is a wire which passed validation, ready to be released
+	WireValidated WireState = "validated"
+	// WireReleased is a wire sent to the Fed, waiting on its acknowledgement
+	WireReleased WireState = "released"
+	// WireAcknowledged is a wire the Fed accepted
+	WireAcknowledged WireState = "acknowledged"
+	// WireRejected is a wire the Fed rejected
+	WireRejected WireState = "rejected"
+	// WireReversed is an accepted wire its receiver sent back with a reversal
+	WireReversed WireState = "reversed"
+)
+
+// wireTransitions are the states each WireState can move to
+var wireTransitions = map[WireState][]WireState{
+	WireDraft:        {WireValidated},
+	WireValidated:    {WireDraft, WireReleased},
+	WireReleased:     {WireAcknowledged, WireRejected},
+	WireAcknowledged: {WireReversed},
+}
+
+var (
+	// ErrInvalidTransition is the error given when a Lifecycle can't move from its state to another
+	ErrInvalidTransition = errors.New("is not a valid transition")
+	// ErrLifecycleMessage is the error given when applying a message which is neither an acknowledgement nor
+	// a reversal to a Lifecycle
+	ErrLifecycleMessage = errors.New("is neither an acknowledgement nor a reversal")
+)
+
+// LifecycleEvent is a change of state of a Lifecycle
+type LifecycleEvent struct {
+	// From is the state before the change, empty for the first event
+	From WireState `json:"from,omitempty"`
+	// To is the state after the change
+	To WireState `json:"to"`
+	// Time is when the state changed
+	Time time.Time `json:"time"`
+	// Reference identifies the message which changed the state: the OMAD of an acknowledgement or the IMAD of
+	// a reversal
+	Reference string `json:"reference,omitempty"`
+	// Reason explains the change, such as the ErrorWire of a rejection
+	Reason string `json:"reason,omitempty"`
+}
+
+// Lifecycle tracks a wire from draft to its release, the Fed's acknowledgement or rejection, and its reversal.
+// Release and validation are driven by the sender, the later states by the messages received back from the Fed.
+// A Lifecycle isn't safe for concurrent use.
+type Lifecycle struct {
+	// State is the current state of the wire
+	State WireState `json:"state"`
+	// History are the changes of state, oldest first
+	History []LifecycleEvent `json:"history"`
+	// OMAD is the Output Message Accountability Data the Fed acknowledged the wire with
+	OMAD string `json:"omad,omitempty"`
+}
+
+// NewLifecycle returns the Lifecycle of a draft wire created at
+func NewLifecycle(at time.Time) *Lifecycle {
+	return &Lifecycle{
+		State:   WireDraft,
+		History: []LifecycleEvent{{To: WireDraft, Time: at}},
+	}
+}
+
+// Validate moves a draft wire to validated if fwm, the wire, is valid. It returns the validation errors of
+// fwm otherwise, leaving the Lifecycle as it is.
+func (l *Lifecycle) Validate(fwm *FEDWireMessage, at time.Time) error {
+	if err := l.can(WireValidated); err != nil {
+		return err
+	}
+	if err := fwm.ValidateAll(); err != nil {
+		return err
+	}
+	return l.Transition(WireValidated, at, "", "")
+}
+
+// Release moves a validated wire to released, once it's sent to the Fed
+func (l *Lifecycle) Release(at time.Time) error {
+	return l.Transition(WireReleased, at, "", "")
+}
+
+// Apply moves the wire on with fwm, a message received about it: the Fed's acknowledgement of the wire, which
+// accepts or rejects it, or an incoming subtype 02 or 08 reversal of it. An acknowledgement of a wire in process
+// or intercepted leaves its state as it is. An acknowledgement is applied as such even if the wire is itself
+// a reversal.
+func (l *Lifecycle) Apply(fwm *FEDWireMessage, at time.Time) error {
+	if !fwm.IsAcknowledgement() {
+		if fwm.IsReversal() {
+			return l.Transition(WireReversed, at, fwm.InputMessageAccountabilityData.Reference(), "")
+		}
+		return fieldError("MessageDisposition", ErrLifecycleMessage)
+	}
+
+	ack, err := fwm.Acknowledgement()
+	if err != nil {
+		return err
+	}
+	switch ack.Status {
+	case AcknowledgementAccepted:
+		if err := l.Transition(WireAcknowledged, at, ack.OMAD, ""); err != nil {
+			return err
+		}
+		l.OMAD = ack.OMAD
+		return nil
+	case AcknowledgementRejected:
+		reason := strings.TrimSpace(strings.Join([]string{ack.ErrorCategory, ack.ErrorCode, ack.ErrorDescription}, " "))
+		return l.Transition(WireRejected, at, ack.OMAD, reason)
+	}
+	return l.can(WireAcknowledged)
+}
+
+// Transition moves the wire to state to at a point in time, with the reference of the message and the reason
+// causing it, if any. It returns ErrInvalidTransition if the wire can't move to to from its current state.
+func (l *Lifecycle) Transition(to WireState, at time.Time, reference, reason string) error {
+	if err := l.can(to); err != nil {
+		return err
+	}
+	l.History = append(l.History, LifecycleEvent{
+		From:      l.State,
+		To:        to,
+		Time:      at,
+		Reference: reference,
+		Reason:    reason,
+	})
+	l.State = to
+	return nil
+}
+
+// can returns ErrInvalidTransition if the wire can't move to to from its current state
+func (l *Lifecycle) can(to WireState) error {
+	if !slices.Contains(wireTransitions[l.State], to) {
+		return fieldError("State", ErrInvalidTransition, string(l.State)+" to "+string(to))
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// mockLifecycleWire returns a valid customer transfer
+func mockLifecycleWire() FEDWireMessage {
+	fwm := mockCustomerTransferData()
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.Originator = mockOriginator()
+	fwm.OriginatorFI = mockOriginatorFI()
+	return fwm
+}
+
+// mockAcknowledgement returns fwm acknowledged by the Fed with status
+func mockAcknowledgement(fwm FEDWireMessage, status string) *FEDWireMessage {
+	fwm.MessageDisposition = NewMessageDisposition()
+	fwm.MessageDisposition.MessageStatusIndicator = status
+	fwm.OutputMessageAccountabilityData = NewOutputMessageAccountabilityData()
+	fwm.OutputMessageAccountabilityData.OutputCycleDate = "20190410"
+	fwm.OutputMessageAccountabilityData.OutputDestinationID = "Dest0001"
+	fwm.OutputMessageAccountabilityData.OutputSequenceNumber = "000001"
+	return &fwm
+}
+
+func TestLifecycle(t *testing.T) {
+	fwm := mockLifecycleWire()
+	at := time.Date(2019, time.April, 10, 9, 0, 0, 0, EasternTime)
+
+	l := NewLifecycle(at)
+	require.Equal(t, WireDraft, l.State)
+	require.NoError(t, l.Validate(&fwm, at.Add(time.Minute)))
+	require.NoError(t, l.Release(at.Add(2*time.Minute)))
+
+	// intercepted, then accepted
+	require.NoError(t, l.Apply(mockAcknowledgement(fwm, MessageStatusInProcess), at.Add(3*time.Minute)))
+	require.Equal(t, WireReleased, l.State)
+	require.NoError(t, l.Apply(mockAcknowledgement(fwm, MessageStatusSuccessfulValue), at.Add(4*time.Minute)))
+	require.Equal(t, WireAcknowledged, l.State)
+	require.Equal(t, "20190410Dest0001000001", l.OMAD[:22])
+
+	b, err := BuildReversal(&fwm, true, "")
+	require.NoError(t, err)
+	reversal, err := b.IMAD("20190411", "Source09", "000001").Build()
+	require.NoError(t, err)
+	require.NoError(t, l.Apply(reversal, at.Add(5*time.Minute)))
+	require.Equal(t, WireReversed, l.State)
+
+	require.Equal(t, []LifecycleEvent{
+		{To: WireDraft, Time: at},
+		{From: WireDraft, To: WireValidated, Time: at.Add(time.Minute)},
+		{From: WireValidated, To: WireReleased, Time: at.Add(2 * time.Minute)},
+		{From: WireReleased, To: WireAcknowledged, Time: at.Add(4 * time.Minute), Reference: l.OMAD},
+		{From: WireAcknowledged, To: WireReversed, Time: at.Add(5 * time.Minute), Reference: "20190411Source09000001"},
+	}, l.History)
+
+	// a reversed wire is final
+	err = l.Apply(mockAcknowledgement(fwm, MessageStatusSuccessfulValue), at.Add(6*time.Minute))
+	require.ErrorIs(t, err, ErrInvalidTransition)
+	require.Len(t, l.History, 5)
+}
+
+func TestLifecycle_Rejected(t *testing.T) {
+	fwm := mockLifecycleWire()
+	at := time.Date(2019, time.April, 10, 9, 0, 0, 0, EasternTime)
+
+	l := NewLifecycle(at)
+	require.NoError(t, l.Validate(&fwm, at))
+	require.NoError(t, l.Release(at))
+
+	ack := mockAcknowledgement(fwm, MessageStatusRejected)
+	ack.ErrorWire = NewErrorWire()
+	ack.ErrorWire.ErrorCategory = ErrorCategoryCutoffHour
+	ack.ErrorWire.ErrorCode = "ABC"
+	ack.ErrorWire.ErrorDescription = "After cutoff"
+	require.NoError(t, l.Apply(ack, at))
+	require.Equal(t, WireRejected, l.State)
+	require.Equal(t, "W ABC After cutoff", l.History[len(l.History)-1].Reason)
+	require.Empty(t, l.OMAD)
+}
+
+func TestLifecycle_AcknowledgedReversal(t *testing.T) {
+	orig := mockLifecycleWire()
+	b, err := BuildReversal(&orig, true, "")
+	require.NoError(t, err)
+	reversal, err := b.IMAD("20190411", "Source09", "000001").Build()
+	require.NoError(t, err)
+	at := time.Date(2019, time.April, 11, 9, 0, 0, 0, EasternTime)
+
+	// the Fed's acknowledgement of a reversal sent is applied as such, not as a reversal of the reversal
+	l := NewLifecycle(at)
+	require.NoError(t, l.Validate(reversal, at))
+	require.NoError(t, l.Release(at))
+	require.NoError(t, l.Apply(mockAcknowledgement(*reversal, MessageStatusSuccessfulValue), at))
+	require.Equal(t, WireAcknowledged, l.State)
+	require.Equal(t, "20190410Dest0001000001", l.OMAD[:22])
+
+	// an incoming message which is no reversal is ignored
+	incoming := mockAcknowledgement(orig, MessageStatusIncomingValue)
+	require.ErrorIs(t, l.Apply(incoming, at), ErrLifecycleMessage)
+}
+
+func TestLifecycle_InvalidTransitions(t *testing.T) {
+	fwm := mockLifecycleWire()
+	at := time.Date(2019, time.April, 10, 9, 0, 0, 0, EasternTime)
+
+	l := NewLifecycle(at)
+	require.ErrorIs(t, l.Release(at), ErrInvalidTransition)
+	require.ErrorIs(t, l.Apply(mockAcknowledgement(fwm, MessageStatusSuccessfulValue), at), ErrInvalidTransition)
+	require.ErrorIs(t, l.Apply(mockAcknowledgement(fwm, MessageStatusInProcess), at), ErrInvalidTransition)
+	require.ErrorIs(t, l.Apply(&fwm, at), ErrLifecycleMessage)
+
+	// an invalid wire stays a draft
+	invalid := mockCustomerTransferData()
+	require.Error(t, l.Validate(&invalid, at))
+	require.Equal(t, WireDraft, l.State)
+
+	// a validated wire may go back to draft to be edited
+	require.NoError(t, l.Validate(&fwm, at))
+	require.ErrorIs(t, l.Validate(&fwm, at), ErrInvalidTransition)
+	require.NoError(t, l.Transition(WireDraft, at, "", "edited"))
+	require.ErrorIs(t, l.Transition(WireReversed, at, "", ""), ErrInvalidTransition)
+	require.Len(t, l.History, 3)
+}
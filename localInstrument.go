@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // LocalInstrument is the LocalInstrument of the wire
@@ -106,19 +108,20 @@ func (li *LocalInstrument) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on LocalInstrument and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (li *LocalInstrument) Validate() error {
+	var errs base.ErrorList
 	if err := li.fieldInclusion(); err != nil {
-		return err
+		errs.Add(err)
 	}
 	if li.tag != TagLocalInstrument {
-		return fieldError("tag", ErrValidTagForType, li.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, li.tag))
 	}
 	if err := li.isLocalInstrumentCode(li.LocalInstrumentCode); err != nil {
-		return fieldError("LocalInstrumentCode", err, li.LocalInstrumentCode)
+		errs.Add(fieldError("LocalInstrumentCode", err, li.LocalInstrumentCode))
 	}
 	if err := li.isAlphanumeric(li.ProprietaryCode); err != nil {
-		return fieldError("ProprietaryCode", err, li.ProprietaryCode)
+		errs.Add(fieldError("ProprietaryCode", err, li.ProprietaryCode))
 	}
-	return nil
+	return li.collected(errs)
 }
 
 // fieldInclusion validate mandatory fields. If fields are
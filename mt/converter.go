@@ -0,0 +1,415 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package mt
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/moov-io/wire"
+)
+
+// converter maps values between a FEDWireMessage and an MT message, collecting a Warning for every value it
+// has to drop or shorten.
+type converter struct {
+	// message is the MT message being converted, e.g. MT103
+	message  string
+	warnings []Warning
+}
+
+func (c *converter) warn(tag, field, format string, args ...interface{}) {
+	c.warnings = append(c.warnings, Warning{
+		Tag:    tag,
+		Field:  field,
+		Reason: fmt.Sprintf(format, args...),
+	})
+}
+
+// warnField records a dropped MT field which has no Fedwire tag
+func (c *converter) warnField(swiftField, format string, args ...interface{}) {
+	c.warnings = append(c.warnings, Warning{
+		SwiftField: swiftField,
+		Reason:     fmt.Sprintf(format, args...),
+	})
+}
+
+// unmapped records a FEDWireMessage field without an equivalent in the MT message when it's present
+func (c *converter) unmapped(present bool, tag, field string) {
+	if present {
+		c.warn(tag, field, "no %s equivalent", c.message)
+	}
+}
+
+// text returns s cut to max characters, recording a Warning if anything was cut off
+func (c *converter) text(tag, field, s string, max int) string {
+	if utf8.RuneCountInString(s) <= max {
+		return s
+	}
+	c.warn(tag, field, "truncated to %d characters", max)
+	return string([]rune(s)[:max])
+}
+
+// lines returns exactly n lines of at most width characters, recording a Warning for lines which don't fit
+func (c *converter) lines(tag, field string, values []string, n, width int) []string {
+	if len(values) > n {
+		c.warn(tag, field, "only %d of %d lines fit", n, len(values))
+		values = values[:n]
+	}
+	out := make([]string, n)
+	for i := range values {
+		out[i] = c.text(tag, field, values[i], width)
+	}
+	return out
+}
+
+// trimLines drops trailing empty lines. Empty lines in between are kept so each line keeps its position.
+func trimLines(lines ...string) []string {
+	for len(lines) > 0 && strings.TrimSpace(lines[len(lines)-1]) == "" {
+		lines = lines[:len(lines)-1]
+	}
+	for i := range lines {
+		lines[i] = strings.TrimSpace(lines[i])
+	}
+	return lines
+}
+
+// requireTags checks the tags every transfer needs to become an MT message
+func requireTags(fwm *wire.FEDWireMessage) error {
+	switch {
+	case fwm.TypeSubType == nil:
+		return missingTag(wire.TagTypeSubType, "TypeSubType")
+	case fwm.InputMessageAccountabilityData == nil:
+		return missingTag(wire.TagInputMessageAccountabilityData, "InputMessageAccountabilityData")
+	case fwm.Amount == nil:
+		return missingTag(wire.TagAmount, "Amount")
+	case fwm.BusinessFunctionCode == nil:
+		return missingTag(wire.TagBusinessFunctionCode, "BusinessFunctionCode")
+	}
+	return nil
+}
+
+// envelope records the Fedwire values which describe a message the Fedwire Funds Service sent rather than the
+// transfer. The IMAD and depository institutions are carried by an Envelope instead.
+func (c *converter) envelope(fwm *wire.FEDWireMessage) {
+	if ss := fwm.SenderSupplied; ss != nil {
+		c.unmapped(strings.TrimSpace(ss.UserRequestCorrelation) != "", wire.TagSenderSupplied, "SenderSupplied.UserRequestCorrelation")
+	}
+	c.unmapped(fwm.MessageDisposition != nil, wire.TagMessageDisposition, "MessageDisposition")
+	c.unmapped(fwm.ReceiptTimeStamp != nil, wire.TagReceiptTimeStamp, "ReceiptTimeStamp")
+	c.unmapped(fwm.OutputMessageAccountabilityData != nil, wire.TagOutputMessageAccountabilityData, "OutputMessageAccountabilityData")
+	c.unmapped(fwm.ErrorWire != nil, wire.TagErrorWire, "ErrorWire")
+}
+
+// fiToFiInformation records the {6100}-{6420} FI to FI information tags, which are addressed to particular
+// agents and have no MT field. {6500} is field 72.
+func (c *converter) fiToFiInformation(fwm *wire.FEDWireMessage) {
+	c.unmapped(fwm.FIReceiverFI != nil, wire.TagFIReceiverFI, "FIReceiverFI")
+	c.unmapped(fwm.FIDrawdownDebitAccountAdvice != nil, wire.TagFIDrawdownDebitAccountAdvice, "FIDrawdownDebitAccountAdvice")
+	c.unmapped(fwm.FIIntermediaryFI != nil, wire.TagFIIntermediaryFI, "FIIntermediaryFI")
+	c.unmapped(fwm.FIIntermediaryFIAdvice != nil, wire.TagFIIntermediaryFIAdvice, "FIIntermediaryFIAdvice")
+	c.unmapped(fwm.FIBeneficiaryFI != nil, wire.TagFIBeneficiaryFI, "FIBeneficiaryFI")
+	c.unmapped(fwm.FIBeneficiaryFIAdvice != nil, wire.TagFIBeneficiaryFIAdvice, "FIBeneficiaryFIAdvice")
+	c.unmapped(fwm.FIBeneficiary != nil, wire.TagFIBeneficiary, "FIBeneficiary")
+	c.unmapped(fwm.FIBeneficiaryAdvice != nil, wire.TagFIBeneficiaryAdvice, "FIBeneficiaryAdvice")
+	c.unmapped(fwm.FIPaymentMethodToBeneficiary != nil, wire.TagFIPaymentMethodToBeneficiary, "FIPaymentMethodToBeneficiary")
+}
+
+// coverPaymentTags records the {7033}-{7072} cover payment tags, which are sequence B of an MT202COV and have
+// no field in an MT103
+func (c *converter) coverPaymentTags(fwm *wire.FEDWireMessage) {
+	c.unmapped(fwm.CurrencyInstructedAmount != nil, wire.TagCurrencyInstructedAmount, "CurrencyInstructedAmount")
+	c.unmapped(fwm.OrderingCustomer != nil, wire.TagOrderingCustomer, "OrderingCustomer")
+	c.unmapped(fwm.OrderingInstitution != nil, wire.TagOrderingInstitution, "OrderingInstitution")
+	c.unmapped(fwm.IntermediaryInstitution != nil, wire.TagIntermediaryInstitution, "IntermediaryInstitution")
+	c.unmapped(fwm.InstitutionAccount != nil, wire.TagInstitutionAccount, "InstitutionAccount")
+	c.unmapped(fwm.BeneficiaryCustomer != nil, wire.TagBeneficiaryCustomer, "BeneficiaryCustomer")
+	c.unmapped(fwm.Remittance != nil, wire.TagRemittance, "Remittance")
+	c.unmapped(fwm.SenderToReceiver != nil, wire.TagSenderToReceiver, "SenderToReceiver")
+}
+
+// remittanceTags records the {8200}-{8750} unstructured addenda and remittance tags, which have no MT field
+func (c *converter) remittanceTags(fwm *wire.FEDWireMessage) {
+	c.unmapped(fwm.UnstructuredAddenda != nil, wire.TagUnstructuredAddenda, "UnstructuredAddenda")
+	c.unmapped(fwm.RelatedRemittance != nil, wire.TagRelatedRemittance, "RelatedRemittance")
+	c.unmapped(fwm.RemittanceOriginator != nil, wire.TagRemittanceOriginator, "RemittanceOriginator")
+	c.unmapped(fwm.RemittanceBeneficiary != nil, wire.TagRemittanceBeneficiary, "RemittanceBeneficiary")
+	c.unmapped(fwm.PrimaryRemittanceDocument != nil, wire.TagPrimaryRemittanceDocument, "PrimaryRemittanceDocument")
+	c.unmapped(fwm.ActualAmountPaid != nil, wire.TagActualAmountPaid, "ActualAmountPaid")
+	c.unmapped(fwm.GrossAmountRemittanceDocument != nil, wire.TagGrossAmountRemittanceDocument, "GrossAmountRemittanceDocument")
+	c.unmapped(fwm.AmountNegotiatedDiscount != nil, wire.TagAmountNegotiatedDiscount, "AmountNegotiatedDiscount")
+	c.unmapped(fwm.Adjustment != nil, wire.TagAdjustment, "Adjustment")
+	c.unmapped(fwm.DateRemittanceDocument != nil, wire.TagDateRemittanceDocument, "DateRemittanceDocument")
+	c.unmapped(fwm.SecondaryRemittanceDocument != nil, wire.TagSecondaryRemittanceDocument, "SecondaryRemittanceDocument")
+	c.unmapped(fwm.RemittanceFreeText != nil, wire.TagRemittanceFreeText, "RemittanceFreeText")
+}
+
+// valueDateAmount returns field 32A, the IMAD cycle date followed by the {2000} amount in US dollars
+func valueDateAmount(fwm *wire.FEDWireMessage) (string, error) {
+	date, err := time.Parse(fedDate, fwm.InputMessageAccountabilityData.InputCycleDate)
+	if err != nil {
+		return "", fmt.Errorf("%s InputCycleDate: %w", wire.TagInputMessageAccountabilityData, err)
+	}
+	amount, err := swiftAmount(fwm.Amount.Amount)
+	if err != nil {
+		return "", err
+	}
+	return date.Format(mtDate) + currencyUSD + amount, nil
+}
+
+// swiftAmount converts a Fedwire {2000} amount (12 digits, implied decimal point) into an MT amount, which
+// uses a decimal comma
+func swiftAmount(s string) (string, error) {
+	cents, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+	if err != nil || cents < 0 {
+		return "", fmt.Errorf("%q: %w", s, ErrInvalidAmount)
+	}
+	return fmt.Sprintf("%d,%02d", cents/100, cents%100), nil
+}
+
+// impliedAmount converts an MT amount into a Fedwire {2000} amount
+func impliedAmount(s string) (string, error) {
+	whole, fraction, _ := strings.Cut(strings.TrimSpace(s), ",")
+	fraction = strings.TrimRight(fraction, "0")
+	if whole == "" || len(fraction) > 2 {
+		return "", fmt.Errorf("%q: %w", s, ErrInvalidAmount)
+	}
+	units, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil || units < 0 {
+		return "", fmt.Errorf("%q: %w", s, ErrInvalidAmount)
+	}
+	cents := int64(0)
+	if fraction != "" {
+		cents, err = strconv.ParseInt((fraction + "0")[:2], 10, 64)
+		if err != nil {
+			return "", fmt.Errorf("%q: %w", s, ErrInvalidAmount)
+		}
+	}
+	out := fmt.Sprintf("%012d", units*100+cents)
+	if len(out) > 12 {
+		return "", fmt.Errorf("%q: %w", s, ErrInvalidAmount)
+	}
+	return out, nil
+}
+
+// setValueDateAmount sets the {2000} of field 32A on fwm. The value date is the IMAD cycle date, so a
+// different date is recorded as a Warning.
+func (c *converter) setValueDateAmount(fwm *wire.FEDWireMessage, f Field) error {
+	value := strings.Join(f.Lines, "")
+	if len(value) < 10 {
+		return fmt.Errorf("%s :%s: %q: %w", c.message, f.Tag, value, ErrInvalidAmount)
+	}
+	date, currency, amount := value[:6], value[6:9], value[9:]
+	if currency != currencyUSD {
+		return fmt.Errorf("%s :%s: %w: currency %s is not USD", c.message, f.Tag, ErrInvalidAmount, currency)
+	}
+	implied, err := impliedAmount(amount)
+	if err != nil {
+		return fmt.Errorf("%s :%s: %w", c.message, f.Tag, err)
+	}
+	fwm.Amount = wire.NewAmount()
+	fwm.Amount.Amount = implied
+
+	if imad := fwm.InputMessageAccountabilityData; imad != nil {
+		if t, err := time.Parse(mtDate, date); err != nil || t.Format(fedDate) != imad.InputCycleDate {
+			c.warnField(f.Tag, "value date %s differs from the IMAD cycle date", date)
+		}
+	}
+	return nil
+}
+
+// bicPattern matches a BIC of 8 or 11 characters
+var bicPattern = regexp.MustCompile(`^[A-Z]{6}[A-Z0-9]{2}([A-Z0-9]{3})?$`)
+
+// partyIdentifierPrefixes are the SWIFT party identifier lines of the Fedwire identification codes, e.g.
+// //FW121042882 for an ABA routing number
+var partyIdentifierPrefixes = []struct {
+	prefix, code string
+}{
+	{"//FW", wire.FEDRoutingNumber},
+	{"//CP", wire.CHIPSParticipant},
+	{"//CH", wire.CHIPSIdentifier},
+}
+
+// partyIdentifier returns the party identifier line of a Fedwire identification code and identifier
+func partyIdentifier(code, identifier string) string {
+	for _, p := range partyIdentifierPrefixes {
+		if p.code == code {
+			return p.prefix + identifier
+		}
+	}
+	return "/" + identifier
+}
+
+// identification returns the Fedwire identification code and identifier of a party identifier line. An
+// account (a single slash) is a demand deposit account.
+func (c *converter) identification(f Field, line string) (string, string) {
+	for _, p := range partyIdentifierPrefixes {
+		if strings.HasPrefix(line, p.prefix) {
+			return p.code, strings.TrimPrefix(line, p.prefix)
+		}
+	}
+	if strings.HasPrefix(line, "//") {
+		c.warnField(f.Tag, "party identifier %s has no Fedwire identification code", line)
+		return "", ""
+	}
+	return wire.DemandDepositAccountNumber, strings.TrimPrefix(line, "/")
+}
+
+// personalLines returns the option and lines of field 50a or 59a for a {5000} Originator or {4200}
+// Beneficiary. A BIC is option A and anything else option K (field 50) or no option (field 59): an account
+// followed by the name and address.
+func (c *converter) personalLines(tag, field string, p wire.Personal) (string, []string) {
+	if p.IdentificationCode == wire.SWIFTBankIdentifierCode {
+		c.unmapped(strings.TrimSpace(p.Name) != "", tag, field+".Name")
+		c.unmapped(p.Address != (wire.Address{}), tag, field+".Address")
+		return "A", []string{strings.TrimSpace(p.Identifier)}
+	}
+	var lines []string
+	if id := strings.TrimSpace(p.Identifier); id != "" {
+		if p.IdentificationCode != wire.DemandDepositAccountNumber {
+			c.warn(tag, field+".IdentificationCode", "identification code %s is written as an account", p.IdentificationCode)
+		}
+		lines = append(lines, c.text(tag, field+".Identifier", "/"+id, lineWidth))
+	}
+	lines = append(lines, p.Name, p.Address.AddressLineOne, p.Address.AddressLineTwo, p.Address.AddressLineThree)
+	return "", trimLines(lines...)
+}
+
+// personal returns the {5000} Originator or {4200} Beneficiary of field 50A, 50K, 59 or 59A
+func (c *converter) personal(tag, field string, f Field) wire.Personal {
+	lines := f.Lines
+	var p wire.Personal
+	if strings.HasSuffix(f.Tag, "A") {
+		for _, line := range lines {
+			if strings.HasPrefix(line, "/") {
+				c.warnField(f.Tag, "account %s next to a BIC has no Fedwire equivalent", line)
+				continue
+			}
+			p.IdentificationCode, p.Identifier = wire.SWIFTBankIdentifierCode, line
+		}
+		return p
+	}
+	if strings.HasSuffix(f.Tag, "F") {
+		c.warnField(f.Tag, "option F is read as a name and address")
+	}
+	if len(lines) > 0 && strings.HasPrefix(lines[0], "/") {
+		p.IdentificationCode, p.Identifier = c.identification(f, lines[0])
+		p.Identifier = c.text(tag, field+".Identifier", p.Identifier, 34)
+		lines = lines[1:]
+	}
+	lines = c.lines(tag, field, lines, 4, lineWidth)
+	p.Name = lines[0]
+	p.Address = wire.Address{AddressLineOne: lines[1], AddressLineTwo: lines[2], AddressLineThree: lines[3]}
+	return p
+}
+
+// institutionLines returns the option and lines of field 52a, 56a or 57a for a Fedwire financial institution.
+// A BIC is option A and anything else option D: the party identifier followed by the name and address.
+func (c *converter) institutionLines(tag, field string, fi wire.FinancialInstitution) (string, []string) {
+	if fi.IdentificationCode == wire.SWIFTBankIdentifierCode {
+		c.unmapped(strings.TrimSpace(fi.Name) != "", tag, field+".Name")
+		c.unmapped(fi.Address != (wire.Address{}), tag, field+".Address")
+		return "A", []string{strings.TrimSpace(fi.Identifier)}
+	}
+	var lines []string
+	if id := strings.TrimSpace(fi.Identifier); id != "" {
+		if fi.IdentificationCode == wire.SWIFTBICORBEIANDAccountNumber {
+			c.warn(tag, field+".IdentificationCode", "identification code %s is written as an account", fi.IdentificationCode)
+		}
+		lines = append(lines, c.text(tag, field+".Identifier", partyIdentifier(fi.IdentificationCode, id), lineWidth))
+	}
+	lines = append(lines, fi.Name, fi.Address.AddressLineOne, fi.Address.AddressLineTwo, fi.Address.AddressLineThree)
+	return "D", trimLines(lines...)
+}
+
+// financialInstitution returns the Fedwire financial institution of field 52a, 56a or 57a
+func (c *converter) financialInstitution(tag, field string, f Field) wire.FinancialInstitution {
+	lines := f.Lines
+	var fi wire.FinancialInstitution
+	switch {
+	case strings.HasSuffix(f.Tag, "A"):
+		for _, line := range lines {
+			if strings.HasPrefix(line, "/") {
+				c.warnField(f.Tag, "party identifier %s next to a BIC has no Fedwire equivalent", line)
+				continue
+			}
+			fi.IdentificationCode, fi.Identifier = wire.SWIFTBankIdentifierCode, line
+		}
+		return fi
+	case strings.HasSuffix(f.Tag, "B"):
+		c.warnField(f.Tag, "option B has no Fedwire equivalent")
+		return fi
+	}
+	if len(lines) > 0 && strings.HasPrefix(lines[0], "/") {
+		fi.IdentificationCode, fi.Identifier = c.identification(f, lines[0])
+		fi.Identifier = c.text(tag, field+".Identifier", fi.Identifier, 34)
+		lines = lines[1:]
+	}
+	lines = c.lines(tag, field, lines, 4, lineWidth)
+	fi.Name = lines[0]
+	fi.Address = wire.Address{AddressLineOne: lines[1], AddressLineTwo: lines[2], AddressLineThree: lines[3]}
+	return fi
+}
+
+// fiToFiLines returns field 72 for {6500} FIAdditionalFIToFI
+func fiToFiLines(fwm *wire.FEDWireMessage) []string {
+	if fwm.FIAdditionalFIToFI == nil {
+		return nil
+	}
+	a := fwm.FIAdditionalFIToFI.AdditionalFIToFI
+	return []string{a.LineOne, a.LineTwo, a.LineThree, a.LineFour, a.LineFive, a.LineSix}
+}
+
+// setFIToFI sets the {6500} of field 72 on fwm
+func (c *converter) setFIToFI(fwm *wire.FEDWireMessage, f Field) {
+	lines := c.lines(wire.TagFIAdditionalFIToFI, "FIAdditionalFIToFI", f.Lines, 6, lineWidth)
+	fi := wire.NewFIAdditionalFIToFI()
+	fi.AdditionalFIToFI = wire.AdditionalFIToFI{
+		LineOne: lines[0], LineTwo: lines[1], LineThree: lines[2],
+		LineFour: lines[3], LineFive: lines[4], LineSix: lines[5],
+	}
+	fwm.FIAdditionalFIToFI = fi
+}
+
+// newMessage returns a customer transfer carrying the tags of env
+func newMessage(env Envelope, bfc string) *wire.FEDWireMessage {
+	fwm := &wire.FEDWireMessage{
+		SenderSupplied:                 env.SenderSupplied,
+		TypeSubType:                    wire.NewTypeSubType(),
+		InputMessageAccountabilityData: env.IMAD,
+		SenderDepositoryInstitution:    env.Sender,
+		ReceiverDepositoryInstitution:  env.Receiver,
+		BusinessFunctionCode:           wire.NewBusinessFunctionCode(),
+	}
+	if fwm.SenderSupplied == nil {
+		fwm.SenderSupplied = wire.NewSenderSupplied()
+	}
+	fwm.TypeSubType.TypeCode = wire.FundsTransfer
+	fwm.TypeSubType.SubTypeCode = wire.BasicFundsTransfer
+	fwm.BusinessFunctionCode.BusinessFunctionCode = bfc
+	return fwm
+}
+
+// setSenderReference sets the {3320} of field 20 on fwm
+func (c *converter) setSenderReference(fwm *wire.FEDWireMessage, f Field) {
+	if ref := strings.Join(f.Lines, ""); ref != "" && ref != noReference {
+		fwm.SenderReference = wire.NewSenderReference()
+		fwm.SenderReference.SenderReference = c.text(wire.TagSenderReference, "SenderReference.SenderReference", ref, 16)
+	}
+}
+
+// senderReference returns field 20 of {3320} SenderReference
+func senderReference(fwm *wire.FEDWireMessage) string {
+	if fwm.SenderReference != nil {
+		if ref := strings.TrimSpace(fwm.SenderReference.SenderReference); ref != "" {
+			return ref
+		}
+	}
+	return noReference
+}
@@ -0,0 +1,184 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+// Package mt converts Fedwire FEDWireMessages to and from the text block of SWIFT MT103 and MT202COV messages.
+//
+// An MT103 single customer credit transfer is a CustomerTransfer (CTR), or a CustomerTransferPlus (CTP) when
+// the ordering customer uses option F. An MT202COV is a cover payment, a CTP with a COVS {3610}
+// LocalInstrument: its sequence A maps to the transfer's own tags and its sequence B, the underlying customer
+// credit transfer, to the {7033}-{7072} cover payment tags.
+//
+// MT fields and Fedwire tags don't line up one to one. Values which can't be carried over, or which had to be
+// shortened, are returned as Warnings next to the converted message rather than being dropped silently.
+package mt
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/moov-io/wire"
+)
+
+const (
+	// MT103 is the message type of a single customer credit transfer
+	MT103 = "103"
+	// MT202COV is the message type of a general financial institution transfer covering a customer transfer
+	MT202COV = "202COV"
+
+	// lineWidth is the width of MT text lines
+	lineWidth = 35
+	// noReference is the MT placeholder for a mandatory reference which has no value
+	noReference = "NONREF"
+	currencyUSD = "USD"
+	mtDate      = "060102"
+	fedDate     = "20060102"
+)
+
+var (
+	// ErrUnsupportedMessage is returned when a FEDWireMessage or MT message has no equivalent in the other format
+	ErrUnsupportedMessage = errors.New("unsupported message")
+	// ErrMissingTag is returned when a FEDWireMessage lacks a tag the MT message requires
+	ErrMissingTag = errors.New("missing tag")
+	// ErrMissingField is returned when an MT message lacks a field the FEDWireMessage requires
+	ErrMissingField = errors.New("missing field")
+	// ErrInvalidAmount is returned when an amount can't be represented in the other format
+	ErrInvalidAmount = errors.New("invalid amount")
+	// ErrMalformedText is returned when a text block can't be parsed
+	ErrMalformedText = errors.New("malformed text block")
+
+	// fieldPattern matches the first line of a field, e.g. :50K:/123456789
+	fieldPattern = regexp.MustCompile(`^:([0-9]{2}[A-Z]?):(.*)$`)
+)
+
+// Warning describes a value which was dropped or altered while converting between a FEDWireMessage and an MT
+// message.
+type Warning struct {
+	// Tag is the Fedwire tag of the value, e.g. {6100}
+	Tag string `json:"tag,omitempty"`
+	// Field is the FEDWireMessage field of the value, e.g. Beneficiary.Personal.Name
+	Field string `json:"field,omitempty"`
+	// SwiftField is the MT field of the value when it has no Fedwire tag, e.g. 53A
+	SwiftField string `json:"swiftField,omitempty"`
+	// Reason explains what happened to the value
+	Reason string `json:"reason"`
+}
+
+func (w Warning) String() string {
+	if w.Tag == "" {
+		return fmt.Sprintf(":%s: %s", w.SwiftField, w.Reason)
+	}
+	return fmt.Sprintf("%s %s: %s", w.Tag, w.Field, w.Reason)
+}
+
+// Field is a field of a text block
+type Field struct {
+	// Tag is the field number and option letter, e.g. 50K
+	Tag string `json:"tag"`
+	// Lines are the field's lines of text
+	Lines []string `json:"lines"`
+}
+
+// Message is the text block (block 4) of an MT103 or MT202COV message
+type Message struct {
+	// Type is the message type, MT103 or MT202COV
+	Type string `json:"type"`
+	// Fields are the fields of the text block in order
+	Fields []Field `json:"fields"`
+}
+
+// Envelope holds the Fedwire tags which identify a FEDWireMessage rather than the transfer it carries. A text
+// block has no fields for them, so the sending bank supplies them when converting an MT message.
+type Envelope struct {
+	// SenderSupplied defaults to a production, original message
+	SenderSupplied *wire.SenderSupplied
+	// IMAD is the message's input message accountability data. Its cycle date should be the value date.
+	IMAD     *wire.InputMessageAccountabilityData
+	Sender   *wire.SenderDepositoryInstitution
+	Receiver *wire.ReceiverDepositoryInstitution
+}
+
+// Parse reads the text block of an MT message of messageType. s is either the text block itself, with or
+// without its {4: and -} delimiters, or a complete message whose block 4 is read.
+func Parse(messageType, s string) (*Message, error) {
+	if messageType != MT103 && messageType != MT202COV {
+		return nil, fmt.Errorf("%w: MT%s", ErrUnsupportedMessage, messageType)
+	}
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	if _, block, ok := strings.Cut(s, "{4:"); ok {
+		s = block
+	}
+	if i := strings.LastIndex(s, "-}"); i >= 0 {
+		s = s[:i]
+	}
+
+	msg := &Message{Type: messageType}
+	for n, line := range strings.Split(s, "\n") {
+		line = strings.TrimRight(line, " ")
+		if m := fieldPattern.FindStringSubmatch(line); m != nil {
+			msg.Fields = append(msg.Fields, Field{Tag: m[1], Lines: []string{m[2]}})
+			continue
+		}
+		if len(msg.Fields) == 0 {
+			if strings.TrimSpace(line) != "" {
+				return nil, fmt.Errorf("%w: line %d %q is not part of a field", ErrMalformedText, n+1, line)
+			}
+			continue
+		}
+		f := &msg.Fields[len(msg.Fields)-1]
+		f.Lines = append(f.Lines, line)
+	}
+	for i := range msg.Fields {
+		msg.Fields[i].Lines = trimLines(msg.Fields[i].Lines...)
+	}
+	if len(msg.Fields) == 0 {
+		return nil, fmt.Errorf("%w: no fields", ErrMalformedText)
+	}
+	return msg, nil
+}
+
+// String writes the message as a text block, including its {4: and -} delimiters
+func (m *Message) String() string {
+	var buf strings.Builder
+	buf.WriteString("{4:\r\n")
+	for _, f := range m.Fields {
+		buf.WriteString(":" + f.Tag + ":")
+		buf.WriteString(strings.Join(f.Lines, "\r\n"))
+		buf.WriteString("\r\n")
+	}
+	buf.WriteString("-}")
+	return buf.String()
+}
+
+// add appends a field to the message unless all its lines are empty
+func (m *Message) add(tag string, lines ...string) {
+	lines = trimLines(lines...)
+	if len(lines) == 0 {
+		return
+	}
+	m.Fields = append(m.Fields, Field{Tag: tag, Lines: lines})
+}
+
+// FEDWireMessage converts the message into a FEDWireMessage. env supplies the tags a text block has no fields
+// for.
+func (m *Message) FEDWireMessage(env Envelope) (*wire.FEDWireMessage, []Warning, error) {
+	switch m.Type {
+	case MT103:
+		return m.mt103(env)
+	case MT202COV:
+		return m.mt202COV(env)
+	}
+	return nil, nil, fmt.Errorf("%w: MT%s", ErrUnsupportedMessage, m.Type)
+}
+
+// missingTag returns an error for a FEDWireMessage field which must be present
+func missingTag(tag, field string) error {
+	return fmt.Errorf("%s %s: %w", tag, field, ErrMissingTag)
+}
+
+// missingField returns an error for a field of message which must be present
+func missingField(message, tag string) error {
+	return fmt.Errorf("%s :%s: %w", message, tag, ErrMissingField)
+}
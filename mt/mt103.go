@@ -0,0 +1,248 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package mt
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/moov-io/wire"
+)
+
+// bankOperationCredit is the field 23B bank operation code of a plain credit transfer
+const bankOperationCredit = "CRED"
+
+// chargeCodes are the field 71A details of charges of the Fedwire {3700} charge details. OUR, where the
+// ordering customer bears all charges, has no Fedwire equivalent and is written when there's no {3700}.
+var chargeCodes = map[string]string{
+	wire.CDBeneficiary: "BEN",
+	wire.CDShared:      "SHA",
+}
+
+const chargesOurs = "OUR"
+
+// NewMT103 converts a customer transfer (CTR or CTP, sub type 00) into the text block of an MT103.
+//
+// Field 32A is the IMAD cycle date and {2000} amount. {5000} Originator or {5010} OriginatorOptionF is the
+// ordering customer, {5100} OriginatorFI the ordering institution, {4000} BeneficiaryIntermediaryFI the
+// intermediary, {4100} BeneficiaryFI the account with institution and {4200} Beneficiary the beneficiary
+// customer. {6000} OriginatorToBeneficiary is the remittance information (field 70) and {6500}
+// FIAdditionalFIToFI the sender to receiver information (field 72).
+func NewMT103(fwm *wire.FEDWireMessage) (*Message, []Warning, error) {
+	if err := requireTags(fwm); err != nil {
+		return nil, nil, err
+	}
+	bfc := fwm.BusinessFunctionCode.BusinessFunctionCode
+	if bfc != wire.CustomerTransfer && bfc != wire.CustomerTransferPlus {
+		return nil, nil, fmt.Errorf("%w: MT103 carries customer transfers, not %s", ErrUnsupportedMessage, bfc)
+	}
+	if fwm.LocalInstrument != nil && fwm.LocalInstrument.LocalInstrumentCode == wire.SequenceBCoverPaymentStructured {
+		return nil, nil, fmt.Errorf("%w: cover payments are MT202COV messages", ErrUnsupportedMessage)
+	}
+	if sub := fwm.TypeSubType.SubTypeCode; sub != wire.BasicFundsTransfer {
+		return nil, nil, fmt.Errorf("%w: MT103 carries transfers, not sub type %s", ErrUnsupportedMessage, sub)
+	}
+
+	c := &converter{message: "MT103"}
+	c.envelope(fwm)
+	c.unmapped(fwm.TypeSubType.TypeCode != wire.FundsTransfer, wire.TagTypeSubType, "TypeSubType.TypeCode")
+	if bfc == wire.CustomerTransferPlus && fwm.OriginatorOptionF == nil {
+		c.warn(wire.TagBusinessFunctionCode, "BusinessFunctionCode", "reads back as %s", wire.CustomerTransfer)
+	}
+
+	valueDate, err := valueDateAmount(fwm)
+	if err != nil {
+		return nil, nil, err
+	}
+	msg := &Message{Type: MT103}
+	msg.add("20", senderReference(fwm))
+	msg.add("23B", bankOperationCredit)
+	msg.add("32A", valueDate)
+	if ia := fwm.InstructedAmount; ia != nil {
+		msg.add("33B", ia.CurrencyCode+strings.TrimSpace(ia.Amount))
+	}
+	if er := fwm.ExchangeRate; er != nil {
+		msg.add("36", er.ExchangeRate)
+	}
+
+	switch {
+	case fwm.OriginatorOptionF != nil:
+		of := fwm.OriginatorOptionF
+		msg.add("50F", of.PartyIdentifier, of.Name, of.LineOne, of.LineTwo, of.LineThree)
+		c.unmapped(fwm.Originator != nil, wire.TagOriginator, "Originator")
+	case fwm.Originator != nil:
+		option, lines := c.personalLines(wire.TagOriginator, "Originator.Personal", fwm.Originator.Personal)
+		if option == "" {
+			option = "K"
+		}
+		msg.add("50"+option, lines...)
+	default:
+		return nil, nil, missingTag(wire.TagOriginator, "Originator")
+	}
+	if fi := fwm.OriginatorFI; fi != nil {
+		option, lines := c.institutionLines(wire.TagOriginatorFI, "OriginatorFI.FinancialInstitution", fi.FinancialInstitution)
+		msg.add("52"+option, lines...)
+	}
+	if fi := fwm.BeneficiaryIntermediaryFI; fi != nil {
+		option, lines := c.institutionLines(wire.TagBeneficiaryIntermediaryFI, "BeneficiaryIntermediaryFI.FinancialInstitution", fi.FinancialInstitution)
+		msg.add("56"+option, lines...)
+	}
+	if fi := fwm.BeneficiaryFI; fi != nil {
+		option, lines := c.institutionLines(wire.TagBeneficiaryFI, "BeneficiaryFI.FinancialInstitution", fi.FinancialInstitution)
+		msg.add("57"+option, lines...)
+	}
+	if fwm.Beneficiary == nil {
+		return nil, nil, missingTag(wire.TagBeneficiary, "Beneficiary")
+	}
+	option, lines := c.personalLines(wire.TagBeneficiary, "Beneficiary.Personal", fwm.Beneficiary.Personal)
+	msg.add("59"+option, lines...)
+	if ob := fwm.OriginatorToBeneficiary; ob != nil {
+		msg.add("70", ob.LineOne, ob.LineTwo, ob.LineThree, ob.LineFour)
+	}
+
+	charges := chargesOurs
+	if ch := fwm.Charges; ch != nil {
+		if code, ok := chargeCodes[ch.ChargeDetails]; ok {
+			charges = code
+		}
+	}
+	msg.add("71A", charges)
+	if ch := fwm.Charges; ch != nil {
+		for _, amount := range []string{ch.SendersChargesOne, ch.SendersChargesTwo, ch.SendersChargesThree, ch.SendersChargesFour} {
+			msg.add("71F", amount)
+		}
+	}
+	msg.add("72", fiToFiLines(fwm)...)
+
+	c.unmapped(fwm.PreviousMessageIdentifier != nil, wire.TagPreviousMessageIdentifier, "PreviousMessageIdentifier")
+	c.unmapped(fwm.LocalInstrument != nil, wire.TagLocalInstrument, "LocalInstrument")
+	c.unmapped(fwm.PaymentNotification != nil, wire.TagPaymentNotification, "PaymentNotification")
+	c.unmapped(fwm.BeneficiaryReference != nil, wire.TagBeneficiaryReference, "BeneficiaryReference")
+	c.unmapped(fwm.InstructingFI != nil, wire.TagInstructingFI, "InstructingFI")
+	c.fiToFiInformation(fwm)
+	c.coverPaymentTags(fwm)
+	c.remittanceTags(fwm)
+
+	return msg, c.warnings, nil
+}
+
+// mt103 converts an MT103 text block into a CustomerTransfer, or a CustomerTransferPlus when the ordering
+// customer uses option F.
+func (m *Message) mt103(env Envelope) (*wire.FEDWireMessage, []Warning, error) {
+	c := &converter{message: "MT103"}
+	fwm := newMessage(env, wire.CustomerTransfer)
+
+	var valueDate, orderingCustomer, beneficiary bool
+	var charges []string
+	for _, f := range m.Fields {
+		switch f.Tag {
+		case "20":
+			c.setSenderReference(fwm, f)
+		case "23B":
+			if code := strings.Join(f.Lines, ""); code != bankOperationCredit {
+				c.warnField(f.Tag, "bank operation code %s has no Fedwire equivalent", code)
+			}
+		case "32A":
+			if err := c.setValueDateAmount(fwm, f); err != nil {
+				return nil, nil, err
+			}
+			valueDate = true
+		case "33B":
+			value := strings.Join(f.Lines, "")
+			if len(value) < 4 {
+				return nil, nil, fmt.Errorf("MT103 :%s: %q: %w", f.Tag, value, ErrInvalidAmount)
+			}
+			fwm.InstructedAmount = wire.NewInstructedAmount()
+			fwm.InstructedAmount.CurrencyCode = value[:3]
+			fwm.InstructedAmount.Amount = c.text(wire.TagInstructedAmount, "InstructedAmount.Amount", value[3:], 15)
+		case "36":
+			fwm.ExchangeRate = wire.NewExchangeRate()
+			fwm.ExchangeRate.ExchangeRate = c.text(wire.TagExchangeRate, "ExchangeRate.ExchangeRate", strings.Join(f.Lines, ""), 12)
+		case "50A", "50K":
+			fwm.Originator = wire.NewOriginator()
+			fwm.Originator.Personal = c.personal(wire.TagOriginator, "Originator.Personal", f)
+			orderingCustomer = true
+		case "50F":
+			lines := c.lines(wire.TagOriginatorOptionF, "OriginatorOptionF", f.Lines, 5, lineWidth)
+			of := wire.NewOriginatorOptionF()
+			of.PartyIdentifier, of.Name = lines[0], lines[1]
+			of.LineOne, of.LineTwo, of.LineThree = lines[2], lines[3], lines[4]
+			fwm.OriginatorOptionF = of
+			fwm.BusinessFunctionCode.BusinessFunctionCode = wire.CustomerTransferPlus
+			orderingCustomer = true
+		case "52A", "52D":
+			fwm.OriginatorFI = wire.NewOriginatorFI()
+			fwm.OriginatorFI.FinancialInstitution = c.financialInstitution(wire.TagOriginatorFI, "OriginatorFI.FinancialInstitution", f)
+		case "56A", "56C", "56D":
+			fwm.BeneficiaryIntermediaryFI = wire.NewBeneficiaryIntermediaryFI()
+			fwm.BeneficiaryIntermediaryFI.FinancialInstitution = c.financialInstitution(wire.TagBeneficiaryIntermediaryFI, "BeneficiaryIntermediaryFI.FinancialInstitution", f)
+		case "57A", "57B", "57C", "57D":
+			fwm.BeneficiaryFI = wire.NewBeneficiaryFI()
+			fwm.BeneficiaryFI.FinancialInstitution = c.financialInstitution(wire.TagBeneficiaryFI, "BeneficiaryFI.FinancialInstitution", f)
+		case "59", "59A", "59F":
+			fwm.Beneficiary = wire.NewBeneficiary()
+			fwm.Beneficiary.Personal = c.personal(wire.TagBeneficiary, "Beneficiary.Personal", f)
+			beneficiary = true
+		case "70":
+			lines := c.lines(wire.TagOriginatorToBeneficiary, "OriginatorToBeneficiary", f.Lines, 4, lineWidth)
+			ob := wire.NewOriginatorToBeneficiary()
+			ob.LineOne, ob.LineTwo, ob.LineThree, ob.LineFour = lines[0], lines[1], lines[2], lines[3]
+			fwm.OriginatorToBeneficiary = ob
+		case "71A":
+			c.setChargeDetails(fwm, f)
+		case "71F":
+			charges = append(charges, strings.Join(f.Lines, ""))
+		case "72":
+			c.setFIToFI(fwm, f)
+		default:
+			c.warnField(f.Tag, "no Fedwire equivalent")
+		}
+	}
+	switch {
+	case !valueDate:
+		return nil, nil, missingField(c.message, "32A")
+	case !orderingCustomer:
+		return nil, nil, missingField(c.message, "50a")
+	case !beneficiary:
+		return nil, nil, missingField(c.message, "59a")
+	}
+	if fwm.Originator != nil && fwm.OriginatorOptionF != nil {
+		c.warn(wire.TagOriginator, "Originator", "a CustomerTransferPlus carries option F only")
+		fwm.Originator = nil
+	}
+	c.setSendersCharges(fwm, charges)
+
+	return fwm, c.warnings, nil
+}
+
+// setChargeDetails sets the {3700} of field 71A on fwm
+func (c *converter) setChargeDetails(fwm *wire.FEDWireMessage, f Field) {
+	code := strings.Join(f.Lines, "")
+	for details, charges := range chargeCodes {
+		if charges == code {
+			fwm.Charges = wire.NewCharges()
+			fwm.Charges.ChargeDetails = details
+			return
+		}
+	}
+	if code != chargesOurs {
+		c.warnField(f.Tag, "details of charges %s have no Fedwire equivalent", code)
+	}
+}
+
+// setSendersCharges sets the {3700} sender's charges of fields 71F on fwm. Fedwire carries them next to
+// beneficiary or shared charge details only.
+func (c *converter) setSendersCharges(fwm *wire.FEDWireMessage, charges []string) {
+	if len(charges) == 0 {
+		return
+	}
+	if fwm.Charges == nil {
+		c.warnField("71F", "sender's charges need details of charges BEN or SHA")
+		return
+	}
+	lines := c.lines(wire.TagCharges, "Charges.SendersCharges", charges, 4, 15)
+	fwm.Charges.SendersChargesOne, fwm.Charges.SendersChargesTwo = lines[0], lines[1]
+	fwm.Charges.SendersChargesThree, fwm.Charges.SendersChargesFour = lines[2], lines[3]
+}
@@ -0,0 +1,130 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package mt
+
+import (
+	"testing"
+
+	"github.com/moov-io/wire"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMT103_customerTransfer(t *testing.T) {
+	fwm := readMessage(t, "fedWireMessage-CustomerTransfer.txt")
+
+	msg, warnings, err := NewMT103(fwm)
+	require.NoError(t, err)
+
+	fields := make(map[string][]string)
+	for _, f := range msg.Fields {
+		fields[f.Tag] = append(fields[f.Tag], f.Lines...)
+	}
+	require.Equal(t, []string{"Sender Reference"}, fields["20"])
+	require.Equal(t, []string{"CRED"}, fields["23B"])
+	require.Equal(t, []string{"190410USD12345,67"}, fields["32A"])
+	require.Equal(t, []string{"/1234", "Name", "Address One", "", "Address Three"}, fields["50K"])
+	require.Equal(t, []string{"/123456789", "FI Name", "Address One", "Address Two", "Address Three"}, fields["52D"])
+	require.Equal(t, []string{"/1234", "Name", "Address One", "Address Two", "Address Three"}, fields["59"])
+	require.Equal(t, []string{"LineOne", "LineTwo", "LineThree", "LineFour"}, fields["70"])
+	require.Equal(t, []string{"BEN"}, fields["71A"])
+	require.Equal(t, []string{"USD0,99", "USD2,99", "USD3,99", "USD1,00"}, fields["71F"])
+	require.Equal(t, []string{"USD4567,89"}, fields["33B"])
+	require.Equal(t, []string{"1,2345"}, fields["36"])
+	require.Len(t, fields["72"], 6)
+
+	got, back := roundTrip(t, msg, envelope(fwm))
+	requireRoundTrip(t, fwm, got, warnings, back)
+	require.Equal(t, wire.CustomerTransfer, got.BusinessFunctionCode.BusinessFunctionCode)
+
+	warned := make(map[string]bool)
+	for _, w := range warnings {
+		warned[w.Tag] = true
+	}
+	for _, tag := range []string{wire.TagPreviousMessageIdentifier, wire.TagBeneficiaryReference, wire.TagInstructingFI, wire.TagFIReceiverFI} {
+		require.True(t, warned[tag], tag)
+	}
+}
+
+func TestMT103_optionF(t *testing.T) {
+	fwm := readMessage(t, "fedWireMessage-CustomerTransferPlus.txt")
+	fwm.LocalInstrument = nil
+	fwm.PaymentNotification = nil
+
+	msg, warnings, err := NewMT103(fwm)
+	require.NoError(t, err)
+	require.Equal(t, "50F", msg.Fields[3].Tag)
+
+	got, back := roundTrip(t, msg, envelope(fwm))
+	requireRoundTrip(t, fwm, got, warnings, back)
+	require.Equal(t, wire.CustomerTransferPlus, got.BusinessFunctionCode.BusinessFunctionCode)
+}
+
+func TestMT103_unsupported(t *testing.T) {
+	_, _, err := NewMT103(readMessage(t, "fedWireMessage-BankTransfer.txt"))
+	require.ErrorIs(t, err, ErrUnsupportedMessage)
+
+	_, _, err = NewMT103(readMessage(t, "fedWireMessage-CustomerTransferPlusCOVS.txt"))
+	require.ErrorIs(t, err, ErrUnsupportedMessage)
+
+	fwm := readMessage(t, "fedWireMessage-CustomerTransfer.txt")
+	fwm.Beneficiary = nil
+	_, _, err = NewMT103(fwm)
+	require.ErrorIs(t, err, ErrMissingTag)
+}
+
+func TestMT103_read(t *testing.T) {
+	input := `{4:
+:20:INV-2024-0042
+:23B:SPRI
+:32A:240103USD1000000,
+:50K:/9876543210
+ACME CORP
+100 MARKET STREET
+SAN FRANCISCO CA
+:53A:BOFAUS3N
+:57A:CHASUS33
+:59:/123456789
+JANE DOE
+1 MAIN STREET
+:70:INVOICE 42
+:71A:OUR
+-}`
+	msg, err := Parse(MT103, input)
+	require.NoError(t, err)
+
+	fwm := readMessage(t, "fedWireMessage-CustomerTransfer.txt")
+	env := envelope(fwm)
+	env.IMAD.InputCycleDate = "20240103"
+
+	got, warnings, err := msg.FEDWireMessage(env)
+	require.NoError(t, err)
+
+	require.Equal(t, wire.CustomerTransfer, got.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, "INV-2024-0042", got.SenderReference.SenderReference)
+	require.Equal(t, "000100000000", got.Amount.Amount)
+	require.Equal(t, wire.Personal{
+		IdentificationCode: wire.DemandDepositAccountNumber,
+		Identifier:         "9876543210",
+		Name:               "ACME CORP",
+		Address:            wire.Address{AddressLineOne: "100 MARKET STREET", AddressLineTwo: "SAN FRANCISCO CA"},
+	}, got.Originator.Personal)
+	require.Equal(t, wire.SWIFTBankIdentifierCode, got.BeneficiaryFI.FinancialInstitution.IdentificationCode)
+	require.Equal(t, "CHASUS33", got.BeneficiaryFI.FinancialInstitution.Identifier)
+	require.Equal(t, "JANE DOE", got.Beneficiary.Personal.Name)
+	require.Equal(t, "INVOICE 42", got.OriginatorToBeneficiary.LineOne)
+	require.Nil(t, got.Charges)
+
+	require.Equal(t, []Warning{
+		{SwiftField: "23B", Reason: "bank operation code SPRI has no Fedwire equivalent"},
+		{SwiftField: "53A", Reason: "no Fedwire equivalent"},
+	}, warnings)
+
+	file := wire.NewFile()
+	file.AddFEDWireMessage(*got)
+	require.NoError(t, file.Validate())
+
+	_, _, err = (&Message{Type: MT103, Fields: msg.Fields[:2]}).FEDWireMessage(env)
+	require.ErrorIs(t, err, ErrMissingField)
+}
@@ -0,0 +1,281 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package mt
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/moov-io/wire"
+)
+
+// coverField describes how a sequence B field of an MT202COV is carried by a {7xxx} cover payment tag
+type coverField struct {
+	tag   string
+	field string
+	// options are the field's tags, the first being written when the SWIFT field tag isn't one of them
+	options []string
+	lines   int
+}
+
+var (
+	orderingCustomer        = coverField{wire.TagOrderingCustomer, "OrderingCustomer", []string{"50K", "50A", "50F"}, 5}
+	orderingInstitution     = coverField{wire.TagOrderingInstitution, "OrderingInstitution", []string{"52D", "52A"}, 5}
+	intermediaryInstitution = coverField{wire.TagIntermediaryInstitution, "IntermediaryInstitution", []string{"56D", "56A", "56C"}, 5}
+	institutionAccount      = coverField{wire.TagInstitutionAccount, "InstitutionAccount", []string{"57D", "57A", "57B", "57C"}, 5}
+	beneficiaryCustomer     = coverField{wire.TagBeneficiaryCustomer, "BeneficiaryCustomer", []string{"59", "59A", "59F"}, 5}
+	remittance              = coverField{wire.TagRemittance, "Remittance", []string{"70"}, 4}
+	senderToReceiver        = coverField{wire.TagSenderToReceiver, "SenderToReceiver", []string{"72"}, 6}
+	currencyInstructedAmt   = coverField{wire.TagCurrencyInstructedAmount, "CurrencyInstructedAmount", []string{"33B"}, 1}
+)
+
+// swiftTag returns the MT field tag of a {7xxx} SWIFT field tag, recording a Warning when it isn't one of the
+// field's options
+func (c *converter) swiftTag(cf coverField, swiftFieldTag string) string {
+	tag := strings.Trim(strings.TrimSpace(swiftFieldTag), ":")
+	for _, option := range cf.options {
+		if tag == option {
+			return tag
+		}
+	}
+	if tag != "" {
+		c.warn(cf.tag, cf.field+".SwiftFieldTag", "%s is not field %s, written as %s", tag, cf.options[0][:2], cf.options[0])
+	}
+	return cf.options[0]
+}
+
+// addCover appends the sequence B field of a {7xxx} cover payment tag to msg
+func (c *converter) addCover(msg *Message, cf coverField, cp *wire.CoverPayment) {
+	if cp == nil {
+		return
+	}
+	msg.add(c.swiftTag(cf, cp.SwiftFieldTag),
+		cp.SwiftLineOne, cp.SwiftLineTwo, cp.SwiftLineThree, cp.SwiftLineFour, cp.SwiftLineFive, cp.SwiftLineSix)
+}
+
+// coverPayment returns the {7xxx} cover payment tag of a sequence B field
+func (c *converter) coverPayment(cf coverField, f Field) wire.CoverPayment {
+	lines := c.lines(cf.tag, cf.field, f.Lines, cf.lines, lineWidth)
+	lines = append(lines, make([]string, 6-len(lines))...)
+	return wire.CoverPayment{
+		SwiftFieldTag: f.Tag,
+		SwiftLineOne:  lines[0], SwiftLineTwo: lines[1], SwiftLineThree: lines[2],
+		SwiftLineFour: lines[3], SwiftLineFive: lines[4], SwiftLineSix: lines[5],
+	}
+}
+
+// NewMT202COV converts a cover payment (CTP with a COVS {3610}) into the text block of an MT202COV.
+//
+// Sequence A is the cover payment itself: field 21 is {4320} BeneficiaryReference, 32A the IMAD cycle date and
+// {2000} amount, {5000} Originator the ordering institution, {4000} BeneficiaryIntermediaryFI the
+// intermediary, {4100} BeneficiaryFI the account with institution and {4200} Beneficiary the beneficiary
+// institution. Sequence B, the underlying customer credit transfer, is the {7033}-{7072} cover payment tags,
+// whose SWIFT field tags are the fields' tags.
+func NewMT202COV(fwm *wire.FEDWireMessage) (*Message, []Warning, error) {
+	if err := requireTags(fwm); err != nil {
+		return nil, nil, err
+	}
+	if bfc := fwm.BusinessFunctionCode.BusinessFunctionCode; bfc != wire.CustomerTransferPlus || fwm.LocalInstrument == nil ||
+		fwm.LocalInstrument.LocalInstrumentCode != wire.SequenceBCoverPaymentStructured {
+		return nil, nil, fmt.Errorf("%w: MT202COV carries cover payments (CTP with COVS)", ErrUnsupportedMessage)
+	}
+	if sub := fwm.TypeSubType.SubTypeCode; sub != wire.BasicFundsTransfer {
+		return nil, nil, fmt.Errorf("%w: MT202COV carries transfers, not sub type %s", ErrUnsupportedMessage, sub)
+	}
+	switch {
+	case fwm.Beneficiary == nil:
+		return nil, nil, missingTag(wire.TagBeneficiary, "Beneficiary")
+	case fwm.OrderingCustomer == nil:
+		return nil, nil, missingTag(wire.TagOrderingCustomer, "OrderingCustomer")
+	case fwm.BeneficiaryCustomer == nil:
+		return nil, nil, missingTag(wire.TagBeneficiaryCustomer, "BeneficiaryCustomer")
+	}
+
+	c := &converter{message: "MT202COV"}
+	c.envelope(fwm)
+	c.unmapped(fwm.TypeSubType.TypeCode != wire.FundsTransfer, wire.TagTypeSubType, "TypeSubType.TypeCode")
+
+	valueDate, err := valueDateAmount(fwm)
+	if err != nil {
+		return nil, nil, err
+	}
+	related := noReference
+	if ref := fwm.BeneficiaryReference; ref != nil && strings.TrimSpace(ref.BeneficiaryReference) != "" {
+		related = strings.TrimSpace(ref.BeneficiaryReference)
+	}
+
+	msg := &Message{Type: MT202COV}
+	msg.add("20", senderReference(fwm))
+	msg.add("21", related)
+	msg.add("32A", valueDate)
+	if fwm.Originator != nil {
+		option, lines := c.personalLines(wire.TagOriginator, "Originator.Personal", fwm.Originator.Personal)
+		if option == "" {
+			option = "D"
+		}
+		msg.add("52"+option, lines...)
+	}
+	if fi := fwm.BeneficiaryIntermediaryFI; fi != nil {
+		option, lines := c.institutionLines(wire.TagBeneficiaryIntermediaryFI, "BeneficiaryIntermediaryFI.FinancialInstitution", fi.FinancialInstitution)
+		msg.add("56"+option, lines...)
+	}
+	if fi := fwm.BeneficiaryFI; fi != nil {
+		option, lines := c.institutionLines(wire.TagBeneficiaryFI, "BeneficiaryFI.FinancialInstitution", fi.FinancialInstitution)
+		msg.add("57"+option, lines...)
+	}
+	option, lines := c.personalLines(wire.TagBeneficiary, "Beneficiary.Personal", fwm.Beneficiary.Personal)
+	if option == "" {
+		option = "D"
+	}
+	msg.add("58"+option, lines...)
+	msg.add("72", fiToFiLines(fwm)...)
+
+	c.addCover(msg, orderingCustomer, &fwm.OrderingCustomer.CoverPayment)
+	if oi := fwm.OrderingInstitution; oi != nil {
+		c.addCover(msg, orderingInstitution, &oi.CoverPayment)
+	}
+	if ii := fwm.IntermediaryInstitution; ii != nil {
+		c.addCover(msg, intermediaryInstitution, &ii.CoverPayment)
+	}
+	if ia := fwm.InstitutionAccount; ia != nil {
+		c.addCover(msg, institutionAccount, &ia.CoverPayment)
+	}
+	c.addCover(msg, beneficiaryCustomer, &fwm.BeneficiaryCustomer.CoverPayment)
+	if ri := fwm.Remittance; ri != nil {
+		c.addCover(msg, remittance, &ri.CoverPayment)
+	}
+	if sr := fwm.SenderToReceiver; sr != nil {
+		c.addCover(msg, senderToReceiver, &sr.CoverPayment)
+	}
+	if cia := fwm.CurrencyInstructedAmount; cia != nil {
+		amount := strings.TrimLeft(strings.TrimSpace(cia.Amount), "0")
+		if amount == "" || strings.HasPrefix(amount, ",") {
+			amount = "0" + amount
+		}
+		msg.add(c.swiftTag(currencyInstructedAmt, cia.SwiftFieldTag), currencyUSD+amount)
+	}
+
+	c.unmapped(fwm.PreviousMessageIdentifier != nil, wire.TagPreviousMessageIdentifier, "PreviousMessageIdentifier")
+	c.unmapped(fwm.PaymentNotification != nil, wire.TagPaymentNotification, "PaymentNotification")
+	c.unmapped(fwm.Charges != nil, wire.TagCharges, "Charges")
+	c.unmapped(fwm.InstructedAmount != nil, wire.TagInstructedAmount, "InstructedAmount")
+	c.unmapped(fwm.ExchangeRate != nil, wire.TagExchangeRate, "ExchangeRate")
+	c.unmapped(fwm.OriginatorOptionF != nil, wire.TagOriginatorOptionF, "OriginatorOptionF")
+	c.unmapped(fwm.OriginatorFI != nil, wire.TagOriginatorFI, "OriginatorFI")
+	c.unmapped(fwm.InstructingFI != nil, wire.TagInstructingFI, "InstructingFI")
+	c.unmapped(fwm.OriginatorToBeneficiary != nil, wire.TagOriginatorToBeneficiary, "OriginatorToBeneficiary")
+	c.fiToFiInformation(fwm)
+	c.remittanceTags(fwm)
+
+	return msg, c.warnings, nil
+}
+
+// mt202COV converts an MT202COV text block into a cover payment. Sequence B starts at field 50a, the ordering
+// customer.
+func (m *Message) mt202COV(env Envelope) (*wire.FEDWireMessage, []Warning, error) {
+	c := &converter{message: "MT202COV"}
+	fwm := newMessage(env, wire.CustomerTransferPlus)
+	fwm.LocalInstrument = wire.NewLocalInstrument()
+	fwm.LocalInstrument.LocalInstrumentCode = wire.SequenceBCoverPaymentStructured
+
+	var valueDate, beneficiary, sequenceB bool
+	for _, f := range m.Fields {
+		if strings.HasPrefix(f.Tag, "50") {
+			sequenceB = true
+		}
+		if sequenceB {
+			c.setCover(fwm, f)
+			continue
+		}
+		switch f.Tag {
+		case "20":
+			c.setSenderReference(fwm, f)
+		case "21":
+			if ref := strings.Join(f.Lines, ""); ref != "" && ref != noReference {
+				fwm.BeneficiaryReference = wire.NewBeneficiaryReference()
+				fwm.BeneficiaryReference.BeneficiaryReference = c.text(wire.TagBeneficiaryReference, "BeneficiaryReference.BeneficiaryReference", ref, 16)
+			}
+		case "32A":
+			if err := c.setValueDateAmount(fwm, f); err != nil {
+				return nil, nil, err
+			}
+			valueDate = true
+		case "52A", "52D":
+			fwm.Originator = wire.NewOriginator()
+			fwm.Originator.Personal = c.personal(wire.TagOriginator, "Originator.Personal", f)
+		case "56A", "56D":
+			fwm.BeneficiaryIntermediaryFI = wire.NewBeneficiaryIntermediaryFI()
+			fwm.BeneficiaryIntermediaryFI.FinancialInstitution = c.financialInstitution(wire.TagBeneficiaryIntermediaryFI, "BeneficiaryIntermediaryFI.FinancialInstitution", f)
+		case "57A", "57B", "57D":
+			fwm.BeneficiaryFI = wire.NewBeneficiaryFI()
+			fwm.BeneficiaryFI.FinancialInstitution = c.financialInstitution(wire.TagBeneficiaryFI, "BeneficiaryFI.FinancialInstitution", f)
+		case "58A", "58D":
+			fwm.Beneficiary = wire.NewBeneficiary()
+			fwm.Beneficiary.Personal = c.personal(wire.TagBeneficiary, "Beneficiary.Personal", f)
+			beneficiary = true
+		case "72":
+			c.setFIToFI(fwm, f)
+		default:
+			c.warnField(f.Tag, "no Fedwire equivalent")
+		}
+	}
+	switch {
+	case !valueDate:
+		return nil, nil, missingField(c.message, "32A")
+	case !beneficiary:
+		return nil, nil, missingField(c.message, "58a")
+	case fwm.OrderingCustomer == nil:
+		return nil, nil, missingField(c.message, "50a")
+	case fwm.BeneficiaryCustomer == nil:
+		return nil, nil, missingField(c.message, "59a")
+	}
+
+	return fwm, c.warnings, nil
+}
+
+// setCover sets the {7xxx} cover payment tag of a sequence B field on fwm
+func (c *converter) setCover(fwm *wire.FEDWireMessage, f Field) {
+	switch f.Tag[:2] {
+	case "50":
+		fwm.OrderingCustomer = wire.NewOrderingCustomer()
+		fwm.OrderingCustomer.CoverPayment = c.coverPayment(orderingCustomer, f)
+	case "52":
+		fwm.OrderingInstitution = wire.NewOrderingInstitution()
+		fwm.OrderingInstitution.CoverPayment = c.coverPayment(orderingInstitution, f)
+	case "56":
+		fwm.IntermediaryInstitution = wire.NewIntermediaryInstitution()
+		fwm.IntermediaryInstitution.CoverPayment = c.coverPayment(intermediaryInstitution, f)
+	case "57":
+		fwm.InstitutionAccount = wire.NewInstitutionAccount()
+		fwm.InstitutionAccount.CoverPayment = c.coverPayment(institutionAccount, f)
+	case "59":
+		fwm.BeneficiaryCustomer = wire.NewBeneficiaryCustomer()
+		fwm.BeneficiaryCustomer.CoverPayment = c.coverPayment(beneficiaryCustomer, f)
+	case "70":
+		fwm.Remittance = wire.NewRemittance()
+		fwm.Remittance.CoverPayment = c.coverPayment(remittance, f)
+	case "72":
+		fwm.SenderToReceiver = wire.NewSenderToReceiver()
+		fwm.SenderToReceiver.CoverPayment = c.coverPayment(senderToReceiver, f)
+	case "33":
+		value := strings.Join(f.Lines, "")
+		if len(value) < 4 {
+			c.warnField(f.Tag, "%q is not a currency and amount", value)
+			return
+		}
+		if currency := value[:3]; currency != currencyUSD {
+			c.warn(wire.TagCurrencyInstructedAmount, "CurrencyInstructedAmount.Amount", "currency %s has no Fedwire equivalent, cover payments are in US dollars", currency)
+		}
+		amount := value[3:]
+		if len(amount) < 18 {
+			amount = strings.Repeat("0", 18-len(amount)) + amount
+		}
+		fwm.CurrencyInstructedAmount = wire.NewCurrencyInstructedAmount()
+		fwm.CurrencyInstructedAmount.SwiftFieldTag = f.Tag
+		fwm.CurrencyInstructedAmount.Amount = c.text(wire.TagCurrencyInstructedAmount, "CurrencyInstructedAmount.Amount", amount, 18)
+	default:
+		c.warnField(f.Tag, "no Fedwire equivalent")
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package mt
+
+import (
+	"testing"
+
+	"github.com/moov-io/wire"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMT202COV_coverPayment(t *testing.T) {
+	fwm := readMessage(t, "fedWireMessage-CustomerTransferPlusCOVS.txt")
+	fwm.CurrencyInstructedAmount.SwiftFieldTag = "33B"
+	fwm.OrderingCustomer.CoverPayment.SwiftFieldTag = "50K"
+	fwm.OrderingInstitution.CoverPayment.SwiftFieldTag = "52D"
+	fwm.IntermediaryInstitution.CoverPayment.SwiftFieldTag = "56D"
+	fwm.InstitutionAccount.CoverPayment.SwiftFieldTag = "57D"
+	fwm.BeneficiaryCustomer.CoverPayment.SwiftFieldTag = "59"
+	fwm.Remittance.CoverPayment.SwiftFieldTag = "70"
+	fwm.SenderToReceiver.CoverPayment.SwiftFieldTag = "72"
+
+	msg, warnings, err := NewMT202COV(fwm)
+	require.NoError(t, err)
+
+	var tags []string
+	for _, f := range msg.Fields {
+		tags = append(tags, f.Tag)
+	}
+	require.Equal(t, []string{"20", "21", "32A", "52D", "56D", "57D", "58D", "72", "50K", "52D", "56D", "57D", "59", "70", "72", "33B"}, tags)
+	require.Equal(t, []string{"Reference"}, msg.Fields[1].Lines)
+	require.Equal(t, []string{"USD1500,49"}, msg.Fields[15].Lines)
+
+	got, back := roundTrip(t, msg, envelope(fwm))
+	requireRoundTrip(t, fwm, got, warnings, back)
+	require.Equal(t, wire.SequenceBCoverPaymentStructured, got.LocalInstrument.LocalInstrumentCode)
+
+	warned := make(map[string]bool)
+	for _, w := range warnings {
+		warned[w.Tag] = true
+	}
+	for _, tag := range []string{wire.TagOrderingCustomer, wire.TagBeneficiaryCustomer, wire.TagCurrencyInstructedAmount, wire.TagBeneficiaryReference} {
+		require.False(t, warned[tag], tag)
+	}
+	for _, tag := range []string{wire.TagOriginatorOptionF, wire.TagOriginatorFI, wire.TagInstructingFI, wire.TagPreviousMessageIdentifier} {
+		require.True(t, warned[tag], tag)
+	}
+}
+
+func TestMT202COV_swiftFieldTag(t *testing.T) {
+	fwm := readMessage(t, "fedWireMessage-CustomerTransferPlusCOVS.txt")
+
+	msg, warnings, err := NewMT202COV(fwm)
+	require.NoError(t, err)
+	require.Contains(t, warnings, Warning{
+		Tag: wire.TagOrderingCustomer, Field: "OrderingCustomer.SwiftFieldTag", Reason: "Swift is not field 50, written as 50K",
+	})
+
+	got, back := roundTrip(t, msg, envelope(fwm))
+	requireRoundTrip(t, fwm, got, warnings, back)
+	require.Equal(t, "50K", got.OrderingCustomer.CoverPayment.SwiftFieldTag)
+}
+
+func TestMT202COV_unsupported(t *testing.T) {
+	_, _, err := NewMT202COV(readMessage(t, "fedWireMessage-CustomerTransfer.txt"))
+	require.ErrorIs(t, err, ErrUnsupportedMessage)
+
+	fwm := readMessage(t, "fedWireMessage-CustomerTransferPlusCOVS.txt")
+	fwm.BeneficiaryCustomer = nil
+	_, _, err = NewMT202COV(fwm)
+	require.ErrorIs(t, err, ErrMissingTag)
+}
+
+func TestMT202COV_read(t *testing.T) {
+	input := `{4:
+:20:COV-0042
+:21:INV-2024-0042
+:32A:240103USD1000000,
+:52A:BOFAUS3N
+:58A:CHASUS33
+:50K:/9876543210
+ACME CORP
+:59:/123456789
+JANE DOE
+:70:INVOICE 42
+:33B:EUR920000,
+-}`
+	msg, err := Parse(MT202COV, input)
+	require.NoError(t, err)
+
+	fwm := readMessage(t, "fedWireMessage-CustomerTransferPlusCOVS.txt")
+	env := envelope(fwm)
+	env.IMAD.InputCycleDate = "20240103"
+
+	got, warnings, err := msg.FEDWireMessage(env)
+	require.NoError(t, err)
+
+	require.Equal(t, wire.CustomerTransferPlus, got.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, "INV-2024-0042", got.BeneficiaryReference.BeneficiaryReference)
+	require.Equal(t, "000100000000", got.Amount.Amount)
+	require.Equal(t, "BOFAUS3N", got.Originator.Personal.Identifier)
+	require.Equal(t, "CHASUS33", got.Beneficiary.Personal.Identifier)
+	require.Equal(t, wire.CoverPayment{SwiftFieldTag: "50K", SwiftLineOne: "/9876543210", SwiftLineTwo: "ACME CORP"}, got.OrderingCustomer.CoverPayment)
+	require.Equal(t, "INVOICE 42", got.Remittance.CoverPayment.SwiftLineOne)
+	require.Equal(t, "000000000920000,", got.CurrencyInstructedAmount.Amount[2:])
+
+	require.Equal(t, []Warning{
+		{Tag: wire.TagCurrencyInstructedAmount, Field: "CurrencyInstructedAmount.Amount", Reason: "currency EUR has no Fedwire equivalent, cover payments are in US dollars"},
+	}, warnings)
+
+	file := wire.NewFile()
+	file.AddFEDWireMessage(*got)
+	require.NoError(t, file.Validate())
+
+	_, _, err = (&Message{Type: MT202COV, Fields: msg.Fields[:5]}).FEDWireMessage(env)
+	require.ErrorIs(t, err, ErrMissingField)
+}
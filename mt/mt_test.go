@@ -0,0 +1,155 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package mt
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/moov-io/wire"
+	"github.com/stretchr/testify/require"
+)
+
+// readMessage reads the only FEDWireMessage of a file in test/testdata
+func readMessage(t *testing.T, name string) *wire.FEDWireMessage {
+	t.Helper()
+
+	fd, err := os.Open(filepath.Join("..", "test", "testdata", name))
+	require.NoError(t, err)
+	defer fd.Close()
+
+	file, err := wire.NewReader(fd).Read()
+	require.NoError(t, err)
+	require.Len(t, file.FEDWireMessages, 1)
+	return &file.FEDWireMessages[0]
+}
+
+// envelope returns the Envelope of fwm
+func envelope(fwm *wire.FEDWireMessage) Envelope {
+	return Envelope{
+		SenderSupplied: fwm.SenderSupplied,
+		IMAD:           fwm.InputMessageAccountabilityData,
+		Sender:         fwm.SenderDepositoryInstitution,
+		Receiver:       fwm.ReceiverDepositoryInstitution,
+	}
+}
+
+// tagLines writes fwm and returns its records by tag. Writing validates fwm as well.
+func tagLines(t *testing.T, fwm *wire.FEDWireMessage) map[string]string {
+	t.Helper()
+
+	file := wire.NewFile()
+	file.AddFEDWireMessage(*fwm)
+
+	var buf bytes.Buffer
+	require.NoError(t, wire.NewWriter(&buf, wire.VariableLengthFields(true)).Write(file))
+
+	lines := make(map[string]string)
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		lines[line[:6]] = line
+	}
+	return lines
+}
+
+// requireRoundTrip checks every tag of orig and got is the same, except the tags a Warning was returned for
+func requireRoundTrip(t *testing.T, orig, got *wire.FEDWireMessage, warnings ...[]Warning) {
+	t.Helper()
+
+	warned := make(map[string]bool)
+	for _, ws := range warnings {
+		for _, w := range ws {
+			warned[w.Tag] = true
+		}
+	}
+	want, have := tagLines(t, orig), tagLines(t, got)
+	for tag, line := range want {
+		if !warned[tag] {
+			require.Equal(t, line, have[tag], "tag %s", tag)
+		}
+	}
+	for tag, line := range have {
+		if !warned[tag] {
+			require.Equal(t, want[tag], line, "tag %s", tag)
+		}
+	}
+}
+
+// roundTrip writes msg, parses the text block and converts it back into a FEDWireMessage
+func roundTrip(t *testing.T, msg *Message, env Envelope) (*wire.FEDWireMessage, []Warning) {
+	t.Helper()
+
+	read, err := Parse(msg.Type, msg.String())
+	require.NoError(t, err)
+	require.Equal(t, msg, read)
+
+	fwm, warnings, err := read.FEDWireMessage(env)
+	require.NoError(t, err)
+	return fwm, warnings
+}
+
+func TestWarning_String(t *testing.T) {
+	w := Warning{Tag: wire.TagFIReceiverFI, Field: "FIReceiverFI", Reason: "no MT103 equivalent"}
+	require.Equal(t, "{6100} FIReceiverFI: no MT103 equivalent", w.String())
+
+	w = Warning{SwiftField: "53A", Reason: "no Fedwire equivalent"}
+	require.Equal(t, ":53A: no Fedwire equivalent", w.String())
+}
+
+func TestAmounts(t *testing.T) {
+	amt, err := swiftAmount("000001234567")
+	require.NoError(t, err)
+	require.Equal(t, "12345,67", amt)
+
+	amt, err = swiftAmount("000000000005")
+	require.NoError(t, err)
+	require.Equal(t, "0,05", amt)
+
+	_, err = swiftAmount("12AB")
+	require.ErrorIs(t, err, ErrInvalidAmount)
+
+	amt, err = impliedAmount("12345,6")
+	require.NoError(t, err)
+	require.Equal(t, "000001234560", amt)
+
+	amt, err = impliedAmount("100,")
+	require.NoError(t, err)
+	require.Equal(t, "000000010000", amt)
+
+	_, err = impliedAmount("1,234")
+	require.ErrorIs(t, err, ErrInvalidAmount)
+}
+
+func TestParse(t *testing.T) {
+	input := "{1:F01BANKUS33AXXX0000000000}{2:I103BANKGB22XXXXN}{4:\r\n" +
+		":20:REF1\r\n" +
+		":59:/12345\r\n" +
+		"JOHN DOE\r\n" +
+		"1 MAIN STREET\r\n" +
+		"-}{5:{CHK:123456789ABC}}"
+
+	msg, err := Parse(MT103, input)
+	require.NoError(t, err)
+	require.Equal(t, &Message{Type: MT103, Fields: []Field{
+		{Tag: "20", Lines: []string{"REF1"}},
+		{Tag: "59", Lines: []string{"/12345", "JOHN DOE", "1 MAIN STREET"}},
+	}}, msg)
+	require.Equal(t, "{4:\r\n:20:REF1\r\n:59:/12345\r\nJOHN DOE\r\n1 MAIN STREET\r\n-}", msg.String())
+
+	msg, err = Parse(MT103, ":20:REF1\n:23B:CRED\n")
+	require.NoError(t, err)
+	require.Len(t, msg.Fields, 2)
+
+	_, err = Parse(MT103, "REF1\n:20:REF1")
+	require.ErrorIs(t, err, ErrMalformedText)
+
+	_, err = Parse(MT103, "{4:\r\n-}")
+	require.ErrorIs(t, err, ErrMalformedText)
+
+	_, err = Parse("940", ":20:REF1")
+	require.ErrorIs(t, err, ErrUnsupportedMessage)
+}
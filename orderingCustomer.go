@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // OrderingCustomer is the ordering customer
@@ -136,31 +138,35 @@ func (oc *OrderingCustomer) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on OrderingCustomer and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (oc *OrderingCustomer) Validate() error {
+	var errs base.ErrorList
 	if err := oc.fieldInclusion(); err != nil {
-		return err
+		errs.Add(err)
 	}
 	if oc.tag != TagOrderingCustomer {
-		return fieldError("tag", ErrValidTagForType, oc.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, oc.tag))
 	}
 	if err := oc.isAlphanumeric(oc.CoverPayment.SwiftFieldTag); err != nil {
-		return fieldError("SwiftFieldTag", err, oc.CoverPayment.SwiftFieldTag)
+		errs.Add(fieldError("SwiftFieldTag", err, oc.CoverPayment.SwiftFieldTag))
 	}
 	if err := oc.isAlphanumeric(oc.CoverPayment.SwiftLineOne); err != nil {
-		return fieldError("SwiftLineOne", err, oc.CoverPayment.SwiftLineOne)
+		errs.Add(fieldError("SwiftLineOne", err, oc.CoverPayment.SwiftLineOne))
 	}
 	if err := oc.isAlphanumeric(oc.CoverPayment.SwiftLineTwo); err != nil {
-		return fieldError("SwiftLineTwo", err, oc.CoverPayment.SwiftLineTwo)
+		errs.Add(fieldError("SwiftLineTwo", err, oc.CoverPayment.SwiftLineTwo))
 	}
 	if err := oc.isAlphanumeric(oc.CoverPayment.SwiftLineThree); err != nil {
-		return fieldError("SwiftLineThree", err, oc.CoverPayment.SwiftLineThree)
+		errs.Add(fieldError("SwiftLineThree", err, oc.CoverPayment.SwiftLineThree))
 	}
 	if err := oc.isAlphanumeric(oc.CoverPayment.SwiftLineFour); err != nil {
-		return fieldError("SwiftLineFour", err, oc.CoverPayment.SwiftLineFour)
+		errs.Add(fieldError("SwiftLineFour", err, oc.CoverPayment.SwiftLineFour))
 	}
 	if err := oc.isAlphanumeric(oc.CoverPayment.SwiftLineFive); err != nil {
-		return fieldError("SwiftLineFive", err, oc.CoverPayment.SwiftLineFive)
+		errs.Add(fieldError("SwiftLineFive", err, oc.CoverPayment.SwiftLineFive))
 	}
-	return nil
+	if err := oc.validateSwiftParty(oc.CoverPayment); err != nil {
+		errs.Add(err)
+	}
+	return oc.collected(errs)
 }
 
 // fieldInclusion validate mandatory fields. If fields are
@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // OrderingInstitution is the ordering institution
@@ -136,31 +138,35 @@ func (oi *OrderingInstitution) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on OrderingInstitution and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (oi *OrderingInstitution) Validate() error {
+	var errs base.ErrorList
 	if err := oi.fieldInclusion(); err != nil {
-		return err
+		errs.Add(err)
 	}
 	if oi.tag != TagOrderingInstitution {
-		return fieldError("tag", ErrValidTagForType, oi.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, oi.tag))
 	}
 	if err := oi.isAlphanumeric(oi.CoverPayment.SwiftFieldTag); err != nil {
-		return fieldError("SwiftFieldTag", err, oi.CoverPayment.SwiftFieldTag)
+		errs.Add(fieldError("SwiftFieldTag", err, oi.CoverPayment.SwiftFieldTag))
 	}
 	if err := oi.isAlphanumeric(oi.CoverPayment.SwiftLineOne); err != nil {
-		return fieldError("SwiftLineOne", err, oi.CoverPayment.SwiftLineOne)
+		errs.Add(fieldError("SwiftLineOne", err, oi.CoverPayment.SwiftLineOne))
 	}
 	if err := oi.isAlphanumeric(oi.CoverPayment.SwiftLineTwo); err != nil {
-		return fieldError("SwiftLineTwo", err, oi.CoverPayment.SwiftLineTwo)
+		errs.Add(fieldError("SwiftLineTwo", err, oi.CoverPayment.SwiftLineTwo))
 	}
 	if err := oi.isAlphanumeric(oi.CoverPayment.SwiftLineThree); err != nil {
-		return fieldError("SwiftLineThree", err, oi.CoverPayment.SwiftLineThree)
+		errs.Add(fieldError("SwiftLineThree", err, oi.CoverPayment.SwiftLineThree))
 	}
 	if err := oi.isAlphanumeric(oi.CoverPayment.SwiftLineFour); err != nil {
-		return fieldError("SwiftLineFour", err, oi.CoverPayment.SwiftLineFour)
+		errs.Add(fieldError("SwiftLineFour", err, oi.CoverPayment.SwiftLineFour))
 	}
 	if err := oi.isAlphanumeric(oi.CoverPayment.SwiftLineFive); err != nil {
-		return fieldError("SwiftLineFive", err, oi.CoverPayment.SwiftLineFive)
+		errs.Add(fieldError("SwiftLineFive", err, oi.CoverPayment.SwiftLineFive))
 	}
-	return nil
+	if err := oi.validateSwiftParty(oi.CoverPayment); err != nil {
+		errs.Add(err)
+	}
+	return oi.collected(errs)
 }
 
 // fieldInclusion validate mandatory fields. If fields are
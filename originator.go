@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // Originator is the originator of the wire
@@ -130,12 +132,13 @@ func (o *Originator) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on Originator and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (o *Originator) Validate() error {
+	var errs base.ErrorList
 	if o.tag != TagOriginator {
-		return fieldError("tag", ErrValidTagForType, o.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, o.tag))
 	}
 
 	if err := o.fieldInclusion(); err != nil {
-		return err
+		errs.Add(err)
 	}
 
 	// Per FAIM 3.0.6, Originator ID code is optional
@@ -144,27 +147,30 @@ func (o *Originator) Validate() error {
 	if o.Personal.IdentificationCode != "" {
 		// If it is present, confirm it is a valid code
 		if err := o.isIdentificationCode(o.Personal.IdentificationCode); err != nil {
-			return fieldError("IdentificationCode", err, o.Personal.IdentificationCode)
+			errs.Add(fieldError("IdentificationCode", err, o.Personal.IdentificationCode))
 		}
 		// Identifier text must only contain allowed characters
 		if err := o.isAlphanumeric(o.Personal.Identifier); err != nil {
-			return fieldError("Identifier", err, o.Personal.Identifier)
+			errs.Add(fieldError("Identifier", err, o.Personal.Identifier))
+		}
+		if err := o.isSWIFTIdentifier(o.Personal.IdentificationCode, o.Personal.Identifier); err != nil {
+			errs.Add(fieldError("Identifier", err, o.Personal.Identifier))
 		}
 	}
 
 	if err := o.isAlphanumeric(o.Personal.Name); err != nil {
-		return fieldError("Name", err, o.Personal.Name)
+		errs.Add(fieldError("Name", err, o.Personal.Name))
 	}
 	if err := o.isAlphanumeric(o.Personal.Address.AddressLineOne); err != nil {
-		return fieldError("AddressLineOne", err, o.Personal.Address.AddressLineOne)
+		errs.Add(fieldError("AddressLineOne", err, o.Personal.Address.AddressLineOne))
 	}
 	if err := o.isAlphanumeric(o.Personal.Address.AddressLineTwo); err != nil {
-		return fieldError("AddressLineTwo", err, o.Personal.Address.AddressLineTwo)
+		errs.Add(fieldError("AddressLineTwo", err, o.Personal.Address.AddressLineTwo))
 	}
 	if err := o.isAlphanumeric(o.Personal.Address.AddressLineThree); err != nil {
-		return fieldError("AddressLineThree", err, o.Personal.Address.AddressLineThree)
+		errs.Add(fieldError("AddressLineThree", err, o.Personal.Address.AddressLineThree))
 	}
-	return nil
+	return o.collected(errs)
 }
 
 // fieldInclusion validate mandatory fields. If fields are
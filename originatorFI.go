@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // OriginatorFI is the originator Financial Institution
@@ -135,15 +137,21 @@ func (ofi *OriginatorFI) Format(options FormatOptions) string {
 // The first error encountered is returned and stops that parsing.
 // If ID Code is present, Identifier is mandatory and vice versa.
 func (ofi *OriginatorFI) Validate() error {
+	var errs base.ErrorList
 	if ofi.tag != TagOriginatorFI {
-		return fieldError("tag", ErrValidTagForType, ofi.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, ofi.tag))
 	}
 
 	if err := ofi.FinancialInstitution.Validate(); err != nil {
-		return err
+		errs.Add(err)
 	}
 
-	return nil
+	return ofi.FinancialInstitution.collected(errs)
+}
+
+// setValidateOpts sets the ValidateOpts the checks of the FinancialInstitution follow
+func (ofi *OriginatorFI) setValidateOpts(opts *ValidateOpts) {
+	ofi.FinancialInstitution.setValidateOpts(opts)
 }
 
 // IdentificationCodeField gets a string of the IdentificationCode field
@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // OriginatorOptionF is originator option F information
@@ -192,25 +194,26 @@ func (oof *OriginatorOptionF) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on OriginatorOptionF and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (oof *OriginatorOptionF) Validate() error {
+	var errs base.ErrorList
 	if err := oof.fieldInclusion(); err != nil {
-		return err
+		errs.Add(err)
 	}
 	if err := oof.validatePartyIdentifier(oof.PartyIdentifier); err != nil {
-		return fieldError("PartyIdentifier", err, oof.PartyIdentifier)
+		errs.Add(fieldError("PartyIdentifier", err, oof.PartyIdentifier))
 	}
 	if err := oof.validateOptionFName(oof.Name); err != nil {
-		return fieldError("Name", err, oof.Name)
+		errs.Add(fieldError("Name", err, oof.Name))
 	}
 	if err := oof.validateOptionFLine(oof.LineOne); err != nil {
-		return fieldError("LineOne", err, oof.LineOne)
+		errs.Add(fieldError("LineOne", err, oof.LineOne))
 	}
 	if err := oof.validateOptionFLine(oof.LineTwo); err != nil {
-		return fieldError("LineTwo", err, oof.LineTwo)
+		errs.Add(fieldError("LineTwo", err, oof.LineTwo))
 	}
 	if err := oof.validateOptionFLine(oof.LineThree); err != nil {
-		return fieldError("LineThree", err, oof.LineThree)
+		errs.Add(fieldError("LineThree", err, oof.LineThree))
 	}
-	return nil
+	return oof.collected(errs)
 }
 
 // fieldInclusion validate mandatory fields. If fields are
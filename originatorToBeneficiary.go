@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // OriginatorToBeneficiary is the OriginatorToBeneficiary of the wire
@@ -127,22 +129,23 @@ func (ob *OriginatorToBeneficiary) Format(options FormatOptions) string {
 // The first error encountered is returned and stops that parsing.
 // See latest version of the FAIM manual for Line Limits for Tags {6000} to {6500}.
 func (ob *OriginatorToBeneficiary) Validate() error {
+	var errs base.ErrorList
 	if ob.tag != TagOriginatorToBeneficiary {
-		return fieldError("tag", ErrValidTagForType, ob.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, ob.tag))
 	}
 	if err := ob.isAlphanumeric(ob.LineOne); err != nil {
-		return fieldError("LineOne", err, ob.LineOne)
+		errs.Add(fieldError("LineOne", err, ob.LineOne))
 	}
 	if err := ob.isAlphanumeric(ob.LineTwo); err != nil {
-		return fieldError("LineTwo", err, ob.LineTwo)
+		errs.Add(fieldError("LineTwo", err, ob.LineTwo))
 	}
 	if err := ob.isAlphanumeric(ob.LineThree); err != nil {
-		return fieldError("LineThree", err, ob.LineThree)
+		errs.Add(fieldError("LineThree", err, ob.LineThree))
 	}
 	if err := ob.isAlphanumeric(ob.LineFour); err != nil {
-		return fieldError("LineFour", err, ob.LineFour)
+		errs.Add(fieldError("LineFour", err, ob.LineFour))
 	}
-	return nil
+	return ob.collected(errs)
 }
 
 // FormatLineOne returns LineOne formatted according to the FormatOptions
@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // PaymentNotification is the PaymentNotification of the wire
@@ -158,31 +160,32 @@ func (pn *PaymentNotification) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on PaymentNotification and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (pn *PaymentNotification) Validate() error {
+	var errs base.ErrorList
 	if pn.tag != TagPaymentNotification {
-		return fieldError("tag", ErrValidTagForType, pn.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, pn.tag))
 	}
 	if err := pn.isNumeric(pn.PaymentNotificationIndicator); err != nil {
-		return fieldError("PaymentNotificationIndicator", err, pn.PaymentNotificationIndicator)
+		errs.Add(fieldError("PaymentNotificationIndicator", err, pn.PaymentNotificationIndicator))
 	}
 	if err := pn.isAlphanumeric(pn.ContactNotificationElectronicAddress); err != nil {
-		return fieldError("ContactNotificationElectronicAddress", err, pn.ContactNotificationElectronicAddress)
+		errs.Add(fieldError("ContactNotificationElectronicAddress", err, pn.ContactNotificationElectronicAddress))
 	}
 	if err := pn.isAlphanumeric(pn.ContactName); err != nil {
-		return fieldError("ContactName", err, pn.ContactName)
+		errs.Add(fieldError("ContactName", err, pn.ContactName))
 	}
 	if err := pn.isAlphanumeric(pn.ContactPhoneNumber); err != nil {
-		return fieldError("ContactPhoneNumber", err, pn.ContactPhoneNumber)
+		errs.Add(fieldError("ContactPhoneNumber", err, pn.ContactPhoneNumber))
 	}
 	if err := pn.isAlphanumeric(pn.ContactMobileNumber); err != nil {
-		return fieldError("ContactMobileNumber", err, pn.ContactMobileNumber)
+		errs.Add(fieldError("ContactMobileNumber", err, pn.ContactMobileNumber))
 	}
 	if err := pn.isAlphanumeric(pn.ContactFaxNumber); err != nil {
-		return fieldError("FaxNumber", err, pn.ContactFaxNumber)
+		errs.Add(fieldError("FaxNumber", err, pn.ContactFaxNumber))
 	}
 	if err := pn.isAlphanumeric(pn.EndToEndIdentification); err != nil {
-		return fieldError("EndToEndIdentification", err, pn.EndToEndIdentification)
+		errs.Add(fieldError("EndToEndIdentification", err, pn.EndToEndIdentification))
 	}
-	return nil
+	return pn.collected(errs)
 }
 
 // PaymentNotificationIndicatorField gets a string of PaymentNotificationIndicator field
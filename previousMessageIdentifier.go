@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // PreviousMessageIdentifier is the PreviousMessageIdentifier of the wire
@@ -92,13 +94,14 @@ func (pmi *PreviousMessageIdentifier) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on PreviousMessageIdentifier and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (pmi *PreviousMessageIdentifier) Validate() error {
+	var errs base.ErrorList
 	if pmi.tag != TagPreviousMessageIdentifier {
-		return fieldError("tag", ErrValidTagForType, pmi.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, pmi.tag))
 	}
 	if err := pmi.isAlphanumeric(pmi.PreviousMessageIdentifier); err != nil {
-		return fieldError("PreviousMessageIdentifier", err, pmi.PreviousMessageIdentifier)
+		errs.Add(fieldError("PreviousMessageIdentifier", err, pmi.PreviousMessageIdentifier))
 	}
-	return nil
+	return pmi.collected(errs)
 }
 
 // PreviousMessageIdentifierField gets a string of PreviousMessageIdentifier field
@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // PrimaryRemittanceDocument is primary remittance document
@@ -122,25 +124,26 @@ func (prd *PrimaryRemittanceDocument) Format(options FormatOptions) string {
 // Document Type Code and Document Identification Number are mandatory for each set of remittance data.
 // Proprietary Document Type Code is mandatory for Document Type Code PROP; otherwise not permitted.
 func (prd *PrimaryRemittanceDocument) Validate() error {
+	var errs base.ErrorList
 	if err := prd.fieldInclusion(); err != nil {
-		return err
+		errs.Add(err)
 	}
 	if prd.tag != TagPrimaryRemittanceDocument {
-		return fieldError("tag", ErrValidTagForType, prd.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, prd.tag))
 	}
 	if err := prd.isDocumentTypeCode(prd.DocumentTypeCode); err != nil {
-		return fieldError("DocumentTypeCode", err, prd.DocumentTypeCode)
+		errs.Add(fieldError("DocumentTypeCode", err, prd.DocumentTypeCode))
 	}
 	if err := prd.isAlphanumeric(prd.ProprietaryDocumentTypeCode); err != nil {
-		return fieldError("ProprietaryDocumentTypeCode", err, prd.ProprietaryDocumentTypeCode)
+		errs.Add(fieldError("ProprietaryDocumentTypeCode", err, prd.ProprietaryDocumentTypeCode))
 	}
 	if err := prd.isAlphanumeric(prd.DocumentIdentificationNumber); err != nil {
-		return fieldError("DocumentIdentificationNumber", err, prd.DocumentIdentificationNumber)
+		errs.Add(fieldError("DocumentIdentificationNumber", err, prd.DocumentIdentificationNumber))
 	}
 	if err := prd.isAlphanumeric(prd.Issuer); err != nil {
-		return fieldError("Issuer", err, prd.Issuer)
+		errs.Add(fieldError("Issuer", err, prd.Issuer))
 	}
-	return nil
+	return prd.collected(errs)
 }
 
 // fieldInclusion validate mandatory fields. If fields are
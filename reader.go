@@ -23,9 +23,14 @@ type Reader struct {
 	File File
 	// line is the current line being parsed from the input r
 	line string
-	// ToDo:  Do we need a current FEDWireMessage, just use FEDWireMessage
 	// currentFEDWireMessage is the current FEDWireMessage being parsed
 	currentFEDWireMessage FEDWireMessage
+	// currentTags holds each tag read for currentFEDWireMessage
+	currentTags map[string]bool
+	// pending holds lines which have been scanned but not yet parsed
+	pending []readerLine
+	// linesRead is the number of lines scanned from the input
+	linesRead int
 	// lineNum is the line number of the file being parsed
 	lineNum int
 	// tagName holds the current tag name being parsed.
@@ -36,11 +41,26 @@ type Reader struct {
 	headerData string
 }
 
+// readerLine is a line scanned from the input along with its line number
+type readerLine struct {
+	text string
+	num  int
+}
+
 var (
 	tagRegex = regexp.MustCompile(`{([0-9]{4})}`)
+
+	// fedAppendedTags are added by the Fedwire Funds Service and lead the message they belong to
+	fedAppendedTags = map[string]bool{
+		TagMessageDisposition:              true,
+		TagReceiptTimeStamp:                true,
+		TagOutputMessageAccountabilityData: true,
+		TagErrorWire:                       true,
+	}
 )
 
-// error returns a new ParseError based on err
+// error returns a new ParseError based on err. The errors of a tag are located within the FEDWireMessage
+// as a ValidationError.
 func (r *Reader) parseError(err error) error {
 	if err == nil {
 		return nil
@@ -48,6 +68,9 @@ func (r *Reader) parseError(err error) error {
 	if _, ok := err.(*base.ParseError); ok {
 		return err
 	}
+	if r.tagName != "" {
+		err = newValidationError(r.tagName, err)
+	}
 	return &base.ParseError{
 		Line:   r.lineNum,
 		Record: r.tagName,
@@ -58,8 +81,9 @@ func (r *Reader) parseError(err error) error {
 // NewReader returns a new ACH Reader that reads from r.
 func NewReader(r io.Reader, opts ...FilePropertyFunc) *Reader {
 	reader := &Reader{
-		scanner: bufio.NewScanner(r),
-		File:    *NewFile(opts...),
+		scanner:     bufio.NewScanner(r),
+		File:        *NewFile(opts...),
+		currentTags: make(map[string]bool),
 	}
 
 	reader.scanner.Split(scanLinesWithSegmentFormat)
@@ -67,74 +91,203 @@ func NewReader(r io.Reader, opts ...FilePropertyFunc) *Reader {
 	return reader
 }
 
-// addCurrentFEDWireMessage creates the current FEDWireMessage for the file being read. A successful
-// current FEDWireMessage will be added to r.File once parsed.
-/*func (r *Reader) addCurrentFEDWireMessage(fwm FEDWireMessage) {
-	r.currentFEDWireMessage = FEDWireMessage{}
-}*/
+// onlyFedAppendedTags returns true if the current FEDWireMessage has no tags besides those
+// appended by the Fedwire Funds Service
+func (r *Reader) onlyFedAppendedTags() bool {
+	for tag := range r.currentTags {
+		if !fedAppendedTags[tag] {
+			return false
+		}
+	}
+	return true
+}
+
+// startsNewFEDWireMessage returns true when the current line opens the next FEDWireMessage
+func (r *Reader) startsNewFEDWireMessage() bool {
+	if utf8.RuneCountInString(r.line) < 6 || len(r.currentTags) == 0 {
+		return false
+	}
+	switch r.line[:6] {
+	case TagMessageDisposition:
+		return !r.trailingFedAppendedTags()
+	case TagSenderSupplied:
+		return !r.onlyFedAppendedTags()
+	}
+	return false
+}
+
+// trailingFedAppendedTags returns true when the {1100} on the current line begins a run of
+// Fed-appended tags which ends the input. Those tags are kept with the current FEDWireMessage.
+func (r *Reader) trailingFedAppendedTags() bool {
+	if r.currentTags[TagMessageDisposition] {
+		return false
+	}
+	for i := 0; ; i++ {
+		if !r.fill(i + 1) {
+			return true
+		}
+		line := r.pending[i].text
+		if utf8.RuneCountInString(line) < 6 || line[:6] == TagMessageDisposition || !fedAppendedTags[line[:6]] {
+			return false
+		}
+	}
+}
+
+// SetValidation stores ValidateOpts on the Reader's File. They are used when validating each FEDWireMessage read.
+func (r *Reader) SetValidation(opts *ValidateOpts) {
+	r.File.SetValidation(opts)
+}
 
 // Read reads each line of the FED Wire file and defines which parser to use based
 // on the first character of each line. It also enforces FED Wire formatting rules and returns
 // the appropriate error if issues are found.
+//
+// A file may contain several FEDWireMessages back to back. A new message is started by
+// {1100}, or by {1500} when the current message holds more than Fed-appended tags.
 func (r *Reader) Read() (File, error) {
 	return r.read(nil)
 }
 
+// ReadWithOpts reads the file like Read, validating each tag and FEDWireMessage read following opts
 func (r *Reader) ReadWithOpts(opts *ValidateOpts) (File, error) {
 	return r.read(opts)
 }
 
 func (r *Reader) read(opts *ValidateOpts) (File, error) {
-	spiltString := func(line string) []string {
-
-		// strip new lines
-		line = strings.ReplaceAll(strings.ReplaceAll(line, "\r\n", ""), "\n", "")
-
-		// split line by tag again
-		indexes := tagRegex.FindAllStringIndex(line, -1)
-		var result []string
-		last := len(line)
-		for i := range indexes {
-			index := indexes[len(indexes)-1-i][0]
-			result = append([]string{line[index:last]}, result...)
-			last = index
-		}
-		return result
+	// opts apply to the tags as they're read as well as to the messages
+	if opts != nil {
+		r.File.SetValidation(opts)
 	}
 
-	r.lineNum = 0
 	// read through the entire file
-	for r.scanner.Scan() {
-		line := r.scanner.Text()
-		for _, subLine := range spiltString(line) {
-			r.lineNum++
-			r.line = subLine
-			if err := r.parseLine(); err != nil {
-				r.errors.Add(err)
-			}
+	for {
+		fwm, errs := r.nextFEDWireMessage()
+		if fwm == nil && errs.Empty() {
+			break
+		}
+		if fwm != nil {
+			r.File.AddFEDWireMessage(*fwm)
+		}
+		for i := range errs {
+			r.errors.Add(errs[i])
 		}
 	}
 
-	r.File.AddFEDWireMessage(r.currentFEDWireMessage)
-	r.currentFEDWireMessage = FEDWireMessage{}
-
 	if r.errors.Empty() {
-		if opts != nil {
-			r.File.SetValidation(opts)
-		}
 		err := r.File.Validate()
 		if err == nil {
 			return r.File, nil
 		}
-		r.errors.Add(fmt.Errorf("file validation failed: %v", err))
+		r.errors.Add(fmt.Errorf("file validation failed: %w", err))
 	}
 	return r.File, r.errors
 }
 
+// Next reads and validates the next FEDWireMessage, returning io.EOF once there are none left.
+//
+// Unlike Read only the current message is kept in memory and r.File is not populated, which
+// suits large files. Parse and validation errors are returned as a base.ErrorList along with
+// the message they were found in. Callers can keep calling Next to read the following messages.
+func (r *Reader) Next() (*FEDWireMessage, error) {
+	fwm, errs := r.nextFEDWireMessage()
+	if fwm == nil {
+		if errs.Empty() {
+			return nil, io.EOF
+		}
+		return nil, errs
+	}
+	if errs.Empty() {
+		if fwm.ValidateOptions == nil {
+			fwm.ValidateOptions = r.File.GetValidation()
+		}
+		if err := fwm.verify(); err != nil {
+			errs.Add(fmt.Errorf("message validation failed: %w", err))
+		}
+	}
+	if errs.Empty() {
+		return fwm, nil
+	}
+	return fwm, errs
+}
+
+// nextFEDWireMessage parses lines until the next FEDWireMessage begins or the input ends.
+// The returned message is nil when no tags were read.
+func (r *Reader) nextFEDWireMessage() (*FEDWireMessage, base.ErrorList) {
+	r.currentFEDWireMessage = FEDWireMessage{}
+	r.currentTags = make(map[string]bool)
+
+	var errs base.ErrorList
+	for r.nextLine() {
+		if r.startsNewFEDWireMessage() {
+			r.unreadLine()
+			break
+		}
+		r.tagName = ""
+		if err := r.parseLine(); err != nil {
+			errs.Add(r.parseError(err))
+		}
+	}
+
+	if len(r.currentTags) == 0 {
+		return nil, errs
+	}
+	fwm := r.currentFEDWireMessage
+	return &fwm, errs
+}
+
+// fill scans the input until r.pending holds n lines. It returns false if the input ends first.
+func (r *Reader) fill(n int) bool {
+	for len(r.pending) < n {
+		if !r.scanner.Scan() {
+			return false
+		}
+		for _, line := range splitTags(r.scanner.Text()) {
+			r.linesRead++
+			r.pending = append(r.pending, readerLine{text: line, num: r.linesRead})
+		}
+	}
+	return true
+}
+
+// nextLine moves r.line to the next line of input. It returns false at the end of input.
+func (r *Reader) nextLine() bool {
+	if !r.fill(1) {
+		return false
+	}
+	r.line, r.lineNum = r.pending[0].text, r.pending[0].num
+	r.pending = r.pending[1:]
+	return true
+}
+
+// unreadLine returns r.line to the input so it's read again by nextLine
+func (r *Reader) unreadLine() {
+	r.pending = append([]readerLine{{text: r.line, num: r.lineNum}}, r.pending...)
+}
+
+// splitTags strips newlines from line and splits it into one string per tag
+func splitTags(line string) []string {
+	// strip new lines
+	line = strings.ReplaceAll(strings.ReplaceAll(line, "\r\n", ""), "\n", "")
+
+	// split line by tag again
+	indexes := tagRegex.FindAllStringIndex(line, -1)
+	var result []string
+	last := len(line)
+	for i := range indexes {
+		index := indexes[len(indexes)-1-i][0]
+		result = append([]string{line[index:last]}, result...)
+		last = index
+	}
+	return result
+}
+
 func (r *Reader) parseLine() error { //nolint:gocyclo
 	if n := utf8.RuneCountInString(r.line); n < 6 {
 		return fmt.Errorf("line %q is too short for tag", r.line)
 	}
+	if tagRegex.MatchString(r.line[:6]) {
+		r.currentTags[r.line[:6]] = true
+	}
 	switch r.line[:6] {
 	case TagMessageDisposition:
 		if err := r.parseMessageDisposition(); err != nil {
@@ -381,18 +534,26 @@ func (r *Reader) parseLine() error { //nolint:gocyclo
 			r.headerData = r.line
 			return nil
 		}
+		if r.File.GetValidation() != nil && r.File.GetValidation().AllowUnknownTags && tagRegex.MatchString(r.line[:6]) {
+			return nil
+		}
 		return NewErrInvalidTag(r.line[:6])
 	}
 	return nil
 }
 
+// validateTag validates a tag as it's read, following the File's ValidateOpts
+func (r *Reader) validateTag(tag tagValidator) error {
+	return r.File.GetValidation().validateTag(tag)
+}
+
 func (r *Reader) parseSenderSupplied() error {
 	r.tagName = "SenderSupplied"
 	ss := new(SenderSupplied)
 	if err := ss.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := ss.Validate(); err != nil {
+	if err := r.validateTag(ss); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.SenderSupplied = ss
@@ -405,7 +566,7 @@ func (r *Reader) parseTypeSubType() error {
 	if err := tst.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := tst.Validate(); err != nil {
+	if err := r.validateTag(tst); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.TypeSubType = tst
@@ -418,7 +579,7 @@ func (r *Reader) parseInputMessageAccountabilityData() error {
 	if err := imad.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := imad.Validate(); err != nil {
+	if err := r.validateTag(imad); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.InputMessageAccountabilityData = imad
@@ -431,7 +592,7 @@ func (r *Reader) parseAmount() error {
 	if err := amt.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := amt.Validate(); err != nil {
+	if err := r.validateTag(amt); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.Amount = amt
@@ -444,7 +605,7 @@ func (r *Reader) parseSenderDepositoryInstitution() error {
 	if err := sdi.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := sdi.Validate(); err != nil {
+	if err := r.validateTag(sdi); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.SenderDepositoryInstitution = sdi
@@ -457,7 +618,7 @@ func (r *Reader) parseReceiverDepositoryInstitution() error {
 	if err := rdi.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := rdi.Validate(); err != nil {
+	if err := r.validateTag(rdi); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.ReceiverDepositoryInstitution = rdi
@@ -470,7 +631,7 @@ func (r *Reader) parseBusinessFunctionCode() error {
 	if err := bfc.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := bfc.Validate(); err != nil {
+	if err := r.validateTag(bfc); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.BusinessFunctionCode = bfc
@@ -483,7 +644,7 @@ func (r *Reader) parseSenderReference() error {
 	if err := sr.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := sr.Validate(); err != nil {
+	if err := r.validateTag(sr); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.SenderReference = sr
@@ -496,7 +657,7 @@ func (r *Reader) parsePreviousMessageIdentifier() error {
 	if err := pmi.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := pmi.Validate(); err != nil {
+	if err := r.validateTag(pmi); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.PreviousMessageIdentifier = pmi
@@ -509,7 +670,7 @@ func (r *Reader) parseLocalInstrument() error {
 	if err := li.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := li.Validate(); err != nil {
+	if err := r.validateTag(li); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.LocalInstrument = li
@@ -522,7 +683,7 @@ func (r *Reader) parsePaymentNotification() error {
 	if err := pn.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := pn.Validate(); err != nil {
+	if err := r.validateTag(pn); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.PaymentNotification = pn
@@ -535,7 +696,7 @@ func (r *Reader) parseCharges() error {
 	if err := c.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := c.Validate(); err != nil {
+	if err := r.validateTag(c); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.Charges = c
@@ -548,7 +709,7 @@ func (r *Reader) parseInstructedAmount() error {
 	if err := ia.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := ia.Validate(); err != nil {
+	if err := r.validateTag(ia); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.InstructedAmount = ia
@@ -561,7 +722,7 @@ func (r *Reader) parseExchangeRate() error {
 	if err := eRate.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := eRate.Validate(); err != nil {
+	if err := r.validateTag(eRate); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.ExchangeRate = eRate
@@ -574,7 +735,7 @@ func (r *Reader) parseBeneficiaryIntermediaryFI() error {
 	if err := bifi.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := bifi.Validate(); err != nil {
+	if err := r.validateTag(bifi); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.BeneficiaryIntermediaryFI = bifi
@@ -587,7 +748,7 @@ func (r *Reader) parseBeneficiaryFI() error {
 	if err := bfi.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := bfi.Validate(); err != nil {
+	if err := r.validateTag(bfi); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.BeneficiaryFI = bfi
@@ -600,7 +761,7 @@ func (r *Reader) parseBeneficiary() error {
 	if err := ben.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := ben.Validate(); err != nil {
+	if err := r.validateTag(ben); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.Beneficiary = ben
@@ -613,7 +774,7 @@ func (r *Reader) parseBeneficiaryReference() error {
 	if err := br.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := br.Validate(); err != nil {
+	if err := r.validateTag(br); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.BeneficiaryReference = br
@@ -626,7 +787,7 @@ func (r *Reader) parseAccountDebitedDrawdown() error {
 	if err := debitDD.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := debitDD.Validate(); err != nil {
+	if err := r.validateTag(debitDD); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.AccountDebitedDrawdown = debitDD
@@ -639,7 +800,7 @@ func (r *Reader) parseOriginator() error {
 	if err := o.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := o.Validate(); err != nil {
+	if err := r.validateTag(o); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.Originator = o
@@ -652,7 +813,7 @@ func (r *Reader) parseOriginatorOptionF() error {
 	if err := oof.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := oof.Validate(); err != nil {
+	if err := r.validateTag(oof); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.OriginatorOptionF = oof
@@ -665,7 +826,7 @@ func (r *Reader) parseOriginatorFI() error {
 	if err := ofi.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := ofi.Validate(); err != nil {
+	if err := r.validateTag(ofi); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.OriginatorFI = ofi
@@ -678,7 +839,7 @@ func (r *Reader) parseInstructingFI() error {
 	if err := ifi.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := ifi.Validate(); err != nil {
+	if err := r.validateTag(ifi); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.InstructingFI = ifi
@@ -691,7 +852,7 @@ func (r *Reader) parseAccountCreditedDrawdown() error {
 	if err := creditDD.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := creditDD.Validate(); err != nil {
+	if err := r.validateTag(creditDD); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.AccountCreditedDrawdown = creditDD
@@ -704,7 +865,7 @@ func (r *Reader) parseOriginatorToBeneficiary() error {
 	if err := ob.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := ob.Validate(); err != nil {
+	if err := r.validateTag(ob); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.OriginatorToBeneficiary = ob
@@ -717,7 +878,7 @@ func (r *Reader) parseFIReceiverFI() error {
 	if err := firfi.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := firfi.Validate(); err != nil {
+	if err := r.validateTag(firfi); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.FIReceiverFI = firfi
@@ -730,7 +891,7 @@ func (r *Reader) parseFIDrawdownDebitAccountAdvice() error {
 	if err := debitDDAdvice.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := debitDDAdvice.Validate(); err != nil {
+	if err := r.validateTag(debitDDAdvice); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.FIDrawdownDebitAccountAdvice = debitDDAdvice
@@ -743,7 +904,7 @@ func (r *Reader) parseFIIntermediaryFI() error {
 	if err := fiifi.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := fiifi.Validate(); err != nil {
+	if err := r.validateTag(fiifi); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.FIIntermediaryFI = fiifi
@@ -756,7 +917,7 @@ func (r *Reader) parseFIIntermediaryFIAdvice() error {
 	if err := fiifia.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := fiifia.Validate(); err != nil {
+	if err := r.validateTag(fiifia); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.FIIntermediaryFIAdvice = fiifia
@@ -769,7 +930,7 @@ func (r *Reader) parseFIBeneficiaryFI() error {
 	if err := fibfi.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := fibfi.Validate(); err != nil {
+	if err := r.validateTag(fibfi); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.FIBeneficiaryFI = fibfi
@@ -782,7 +943,7 @@ func (r *Reader) parseFIBeneficiaryFIAdvice() error {
 	if err := fibfia.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := fibfia.Validate(); err != nil {
+	if err := r.validateTag(fibfia); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.FIBeneficiaryFIAdvice = fibfia
@@ -795,7 +956,7 @@ func (r *Reader) parseFIBeneficiary() error {
 	if err := fib.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := fib.Validate(); err != nil {
+	if err := r.validateTag(fib); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.FIBeneficiary = fib
@@ -808,7 +969,7 @@ func (r *Reader) parseFIBeneficiaryAdvice() error {
 	if err := fiba.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := fiba.Validate(); err != nil {
+	if err := r.validateTag(fiba); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.FIBeneficiaryAdvice = fiba
@@ -821,7 +982,7 @@ func (r *Reader) parseFIPaymentMethodToBeneficiary() error {
 	if err := pm.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := pm.Validate(); err != nil {
+	if err := r.validateTag(pm); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.FIPaymentMethodToBeneficiary = pm
@@ -834,7 +995,7 @@ func (r *Reader) parseFIAdditionalFIToFI() error {
 	if err := fifi.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := fifi.Validate(); err != nil {
+	if err := r.validateTag(fifi); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.FIAdditionalFIToFI = fifi
@@ -847,7 +1008,7 @@ func (r *Reader) parseCurrencyInstructedAmount() error {
 	if err := cia.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := cia.Validate(); err != nil {
+	if err := r.validateTag(cia); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.CurrencyInstructedAmount = cia
@@ -860,7 +1021,7 @@ func (r *Reader) parseOrderingCustomer() error {
 	if err := oc.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := oc.Validate(); err != nil {
+	if err := r.validateTag(oc); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.OrderingCustomer = oc
@@ -873,7 +1034,7 @@ func (r *Reader) parseOrderingInstitution() error {
 	if err := oi.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := oi.Validate(); err != nil {
+	if err := r.validateTag(oi); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.OrderingInstitution = oi
@@ -886,7 +1047,7 @@ func (r *Reader) parseIntermediaryInstitution() error {
 	if err := ii.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := ii.Validate(); err != nil {
+	if err := r.validateTag(ii); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.IntermediaryInstitution = ii
@@ -899,7 +1060,7 @@ func (r *Reader) parseInstitutionAccount() error {
 	if err := iAccount.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := iAccount.Validate(); err != nil {
+	if err := r.validateTag(iAccount); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.InstitutionAccount = iAccount
@@ -912,7 +1073,7 @@ func (r *Reader) parseBeneficiaryCustomer() error {
 	if err := bc.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := bc.Validate(); err != nil {
+	if err := r.validateTag(bc); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.BeneficiaryCustomer = bc
@@ -925,7 +1086,7 @@ func (r *Reader) parseRemittance() error {
 	if err := ri.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := ri.Validate(); err != nil {
+	if err := r.validateTag(ri); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.Remittance = ri
@@ -938,7 +1099,7 @@ func (r *Reader) parseSenderToReceiver() error {
 	if err := sr.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := sr.Validate(); err != nil {
+	if err := r.validateTag(sr); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.SenderToReceiver = sr
@@ -951,7 +1112,7 @@ func (r *Reader) parseUnstructuredAddenda() error {
 	if err := ua.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := ua.Validate(); err != nil {
+	if err := r.validateTag(ua); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.UnstructuredAddenda = ua
@@ -964,7 +1125,7 @@ func (r *Reader) parseRelatedRemittance() error {
 	if err := rr.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := rr.Validate(); err != nil {
+	if err := r.validateTag(rr); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.RelatedRemittance = rr
@@ -977,7 +1138,7 @@ func (r *Reader) parseRemittanceOriginator() error {
 	if err := ro.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := ro.Validate(); err != nil {
+	if err := r.validateTag(ro); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.RemittanceOriginator = ro
@@ -990,7 +1151,7 @@ func (r *Reader) parseRemittanceBeneficiary() error {
 	if err := rb.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := rb.Validate(); err != nil {
+	if err := r.validateTag(rb); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.RemittanceBeneficiary = rb
@@ -1003,7 +1164,7 @@ func (r *Reader) parsePrimaryRemittanceDocument() error {
 	if err := prd.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := prd.Validate(); err != nil {
+	if err := r.validateTag(prd); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.PrimaryRemittanceDocument = prd
@@ -1016,7 +1177,7 @@ func (r *Reader) parseActualAmountPaid() error {
 	if err := aap.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := aap.Validate(); err != nil {
+	if err := r.validateTag(aap); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.ActualAmountPaid = aap
@@ -1029,7 +1190,7 @@ func (r *Reader) parseGrossAmountRemittanceDocument() error {
 	if err := gard.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := gard.Validate(); err != nil {
+	if err := r.validateTag(gard); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.GrossAmountRemittanceDocument = gard
@@ -1042,7 +1203,7 @@ func (r *Reader) parseAmountNegotiatedDiscount() error {
 	if err := nd.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := nd.Validate(); err != nil {
+	if err := r.validateTag(nd); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.AmountNegotiatedDiscount = nd
@@ -1055,7 +1216,7 @@ func (r *Reader) parseAdjustment() error {
 	if err := adj.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := adj.Validate(); err != nil {
+	if err := r.validateTag(adj); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.Adjustment = adj
@@ -1068,7 +1229,7 @@ func (r *Reader) parseDateRemittanceDocument() error {
 	if err := drd.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := drd.Validate(); err != nil {
+	if err := r.validateTag(drd); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.DateRemittanceDocument = drd
@@ -1081,7 +1242,7 @@ func (r *Reader) parseSecondaryRemittanceDocument() error {
 	if err := srd.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := srd.Validate(); err != nil {
+	if err := r.validateTag(srd); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.SecondaryRemittanceDocument = srd
@@ -1094,7 +1255,7 @@ func (r *Reader) parseRemittanceFreeText() error {
 	if err := rft.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := rft.Validate(); err != nil {
+	if err := r.validateTag(rft); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.RemittanceFreeText = rft
@@ -1107,7 +1268,7 @@ func (r *Reader) parseServiceMessage() error {
 	if err := sm.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := sm.Validate(); err != nil {
+	if err := r.validateTag(sm); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.ServiceMessage = sm
@@ -1120,7 +1281,7 @@ func (r *Reader) parseMessageDisposition() error {
 	if err := md.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := md.Validate(); err != nil {
+	if err := r.validateTag(md); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.MessageDisposition = md
@@ -1133,7 +1294,7 @@ func (r *Reader) parseReceiptTimeStamp() error {
 	if err := rts.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := rts.Validate(); err != nil {
+	if err := r.validateTag(rts); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.ReceiptTimeStamp = rts
@@ -1146,7 +1307,7 @@ func (r *Reader) parseOutputMessageAccountabilityData() error {
 	if err := omad.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := omad.Validate(); err != nil {
+	if err := r.validateTag(omad); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.OutputMessageAccountabilityData = omad
@@ -1159,7 +1320,7 @@ func (r *Reader) parseErrorWire() error {
 	if err := ew.Parse(r.line); err != nil {
 		return r.parseError(err)
 	}
-	if err := ew.Validate(); err != nil {
+	if err := r.validateTag(ew); err != nil {
 		return r.parseError(err)
 	}
 	r.currentFEDWireMessage.ErrorWire = ew
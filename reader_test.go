@@ -2,12 +2,14 @@ package wire
 
 import (
 	"bytes"
+	"io"
 	"os"
 	"path"
 	"path/filepath"
 	"strings"
 	"testing"
 
+	"github.com/moov-io/base"
 	"github.com/stretchr/testify/require"
 )
 
@@ -64,6 +66,41 @@ func TestReadInvalidTag(t *testing.T) {
 	require.Contains(t, err.Error(), NewErrInvalidTag("{1599}").Error())
 }
 
+func TestReadWithOpts_AllowUnknownTags(t *testing.T) {
+	bs, err := os.ReadFile(filepath.Join("test", "testdata", "fedWireMessage-CustomerTransfer.txt"))
+	require.NoError(t, err)
+	input := strings.Replace(string(bs), "{2000}", "{1599}Unknown\n{2000}", 1)
+
+	_, err = NewReader(strings.NewReader(input)).Read()
+	require.ErrorContains(t, err, NewErrInvalidTag("{1599}").Error())
+
+	file, err := NewReader(strings.NewReader(input)).ReadWithOpts(&ValidateOpts{AllowUnknownTags: true})
+	require.NoError(t, err)
+	require.Len(t, file.FEDWireMessages, 1)
+
+	// lines which aren't tags at all are still rejected
+	input = strings.Replace(string(bs), "{2000}", "Unknown\n{2000}", 1)
+	_, err = NewReader(strings.NewReader(input)).ReadWithOpts(&ValidateOpts{AllowUnknownTags: true})
+	require.Error(t, err)
+}
+
+func TestReadWithOpts_SkipCharacterSet(t *testing.T) {
+	bs, err := os.ReadFile(filepath.Join("test", "testdata", "fedWireMessage-CustomerTransfer.txt"))
+	require.NoError(t, err)
+	input := strings.Replace(string(bs), "{4200}31234*Name*", "{4200}31234*Nam®*", 1)
+	require.NotEqual(t, string(bs), input)
+
+	_, err = NewReader(strings.NewReader(input)).Read()
+	require.ErrorContains(t, err, ErrNonAlphanumeric.Error())
+
+	file, err := NewReader(strings.NewReader(input)).ReadWithOpts(&ValidateOpts{SkipCharacterSet: true})
+	require.NoError(t, err)
+	require.Equal(t, "Nam®", file.FEDWireMessages[0].Beneficiary.Personal.Name)
+
+	_, err = NewReader(strings.NewReader(input)).ReadWithOpts(&ValidateOpts{SkipAll: true})
+	require.NoError(t, err)
+}
+
 func TestReadShortLine(t *testing.T) {
 	f, err := NewReader(strings.NewReader("00")).Read()
 
@@ -95,7 +132,7 @@ func TestReadWithValidateOpts(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, file)
 
-	file.FEDWireMessage.InputMessageAccountabilityData = nil
+	file.FEDWireMessages[0].InputMessageAccountabilityData = nil
 
 	b := &bytes.Buffer{}
 	w := NewWriter(b)
@@ -109,5 +146,142 @@ func TestReadWithValidateOpts(t *testing.T) {
 
 	require.Error(t, err)
 	require.NotNil(t, file)
-	require.Nil(t, file.FEDWireMessage.InputMessageAccountabilityData)
+	require.Empty(t, file.FEDWireMessages)
+}
+
+func TestRead_multipleMessages(t *testing.T) {
+	f, err := os.Open(filepath.Join("test", "testdata", "fedWireMessage-Batch.txt"))
+	require.NoError(t, err)
+	defer f.Close()
+
+	file, err := NewReader(f).Read()
+	require.NoError(t, err)
+	require.Len(t, file.FEDWireMessages, 3)
+
+	require.Equal(t, CustomerTransfer, file.FEDWireMessages[0].BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, BankTransfer, file.FEDWireMessages[1].BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, BankTransfer, file.FEDWireMessages[2].BusinessFunctionCode.BusinessFunctionCode)
+	require.Nil(t, file.FEDWireMessages[1].MessageDisposition)
+	require.NotNil(t, file.FEDWireMessages[2].MessageDisposition)
+	require.NotNil(t, file.FEDWireMessages[2].ErrorWire)
+
+	// the written file sorts each message by tag, so {1100} leads the last message
+	var buf bytes.Buffer
+	require.NoError(t, NewWriter(&buf).Write(&file))
+
+	written, err := NewReader(&buf).Read()
+	require.NoError(t, err)
+	require.Len(t, written.FEDWireMessages, 3)
+	require.Equal(t, file.FEDWireMessages[2].ErrorWire, written.FEDWireMessages[2].ErrorWire)
+}
+
+func TestRead_multipleMessagesValidateOpts(t *testing.T) {
+	var buf bytes.Buffer
+	for i := 0; i < 2; i++ {
+		fwm := mockCustomerTransferData()
+		fwm.Beneficiary = mockBeneficiary()
+		fwm.Originator = mockOriginator()
+		fwm.InputMessageAccountabilityData = nil
+		for _, line := range []string{
+			fwm.SenderSupplied.Format(FormatOptions{}),
+			fwm.TypeSubType.String(),
+			fwm.Amount.String(),
+			fwm.SenderDepositoryInstitution.String(),
+			fwm.ReceiverDepositoryInstitution.String(),
+			fwm.BusinessFunctionCode.String(),
+			fwm.Beneficiary.String(),
+			fwm.Originator.String(),
+		} {
+			buf.WriteString(line + "\n")
+		}
+	}
+
+	file, err := NewReader(&buf).ReadWithOpts(&ValidateOpts{
+		SkipMandatoryIMAD: true,
+	})
+	require.NoError(t, err)
+	require.Len(t, file.FEDWireMessages, 2)
+	for i := range file.FEDWireMessages {
+		require.True(t, file.FEDWireMessages[i].ValidateOptions.SkipMandatoryIMAD)
+	}
+}
+
+func TestReader_Next(t *testing.T) {
+	f, err := os.Open(filepath.Join("test", "testdata", "fedWireMessage-Batch.txt"))
+	require.NoError(t, err)
+	defer f.Close()
+
+	r := NewReader(f)
+
+	var codes []string
+	for {
+		fwm, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		codes = append(codes, fwm.BusinessFunctionCode.BusinessFunctionCode)
+	}
+	require.Equal(t, []string{CustomerTransfer, BankTransfer, BankTransfer}, codes)
+	require.Empty(t, r.File.FEDWireMessages)
+
+	fwm, err := r.Next()
+	require.Nil(t, fwm)
+	require.Equal(t, io.EOF, err)
+}
+
+func TestReader_NextErrors(t *testing.T) {
+	ct, err := os.ReadFile(filepath.Join("test", "testdata", "fedWireMessage-CustomerTransfer.txt"))
+	require.NoError(t, err)
+	bt, err := os.ReadFile(filepath.Join("test", "testdata", "fedWireMessage-BankTransfer.txt"))
+	require.NoError(t, err)
+
+	// the second message has an invalid tag on line 37 and the third is missing its Amount
+	invalid := strings.Replace(string(bt), "{3320}", "{3329}", 1)
+	missing := strings.Replace(string(ct), "{2000}000001234567\n", "", 1)
+	input := strings.Join([]string{string(ct), invalid, missing}, "\n")
+
+	r := NewReader(strings.NewReader(input))
+
+	fwm, err := r.Next()
+	require.NoError(t, err)
+	require.Equal(t, CustomerTransfer, fwm.BusinessFunctionCode.BusinessFunctionCode)
+
+	fwm, err = r.Next()
+	require.NotNil(t, fwm)
+	require.Equal(t, BankTransfer, fwm.BusinessFunctionCode.BusinessFunctionCode)
+	var errs base.ErrorList
+	require.ErrorAs(t, err, &errs)
+	require.Len(t, errs, 1)
+	var parseErr *base.ParseError
+	require.ErrorAs(t, errs[0], &parseErr)
+	require.Equal(t, 37, parseErr.Line)
+	require.Contains(t, parseErr.Error(), NewErrInvalidTag("{3329}").Error())
+
+	fwm, err = r.Next()
+	require.NotNil(t, fwm)
+	require.Nil(t, fwm.Amount)
+	require.ErrorContains(t, err, "message validation failed")
+	require.ErrorContains(t, err, fieldError("Amount", ErrFieldRequired).Error())
+
+	_, err = r.Next()
+	require.Equal(t, io.EOF, err)
+}
+
+func TestReader_NextValidateOpts(t *testing.T) {
+	bt, err := os.ReadFile(filepath.Join("test", "testdata", "fedWireMessage-BankTransfer.txt"))
+	require.NoError(t, err)
+	input := strings.Replace(string(bt), "{1500}30User ReqT \n", "", 1)
+
+	_, err = NewReader(strings.NewReader(input)).Next()
+	require.ErrorContains(t, err, fieldError("SenderSupplied", ErrFieldRequired).Error())
+
+	fwm, err := NewReader(strings.NewReader(input), IncomingFile()).Next()
+	require.NoError(t, err)
+	require.Nil(t, fwm.SenderSupplied)
+
+	r := NewReader(strings.NewReader(input))
+	r.SetValidation(&ValidateOpts{AllowMissingSenderSupplied: true})
+	_, err = r.Next()
+	require.NoError(t, err)
 }
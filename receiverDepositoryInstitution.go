@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // ReceiverDepositoryInstitution {3400}
@@ -102,19 +104,20 @@ func (rdi *ReceiverDepositoryInstitution) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on ReceiverDepositoryInstitution and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (rdi *ReceiverDepositoryInstitution) Validate() error {
+	var errs base.ErrorList
 	if err := rdi.fieldInclusion(); err != nil {
-		return err
+		errs.Add(err)
 	}
 	if rdi.tag != TagReceiverDepositoryInstitution {
-		return fieldError("tag", ErrValidTagForType, rdi.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, rdi.tag))
 	}
-	if err := rdi.isNumeric(rdi.ReceiverABANumber); err != nil {
-		return fieldError("ReceiverABANumber", err, rdi.ReceiverABANumber)
+	if err := rdi.isRoutingNumber(rdi.ReceiverABANumber); err != nil {
+		errs.Add(fieldError("ReceiverABANumber", err, rdi.ReceiverABANumber))
 	}
 	if err := rdi.isAlphanumeric(rdi.ReceiverShortName); err != nil {
-		return fieldError("ReceiverShortName", err, rdi.ReceiverShortName)
+		errs.Add(fieldError("ReceiverShortName", err, rdi.ReceiverShortName))
 	}
-	return nil
+	return rdi.collected(errs)
 }
 
 // fieldInclusion validate mandatory fields. If fields are
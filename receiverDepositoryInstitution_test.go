@@ -89,28 +89,28 @@ func TestReceiverDepositoryInstitutionTagError(t *testing.T) {
 // TestStringReceiverDepositoryInstitutionVariableLength parses using variable length
 func TestStringReceiverDepositoryInstitutionVariableLength(t *testing.T) {
 	var line = "{3400}1        A*"
-	r := NewReader(strings.NewReader(line))
+	r := NewReader(strings.NewReader(line), skipRoutingNumberCheck)
 	r.line = line
 
 	err := r.parseReceiverDepositoryInstitution()
 	require.NoError(t, err)
 
 	line = "{3400}1        A                 NNN*"
-	r = NewReader(strings.NewReader(line))
+	r = NewReader(strings.NewReader(line), skipRoutingNumberCheck)
 	r.line = line
 
 	err = r.parseReceiverDepositoryInstitution()
 	require.NoError(t, err)
 
 	line = "{3400}1*A********"
-	r = NewReader(strings.NewReader(line))
+	r = NewReader(strings.NewReader(line), skipRoutingNumberCheck)
 	r.line = line
 
 	err = r.parseReceiverDepositoryInstitution()
 	require.ErrorContains(t, err, ErrValidLength.Error())
 
 	line = "{3400}1        A*"
-	r = NewReader(strings.NewReader(line))
+	r = NewReader(strings.NewReader(line), skipRoutingNumberCheck)
 	r.line = line
 
 	err = r.parseReceiverDepositoryInstitution()
@@ -120,7 +120,7 @@ func TestStringReceiverDepositoryInstitutionVariableLength(t *testing.T) {
 // TestStringReceiverDepositoryInstitutionOptions validates Format() formatted according to the FormatOptions
 func TestStringReceiverDepositoryInstitutionOptions(t *testing.T) {
 	var line = "{3400}1        A*"
-	r := NewReader(strings.NewReader(line))
+	r := NewReader(strings.NewReader(line), skipRoutingNumberCheck)
 	r.line = line
 
 	err := r.parseReceiverDepositoryInstitution()
@@ -132,7 +132,7 @@ func TestStringReceiverDepositoryInstitutionOptions(t *testing.T) {
 	require.Equal(t, record.String(), record.Format(FormatOptions{VariableLengthFields: false}))
 
 	line = "{3400}1        *"
-	r = NewReader(strings.NewReader(line))
+	r = NewReader(strings.NewReader(line), skipRoutingNumberCheck)
 	r.line = line
 
 	err = r.parseReceiverDepositoryInstitution()
@@ -144,7 +144,7 @@ func TestStringReceiverDepositoryInstitutionOptions(t *testing.T) {
 	require.Equal(t, record.String(), record.Format(FormatOptions{VariableLengthFields: false}))
 
 	line = "{3400}111111111*"
-	r = NewReader(strings.NewReader(line))
+	r = NewReader(strings.NewReader(line), skipRoutingNumberCheck)
 	r.line = line
 
 	err = r.parseReceiverDepositoryInstitution()
@@ -155,3 +155,12 @@ func TestStringReceiverDepositoryInstitutionOptions(t *testing.T) {
 	require.Equal(t, "{3400}111111111*", record.Format(FormatOptions{VariableLengthFields: true}))
 	require.Equal(t, record.String(), record.Format(FormatOptions{VariableLengthFields: false}))
 }
+
+func TestReceiverABANumberCheckDigit(t *testing.T) {
+	rdi := mockReceiverDepositoryInstitution()
+	rdi.ReceiverABANumber = "121042883"
+	require.ErrorIs(t, rdi.Validate(), ErrRoutingNumberCheckDigit)
+
+	rdi.ReceiverABANumber = "12104288"
+	require.ErrorIs(t, rdi.Validate(), ErrRoutingNumberLength)
+}
@@ -0,0 +1,244 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+// Package reconcile matches the Fedwire messages a participant sends to the acknowledgements the Fed sends
+// back, and messages such as reversals and drawdown responses to the originals they refer to.
+//
+// Outbound messages are matched to acknowledgements, inbound messages carrying the Fed-appended
+// MessageDisposition {1100} of an outgoing message, by IMAD. When a message was acknowledged more than once,
+// such as once intercepted and then accepted, the latest acknowledgement by ReceiptTimeStamp counts. Messages
+// with a {3500} PreviousMessageIdentifier are matched to the original of that IMAD, or OMAD, on either side.
+package reconcile
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/moov-io/wire"
+)
+
+// Match is an outbound message and the Fed's acknowledgement of it
+type Match struct {
+	// Outbound is the message sent
+	Outbound *wire.FEDWireMessage
+	// Acknowledgement is the message the Fed sent back
+	Acknowledgement *wire.FEDWireMessage
+	// Ack is the Acknowledgement read from the tags the Fed appended
+	Ack *wire.Acknowledgement
+}
+
+// Rejection is an outbound message the Fed rejected
+type Rejection struct {
+	Match
+	// Details are the catalog's details of the acknowledgement's ErrorWire, if it has one
+	Details *wire.ErrorWireDetails
+}
+
+// RelationKind is how a message relates to the original it refers to
+type RelationKind string
+
+const (
+	// RelationReversal is a reversal of a transfer
+	RelationReversal RelationKind = "reversal"
+	// RelationReversalRequest is a request for the reversal of a transfer
+	RelationReversalRequest RelationKind = "reversal-request"
+	// RelationDrawdownPayment is a transfer honoring a drawdown request
+	RelationDrawdownPayment RelationKind = "drawdown-payment"
+	// RelationDrawdownRefusal is a refusal of a drawdown request
+	RelationDrawdownRefusal RelationKind = "drawdown-refusal"
+	// RelationOther is any other message referring to an earlier one
+	RelationOther RelationKind = "other"
+)
+
+// Related is a message and the original it refers to through its PreviousMessageIdentifier
+type Related struct {
+	Kind     RelationKind
+	Original *wire.FEDWireMessage
+	Message  *wire.FEDWireMessage
+}
+
+// Report is the outcome of reconciling outbound and inbound files
+type Report struct {
+	// Matched are the outbound messages accepted, or in process, with their acknowledgement
+	Matched []Match
+	// Rejected are the outbound messages rejected, with their acknowledgement
+	Rejected []Rejection
+	// Unacknowledged are the outbound messages without an acknowledgement
+	Unacknowledged []*wire.FEDWireMessage
+	// Orphaned are the acknowledgements of no outbound message
+	Orphaned []*wire.FEDWireMessage
+	// Related are the messages matched to the original their PreviousMessageIdentifier refers to
+	Related []Related
+	// Unresolved are the messages whose PreviousMessageIdentifier refers to no message of either side
+	Unresolved []*wire.FEDWireMessage
+}
+
+// acknowledgement is an inbound acknowledgement of an outgoing message
+type acknowledgement struct {
+	fwm *wire.FEDWireMessage
+	ack *wire.Acknowledgement
+}
+
+// Reconcile matches the messages of the outbound files, those sent, to the acknowledgements and messages of
+// the inbound files, those received from the Fed. Messages keep the order of the files in each part of the
+// Report. It returns an error if an inbound message has an invalid acknowledgement.
+func Reconcile(outbound, inbound []*wire.File) (*Report, error) {
+	report := &Report{}
+
+	// inbound messages are acknowledgements of outgoing messages or incoming messages
+	acks := make(map[string][]acknowledgement)
+	var ackOrder []string
+	var incoming []*wire.FEDWireMessage
+	for _, file := range inbound {
+		for i := range file.FEDWireMessages {
+			fwm := &file.FEDWireMessages[i]
+			if !fwm.IsAcknowledgement() {
+				incoming = append(incoming, fwm)
+				continue
+			}
+			ack, err := fwm.Acknowledgement()
+			if err != nil {
+				return nil, fmt.Errorf("inbound file %s message %d: %w", file.ID, i, err)
+			}
+			key := fwm.InputMessageAccountabilityData.Reference()
+			if _, ok := acks[key]; !ok {
+				ackOrder = append(ackOrder, key)
+			}
+			acks[key] = append(acks[key], acknowledgement{fwm: fwm, ack: ack})
+		}
+	}
+
+	// originals are the messages a PreviousMessageIdentifier may refer to, by IMAD and OMAD
+	byIMAD := make(map[string]*wire.FEDWireMessage)
+	byOMAD := make(map[string]*wire.FEDWireMessage)
+	index := func(fwm *wire.FEDWireMessage, omad *wire.OutputMessageAccountabilityData) {
+		if key := fwm.InputMessageAccountabilityData.Reference(); key != "" {
+			if _, ok := byIMAD[key]; !ok {
+				byIMAD[key] = fwm
+			}
+		}
+		if key := omadKey(omad); key != "" {
+			if _, ok := byOMAD[key]; !ok {
+				byOMAD[key] = fwm
+			}
+		}
+	}
+
+	var sent []*wire.FEDWireMessage
+	acked := make(map[string]bool)
+	for _, file := range outbound {
+		for i := range file.FEDWireMessages {
+			fwm := &file.FEDWireMessages[i]
+			sent = append(sent, fwm)
+
+			key := fwm.InputMessageAccountabilityData.Reference()
+			candidates := acks[key]
+			if key == "" || len(candidates) == 0 {
+				index(fwm, nil)
+				report.Unacknowledged = append(report.Unacknowledged, fwm)
+				continue
+			}
+			acked[key] = true
+			latest := latestAcknowledgement(candidates)
+			index(fwm, latest.fwm.OutputMessageAccountabilityData)
+
+			match := Match{Outbound: fwm, Acknowledgement: latest.fwm, Ack: latest.ack}
+			if latest.ack.Status != wire.AcknowledgementRejected {
+				report.Matched = append(report.Matched, match)
+				continue
+			}
+			rejection := Rejection{Match: match}
+			if latest.fwm.ErrorWire != nil {
+				if details, err := latest.fwm.ErrorWire.Details(); err == nil {
+					rejection.Details = &details
+				}
+			}
+			report.Rejected = append(report.Rejected, rejection)
+		}
+	}
+	for _, key := range ackOrder {
+		if acked[key] {
+			continue
+		}
+		for _, a := range acks[key] {
+			report.Orphaned = append(report.Orphaned, a.fwm)
+		}
+	}
+	for _, fwm := range incoming {
+		index(fwm, fwm.OutputMessageAccountabilityData)
+	}
+
+	for _, fwm := range append(sent, incoming...) {
+		pmi := previousMessageIdentifier(fwm)
+		if pmi == "" {
+			continue
+		}
+		original, ok := byIMAD[pmi]
+		if !ok {
+			original, ok = byOMAD[pmi]
+		}
+		if !ok || original == fwm {
+			report.Unresolved = append(report.Unresolved, fwm)
+			continue
+		}
+		report.Related = append(report.Related, Related{
+			Kind:     relationKind(fwm),
+			Original: original,
+			Message:  fwm,
+		})
+	}
+	return report, nil
+}
+
+// latestAcknowledgement returns the acknowledgement received last, or listed last when the receipt times are
+// the same or missing
+func latestAcknowledgement(acks []acknowledgement) acknowledgement {
+	latest := acks[0]
+	for _, a := range acks[1:] {
+		if !receivedAt(a.ack).Before(receivedAt(latest.ack)) {
+			latest = a
+		}
+	}
+	return latest
+}
+
+// receivedAt returns the ReceiptTime of ack, or the zero time if it has none
+func receivedAt(ack *wire.Acknowledgement) time.Time {
+	if ack.ReceiptTime == nil {
+		return time.Time{}
+	}
+	return *ack.ReceiptTime
+}
+
+// omadKey returns omad as written to a PreviousMessageIdentifier, or "" if there's none
+func omadKey(omad *wire.OutputMessageAccountabilityData) string {
+	if omad == nil || omad.OutputCycleDate == "" {
+		return ""
+	}
+	return omad.OutputCycleDate + omad.OutputDestinationID + omad.OutputSequenceNumber
+}
+
+func previousMessageIdentifier(fwm *wire.FEDWireMessage) string {
+	if fwm.PreviousMessageIdentifier == nil {
+		return ""
+	}
+	return fwm.PreviousMessageIdentifier.PreviousMessageIdentifier
+}
+
+func relationKind(fwm *wire.FEDWireMessage) RelationKind {
+	if fwm.TypeSubType == nil {
+		return RelationOther
+	}
+	switch fwm.TypeSubType.SubTypeCode {
+	case wire.ReversalTransfer, wire.ReversalPriorDayTransfer:
+		return RelationReversal
+	case wire.RequestReversal, wire.RequestReversalPriorDayTransfer:
+		return RelationReversalRequest
+	case wire.FundsTransferRequestCredit:
+		return RelationDrawdownPayment
+	case wire.RefusalRequestCredit:
+		return RelationDrawdownRefusal
+	}
+	return RelationOther
+}
@@ -0,0 +1,172 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package reconcile
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/moov-io/wire"
+	"github.com/stretchr/testify/require"
+)
+
+// readMessage reads the only FEDWireMessage of a file in test/testdata
+func readMessage(t *testing.T, name string) *wire.FEDWireMessage {
+	t.Helper()
+
+	fd, err := os.Open(filepath.Join("..", "test", "testdata", name))
+	require.NoError(t, err)
+	defer fd.Close()
+
+	file, err := wire.NewReader(fd).Read()
+	require.NoError(t, err)
+	require.Len(t, file.FEDWireMessages, 1)
+	return &file.FEDWireMessages[0]
+}
+
+// sent returns a copy of fwm with the IMAD of sequence number seq, referring to no earlier message
+func sent(fwm *wire.FEDWireMessage, seq string) wire.FEDWireMessage {
+	out := *fwm
+	out.PreviousMessageIdentifier = nil
+	out.InputMessageAccountabilityData = wire.NewInputMessageAccountabilityData()
+	out.InputMessageAccountabilityData.InputCycleDate = "20190410"
+	out.InputMessageAccountabilityData.InputSource = "Source08"
+	out.InputMessageAccountabilityData.InputSequenceNumber = seq
+	return out
+}
+
+// acknowledged returns a copy of fwm with the tags the Fed appends, received at receipt (MMDDHHmm) with OMAD
+// sequence number seq
+func acknowledged(fwm wire.FEDWireMessage, status, receipt, seq string) wire.FEDWireMessage {
+	fwm.MessageDisposition = wire.NewMessageDisposition()
+	fwm.MessageDisposition.MessageStatusIndicator = status
+	fwm.ReceiptTimeStamp = wire.NewReceiptTimeStamp()
+	fwm.ReceiptTimeStamp.ReceiptDate = receipt[:4]
+	fwm.ReceiptTimeStamp.ReceiptTime = receipt[4:]
+	fwm.OutputMessageAccountabilityData = wire.NewOutputMessageAccountabilityData()
+	fwm.OutputMessageAccountabilityData.OutputCycleDate = "20190410"
+	fwm.OutputMessageAccountabilityData.OutputDestinationID = "Dest0001"
+	fwm.OutputMessageAccountabilityData.OutputSequenceNumber = seq
+	return fwm
+}
+
+func files(msgs ...wire.FEDWireMessage) []*wire.File {
+	file := wire.NewFile()
+	file.ID = "file"
+	for _, fwm := range msgs {
+		file.AddFEDWireMessage(fwm)
+	}
+	return []*wire.File{file}
+}
+
+func TestReconcile(t *testing.T) {
+	orig := readMessage(t, "fedWireMessage-CustomerTransfer.txt")
+
+	accepted := sent(orig, "000001")
+	intercepted := sent(orig, "000002")
+	rejected := sent(orig, "000003")
+	unacknowledged := sent(orig, "000004")
+
+	rejection := acknowledged(rejected, wire.MessageStatusRejected, "04101230", "000003")
+	rejection.ErrorWire = wire.NewErrorWire()
+	rejection.ErrorWire.ErrorCategory = wire.ErrorCategoryCutoffHour
+	rejection.ErrorWire.ErrorCode = "ABC"
+	rejection.ErrorWire.ErrorDescription = "After cutoff"
+
+	// a reversal of the accepted transfer, referring to its OMAD, and a reversal request of a transfer never sent
+	b, err := wire.BuildReversal(&accepted, true, "")
+	require.NoError(t, err)
+	reversal, err := b.IMAD("20190410", "Source09", "000001").Build()
+	require.NoError(t, err)
+	reversal.PreviousMessageIdentifier.PreviousMessageIdentifier = "20190410Dest0001000001"
+	reversal.MessageDisposition = wire.NewMessageDisposition()
+	reversal.MessageDisposition.MessageStatusIndicator = wire.MessageStatusIncomingValue
+
+	b, err = wire.BuildReversalRequest(&accepted, true, "")
+	require.NoError(t, err)
+	request, err := b.IMAD("20190410", "Source09", "000002").Build()
+	require.NoError(t, err)
+	request.PreviousMessageIdentifier.PreviousMessageIdentifier = "20190410Source08000099"
+
+	orphan := acknowledged(sent(orig, "000005"), wire.MessageStatusSuccessfulValue, "04101300", "000005")
+
+	report, err := Reconcile(
+		files(accepted, intercepted, rejected, unacknowledged),
+		files(
+			// intercepted, then accepted
+			acknowledged(accepted, wire.MessageStatusSuccessfulValue, "04101205", "000001"),
+			acknowledged(accepted, wire.MessageStatusInProcess, "04101200", "000001"),
+			acknowledged(intercepted, wire.MessageStatusInProcess, "04101210", "000002"),
+			rejection,
+			*reversal,
+			*request,
+			orphan,
+		),
+	)
+	require.NoError(t, err)
+
+	require.Len(t, report.Matched, 2)
+	require.Equal(t, "000001", report.Matched[0].Outbound.InputMessageAccountabilityData.InputSequenceNumber)
+	require.Equal(t, wire.AcknowledgementAccepted, report.Matched[0].Ack.Status)
+	require.Equal(t, "20190410Dest0001000001", report.Matched[0].Ack.OMAD[:22])
+	require.Equal(t, "000002", report.Matched[1].Outbound.InputMessageAccountabilityData.InputSequenceNumber)
+	require.Equal(t, wire.AcknowledgementIntercepted, report.Matched[1].Ack.Status)
+
+	require.Len(t, report.Rejected, 1)
+	require.Equal(t, "000003", report.Rejected[0].Outbound.InputMessageAccountabilityData.InputSequenceNumber)
+	require.Equal(t, wire.AcknowledgementRejected, report.Rejected[0].Ack.Status)
+	require.NotNil(t, report.Rejected[0].Details)
+	require.Equal(t, wire.ErrorCategoryCutoffHour, report.Rejected[0].Details.ErrorCategory)
+	require.Equal(t, "After cutoff", report.Rejected[0].Details.Description)
+	require.True(t, report.Rejected[0].Details.ResendAllowed)
+
+	require.Len(t, report.Unacknowledged, 1)
+	require.Equal(t, "000004", report.Unacknowledged[0].InputMessageAccountabilityData.InputSequenceNumber)
+
+	require.Len(t, report.Orphaned, 1)
+	require.Equal(t, "000005", report.Orphaned[0].InputMessageAccountabilityData.InputSequenceNumber)
+
+	require.Len(t, report.Related, 1)
+	require.Equal(t, RelationReversal, report.Related[0].Kind)
+	require.Equal(t, "000001", report.Related[0].Original.InputMessageAccountabilityData.InputSequenceNumber)
+	require.Equal(t, "Source09", report.Related[0].Message.InputMessageAccountabilityData.InputSource)
+
+	require.Len(t, report.Unresolved, 1)
+	require.Equal(t, wire.RequestReversal, report.Unresolved[0].TypeSubType.SubTypeCode)
+}
+
+func TestReconcile_RelatedByIMAD(t *testing.T) {
+	orig := readMessage(t, "fedWireMessage-CustomerCorporateDrawDownRequest.txt")
+	received := sent(orig, "000001")
+
+	// a drawdown payment sent in answer to a received drawdown request, referring to its IMAD
+	b, err := wire.BuildDrawdownPayment(&received)
+	require.NoError(t, err)
+	payment, err := b.IMAD("20190410", "Source09", "000001").Build()
+	require.NoError(t, err)
+
+	b, err = wire.BuildDrawdownRefusal(&received, "No funds")
+	require.NoError(t, err)
+	refusal, err := b.IMAD("20190410", "Source09", "000002").Build()
+	require.NoError(t, err)
+
+	report, err := Reconcile(files(*payment, *refusal), files(received))
+	require.NoError(t, err)
+	require.Len(t, report.Unacknowledged, 2)
+	require.Len(t, report.Related, 2)
+	require.Equal(t, RelationDrawdownPayment, report.Related[0].Kind)
+	require.Equal(t, RelationDrawdownRefusal, report.Related[1].Kind)
+	require.Same(t, received.InputMessageAccountabilityData, report.Related[0].Original.InputMessageAccountabilityData)
+	require.Empty(t, report.Unresolved)
+}
+
+func TestReconcile_InvalidAcknowledgement(t *testing.T) {
+	orig := readMessage(t, "fedWireMessage-CustomerTransfer.txt")
+	ack := acknowledged(sent(orig, "000001"), "Z", "04101200", "000001")
+
+	_, err := Reconcile(nil, files(ack))
+	require.ErrorIs(t, err, wire.ErrMessageStatusIndicator)
+}
@@ -0,0 +1,248 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/moov-io/base"
+)
+
+// Redaction masks the value of a sensitive field. Empty values are left empty.
+type Redaction func(value string) string
+
+// KeepLast returns a Redaction replacing every character of a value but the last n with X, e.g. XXXXXX6789
+// for an account number. Values of n characters or less are masked whole.
+func KeepLast(n int) Redaction {
+	return func(value string) string {
+		runes := []rune(value)
+		masked := len(runes) - n
+		if masked <= 0 {
+			masked = len(runes)
+		}
+		return strings.Repeat("X", masked) + string(runes[masked:])
+	}
+}
+
+// Mask returns a Redaction replacing every character of a value with X
+func Mask() Redaction {
+	return KeepLast(0)
+}
+
+// Hash returns a Redaction replacing a value with its HMAC-SHA256 keyed with key, so that the same value, such
+// as a name, can be recognized across log lines without being revealed.
+func Hash(key string) Redaction {
+	return func(value string) string {
+		mac := hmac.New(sha256.New, []byte(key))
+		mac.Write([]byte(value))
+		return "#" + hex.EncodeToString(mac.Sum(nil))[:16]
+	}
+}
+
+// RedactionPolicy is how to mask the sensitive fields of a FEDWireMessage, such as account numbers, names and
+// identification numbers, before logging or exporting it.
+type RedactionPolicy struct {
+	// Fields are the Redaction of each field, by the path of the Go field within the FEDWireMessage, e.g.
+	// Beneficiary.Personal.Identifier. Fields not listed are left as they are.
+	Fields map[string]Redaction
+}
+
+// DefaultRedactionPolicy returns a RedactionPolicy keeping only the last 4 characters of account numbers,
+// hashing names with key and masking identification and contact phone numbers. Identification numbers are
+// masked whole, as RemittanceOriginator.IdentificationNumber is a social security number when its
+// IdentificationCode is SOSE.
+func DefaultRedactionPolicy(key string) *RedactionPolicy {
+	account, name, mask := KeepLast(4), Hash(key), Mask()
+	return &RedactionPolicy{
+		Fields: map[string]Redaction{
+			"Beneficiary.Personal.Identifier":                     account,
+			"Beneficiary.Personal.Name":                           name,
+			"Originator.Personal.Identifier":                      account,
+			"Originator.Personal.Name":                            name,
+			"OriginatorOptionF.PartyIdentifier":                   account,
+			"OriginatorOptionF.Name":                              name,
+			"AccountDebitedDrawdown.Identifier":                   account,
+			"AccountDebitedDrawdown.Name":                         name,
+			"AccountCreditedDrawdown.DrawdownCreditAccountNumber": account,
+			"PaymentNotification.ContactName":                     name,
+			"PaymentNotification.ContactPhoneNumber":              mask,
+			"PaymentNotification.ContactMobileNumber":             mask,
+			"PaymentNotification.ContactFaxNumber":                mask,
+			"RemittanceOriginator.IdentificationNumber":           mask,
+			"RemittanceOriginator.RemittanceData.Name":            name,
+			"RemittanceOriginator.ContactName":                    name,
+			"RemittanceOriginator.ContactPhoneNumber":             mask,
+			"RemittanceOriginator.ContactMobileNumber":            mask,
+			"RemittanceOriginator.ContactFaxNumber":               mask,
+			"RemittanceBeneficiary.IdentificationNumber":          mask,
+			"RemittanceBeneficiary.RemittanceData.Name":           name,
+		},
+	}
+}
+
+// redaction returns the Redaction of the field at path, if any
+func (p *RedactionPolicy) redaction(path string) (Redaction, bool) {
+	if p == nil {
+		return nil, false
+	}
+	r, ok := p.Fields[path]
+	return r, ok && r != nil
+}
+
+// redactionOf returns the Redaction of a field named name within some tag, as named by the errors of a tag's
+// Validate, which don't tell the path of the field. The value of a field whose name ends a path of the policy
+// is masked whole, as the Redaction of that path may not be the one of the field.
+func (p *RedactionPolicy) redactionOf(name string) (Redaction, bool) {
+	if r, ok := p.redaction(name); ok || p == nil {
+		return r, ok
+	}
+	for path, r := range p.Fields {
+		if r != nil && strings.HasSuffix(path, "."+name) {
+			return Mask(), true
+		}
+	}
+	return nil, false
+}
+
+// Redacted returns a copy of the FEDWireMessage with the fields of policy masked. The FEDWireMessage itself is
+// left as it is.
+func (fwm *FEDWireMessage) Redacted(policy *RedactionPolicy) *FEDWireMessage {
+	out := *fwm
+	v := reflect.ValueOf(&out).Elem()
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		if _, ok := messageTags[t.Field(i).Name]; !ok {
+			continue
+		}
+		redactValue(v.Field(i), t.Field(i).Name, policy)
+	}
+	return &out
+}
+
+// redactValue masks the fields of policy within v, the field at path, copying the tags it points to first
+func redactValue(v reflect.Value, path string, policy *RedactionPolicy) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return
+		}
+		c := reflect.New(v.Type().Elem())
+		c.Elem().Set(v.Elem())
+		v.Set(c)
+		redactValue(c.Elem(), path, policy)
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			if t.Field(i).IsExported() {
+				redactValue(v.Field(i), path+"."+t.Field(i).Name, policy)
+			}
+		}
+	case reflect.String:
+		if r, ok := policy.redaction(path); ok && v.String() != "" {
+			v.SetString(r(v.String()))
+		}
+	}
+}
+
+// RedactError returns err with the values of the fields of the policy masked, so it can be logged. The values
+// of FieldErrors and ValidationErrors are masked, including those within a base.ErrorList, MessageError or
+// base.ParseError and those wrapped by other errors, such as with fmt.Errorf and %w. A ValidationError is
+// masked with the Redaction of its Field. Other errors are returned as they are.
+func (p *RedactionPolicy) RedactError(err error) error {
+	if p == nil || err == nil {
+		return err
+	}
+	switch e := err.(type) {
+	case base.ErrorList:
+		out := make(base.ErrorList, len(e))
+		for i := range e {
+			out[i] = p.RedactError(e[i])
+		}
+		return out
+	case *MessageError:
+		return &MessageError{Index: e.Index, Err: p.RedactError(e.Err)}
+	case *base.ParseError:
+		c := *e
+		c.Err = p.RedactError(e.Err)
+		return &c
+	case *ValidationError:
+		if e.Field == "" {
+			// the error couldn't be placed within the message, it's masked as the error it wraps
+			c := *e
+			c.Err = p.RedactError(e.Err)
+			if fe, ok := c.Err.(*FieldError); ok && c.Value != nil {
+				c.Value = fe.Value
+			}
+			return &c
+		}
+		r, ok := p.redaction(e.Field)
+		if !ok {
+			return err
+		}
+		c := *e
+		if c.Value != nil {
+			c.Value = redactField(r, c.Value)
+		}
+		if fe, ok := e.Err.(*FieldError); ok {
+			c.Err = redactFieldError(r, fe)
+		}
+		return &c
+	case *FieldError:
+		if r, ok := p.redactionOf(e.FieldName); ok {
+			return redactFieldError(r, e)
+		}
+		return err
+	}
+
+	// the message of an error wrapping one masked has the masked error's message in place of the original one
+	inner := errors.Unwrap(err)
+	if inner == nil {
+		return err
+	}
+	redacted := p.RedactError(inner)
+	if redacted.Error() == inner.Error() {
+		return err
+	}
+	return &redactedError{
+		msg: strings.Replace(err.Error(), inner.Error(), redacted.Error(), 1),
+		err: redacted,
+	}
+}
+
+// redactedError is an error whose message was masked by RedactError, wrapping the masked error it wrapped
+type redactedError struct {
+	msg string
+	err error
+}
+
+func (e *redactedError) Error() string {
+	return e.msg
+}
+
+func (e *redactedError) Unwrap() error {
+	return e.err
+}
+
+func redactFieldError(r Redaction, fe *FieldError) *FieldError {
+	c := *fe
+	if c.Value != nil {
+		c.Value = redactField(r, c.Value)
+	}
+	return &c
+}
+
+func redactField(r Redaction, value interface{}) string {
+	s := fmt.Sprint(value)
+	if s == "" {
+		return s
+	}
+	return r(s)
+}
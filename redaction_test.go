@@ -0,0 +1,122 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/moov-io/base"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedactions(t *testing.T) {
+	require.Equal(t, "XXXXXX7890", KeepLast(4)("1234567890"))
+	require.Equal(t, "XXXX", KeepLast(4)("1234"))
+	require.Equal(t, "XXXXXX", Mask()("123456"))
+
+	hash := Hash("key")
+	require.Equal(t, hash("Jane Doe"), hash("Jane Doe"))
+	require.NotEqual(t, hash("Jane Doe"), hash("John Doe"))
+	require.NotEqual(t, hash("Jane Doe"), Hash("other")("Jane Doe"))
+	require.Len(t, hash("Jane Doe"), 17)
+}
+
+func TestFEDWireMessage_Redacted(t *testing.T) {
+	fwm := mockCustomerTransferData()
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.Beneficiary.Personal.Identifier = "1234567890"
+	fwm.Originator = mockOriginator()
+	fwm.RemittanceOriginator = mockRemittanceOriginator()
+	fwm.RemittanceOriginator.IdentificationCode = PICSocialSecurityNumber
+	fwm.RemittanceOriginator.IdentificationNumber = "123456789"
+	fwm.RemittanceOriginator.ContactPhoneNumber = "5551234567"
+
+	out := fwm.Redacted(DefaultRedactionPolicy("key"))
+	require.Equal(t, "XXXXXX7890", out.Beneficiary.Personal.Identifier)
+	require.Equal(t, Hash("key")("Name"), out.Beneficiary.Personal.Name)
+	require.Equal(t, fwm.Beneficiary.Personal.Address, out.Beneficiary.Personal.Address)
+	require.Equal(t, "XXXXXXXXX", out.RemittanceOriginator.IdentificationNumber)
+	require.Equal(t, "XXXXXXXXXX", out.RemittanceOriginator.ContactPhoneNumber)
+	require.NotEqual(t, fwm.RemittanceOriginator.ContactMobileNumber, out.RemittanceOriginator.ContactMobileNumber)
+	require.Equal(t, fwm.Amount, out.Amount)
+	require.NoError(t, out.Beneficiary.Validate())
+
+	// the message itself is left as it is
+	require.Equal(t, "1234567890", fwm.Beneficiary.Personal.Identifier)
+	require.Equal(t, "123456789", fwm.RemittanceOriginator.IdentificationNumber)
+
+	var policy *RedactionPolicy
+	require.Equal(t, &fwm, fwm.Redacted(policy))
+}
+
+func TestRedactionPolicy_RedactError(t *testing.T) {
+	policy := DefaultRedactionPolicy("key")
+
+	fwm := mockCustomerTransferData()
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.Beneficiary.Personal.Identifier = "123456789®"
+	fwm.Originator = mockOriginator()
+	fwm.OriginatorFI = mockOriginatorFI()
+
+	// the errors of a tag's Validate don't tell which Identifier it is, so its value is masked whole
+	err := fwm.Beneficiary.Validate()
+	redacted := policy.RedactError(err)
+	require.Equal(t, "Identifier XXXXXXXXXX has non alphanumeric characters", redacted.Error())
+	require.ErrorIs(t, redacted, ErrNonAlphanumeric)
+
+	file := NewFile()
+	file.AddFEDWireMessage(fwm)
+	err = file.ValidateAll()
+	require.Contains(t, err.Error(), "123456789®")
+	redacted = policy.RedactError(err)
+	require.NotContains(t, redacted.Error(), "123456789®")
+	require.Contains(t, redacted.Error(), "XXXXXX789®")
+
+	var ve *ValidationError
+	require.True(t, errors.As(redacted.(base.ErrorList)[0], &ve))
+	require.Equal(t, "Beneficiary.Personal.Identifier", ve.Field)
+	require.Equal(t, "XXXXXX789®", ve.Value)
+
+	// errors wrapping those are masked too
+	err = fmt.Errorf("message 0: %w", newValidationError("Beneficiary", fwm.Beneficiary.Validate()))
+	require.Contains(t, err.Error(), "123456789®")
+	redacted = policy.RedactError(err)
+	require.Equal(t, "message 0: Identifier XXXXXX789® has non alphanumeric characters", redacted.Error())
+	require.ErrorIs(t, redacted, ErrNonAlphanumeric)
+	require.True(t, errors.As(redacted, &ve))
+	require.Equal(t, "XXXXXX789®", ve.Value)
+
+	// errors of fields outside the policy are left as they are
+	err = fieldError("Amount", ErrNonAmount, "1.00")
+	require.Equal(t, err, policy.RedactError(err))
+	err = fmt.Errorf("message 0: %w", err)
+	require.Equal(t, err, policy.RedactError(err))
+}
+
+func TestRedactionPolicy_RedactErrorField(t *testing.T) {
+	policy := &RedactionPolicy{
+		Fields: map[string]Redaction{
+			"Beneficiary.Personal.Identifier": KeepLast(4),
+			"Originator.Personal.Identifier":  Mask(),
+		},
+	}
+
+	// a ValidationError is masked with the Redaction of its own field
+	err := newValidationError("Beneficiary", fieldError("Identifier", ErrNonAlphanumeric, "123456789®"))
+	require.Equal(t, "Beneficiary.Personal.Identifier", err.Field)
+	redacted := policy.RedactError(err)
+	require.Equal(t, "Identifier XXXXXX789® has non alphanumeric characters", redacted.Error())
+
+	err = newValidationError("Originator", fieldError("Identifier", ErrNonAlphanumeric, "123456789®"))
+	redacted = policy.RedactError(err)
+	require.Equal(t, "Identifier XXXXXXXXXX has non alphanumeric characters", redacted.Error())
+
+	// that of a field outside the policy is left as it is, even if a field of the same name is in it
+	err = newValidationError("BeneficiaryFI", fieldError("Identifier", ErrNonAlphanumeric, "123456789®"))
+	require.Equal(t, "BeneficiaryFI.FinancialInstitution.Identifier", err.Field)
+	require.Equal(t, err, policy.RedactError(err))
+}
@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // Remittance is the remittance information
@@ -128,28 +130,29 @@ func (ri *Remittance) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on Remittance and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (ri *Remittance) Validate() error {
+	var errs base.ErrorList
 	if err := ri.fieldInclusion(); err != nil {
-		return err
+		errs.Add(err)
 	}
 	if ri.tag != TagRemittance {
-		return fieldError("tag", ErrValidTagForType, ri.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, ri.tag))
 	}
 	if err := ri.isAlphanumeric(ri.CoverPayment.SwiftFieldTag); err != nil {
-		return fieldError("SwiftFieldTag", err, ri.CoverPayment.SwiftFieldTag)
+		errs.Add(fieldError("SwiftFieldTag", err, ri.CoverPayment.SwiftFieldTag))
 	}
 	if err := ri.isAlphanumeric(ri.CoverPayment.SwiftLineOne); err != nil {
-		return fieldError("SwiftLineOne", err, ri.CoverPayment.SwiftLineOne)
+		errs.Add(fieldError("SwiftLineOne", err, ri.CoverPayment.SwiftLineOne))
 	}
 	if err := ri.isAlphanumeric(ri.CoverPayment.SwiftLineTwo); err != nil {
-		return fieldError("SwiftLineTwo", err, ri.CoverPayment.SwiftLineTwo)
+		errs.Add(fieldError("SwiftLineTwo", err, ri.CoverPayment.SwiftLineTwo))
 	}
 	if err := ri.isAlphanumeric(ri.CoverPayment.SwiftLineThree); err != nil {
-		return fieldError("SwiftLineThree", err, ri.CoverPayment.SwiftLineThree)
+		errs.Add(fieldError("SwiftLineThree", err, ri.CoverPayment.SwiftLineThree))
 	}
 	if err := ri.isAlphanumeric(ri.CoverPayment.SwiftLineFour); err != nil {
-		return fieldError("SwiftLineFour", err, ri.CoverPayment.SwiftLineFour)
+		errs.Add(fieldError("SwiftLineFour", err, ri.CoverPayment.SwiftLineFour))
 	}
-	return nil
+	return ri.collected(errs)
 }
 
 // fieldInclusion validate mandatory fields. If fields are
@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // RemittanceFreeText is the remittance free text
@@ -116,19 +118,20 @@ func (rft *RemittanceFreeText) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on RemittanceFreeText and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (rft *RemittanceFreeText) Validate() error {
+	var errs base.ErrorList
 	if rft.tag != TagRemittanceFreeText {
-		return fieldError("tag", ErrValidTagForType, rft.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, rft.tag))
 	}
 	if err := rft.isAlphanumeric(rft.LineOne); err != nil {
-		return fieldError("LineOne", err, rft.LineOne)
+		errs.Add(fieldError("LineOne", err, rft.LineOne))
 	}
 	if err := rft.isAlphanumeric(rft.LineTwo); err != nil {
-		return fieldError("LineTwo", err, rft.LineTwo)
+		errs.Add(fieldError("LineTwo", err, rft.LineTwo))
 	}
 	if err := rft.isAlphanumeric(rft.LineThree); err != nil {
-		return fieldError("LineThree", err, rft.LineThree)
+		errs.Add(fieldError("LineThree", err, rft.LineThree))
 	}
-	return nil
+	return rft.collected(errs)
 }
 
 // LineOneField gets a string of the LineOne field
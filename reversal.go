@@ -0,0 +1,190 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import "strings"
+
+// reasonLineWidth is the width of the lines the reason of a reversal or refusal is written on
+const reasonLineWidth = 35
+
+// BuildReversal returns a Builder for the reversal of orig, a value transfer received earlier. The reversal is
+// a ReversalTransfer (02) when sameDay is true and a ReversalPriorDayTransfer (08) otherwise, with orig's
+// business function code and amount.
+//
+// The reversal goes back from orig's receiver to its sender. Its {3500} PreviousMessageIdentifier is orig's
+// IMAD, or OMAD when orig has no IMAD, and its originator and beneficiary, and their financial institutions,
+// are orig's the other way round. reason, if any, is written to {6000} OriginatorToBeneficiary.
+//
+// As with the NewX constructors the IMAD of the reversal itself must be set before calling Build.
+func BuildReversal(orig *FEDWireMessage, sameDay bool, reason string) (*Builder, error) {
+	subType := ReversalPriorDayTransfer
+	if sameDay {
+		subType = ReversalTransfer
+	}
+	if err := checkReversible(orig); err != nil {
+		return nil, err
+	}
+	b, err := reverse(orig, orig.BusinessFunctionCode.BusinessFunctionCode, subType)
+	if err != nil {
+		return nil, err
+	}
+	lines, err := reasonLines("OriginatorToBeneficiary", reason, 4)
+	if err != nil {
+		return nil, err
+	}
+	if lines != nil {
+		ob := NewOriginatorToBeneficiary()
+		ob.LineOne, ob.LineTwo, ob.LineThree, ob.LineFour = lines[0], lines[1], lines[2], lines[3]
+		b.fwm.OriginatorToBeneficiary = ob
+	}
+	return b, nil
+}
+
+// BuildReversalRequest returns a Builder for a non-value request asking orig's receiver to reverse orig. The
+// request is a RequestReversal (01) when sameDay is true and a RequestReversalPriorDayTransfer (07) otherwise.
+// It's a CustomerTransferPlus when orig is one and a BFCServiceMessage otherwise.
+//
+// Like a reversal, the request carries orig's amount, identifies orig in {3500} PreviousMessageIdentifier and
+// mirrors its parties. reason, if any, is written to {9000} ServiceMessage.
+func BuildReversalRequest(orig *FEDWireMessage, sameDay bool, reason string) (*Builder, error) {
+	subType := RequestReversalPriorDayTransfer
+	if sameDay {
+		subType = RequestReversal
+	}
+	if err := checkReversible(orig); err != nil {
+		return nil, err
+	}
+	bfc := BFCServiceMessage
+	if orig.BusinessFunctionCode.BusinessFunctionCode == CustomerTransferPlus {
+		bfc = CustomerTransferPlus
+	}
+	b, err := reverse(orig, bfc, subType)
+	if err != nil {
+		return nil, err
+	}
+	lines, err := reasonLines("ServiceMessage", reason, 12)
+	if err != nil {
+		return nil, err
+	}
+	if lines != nil {
+		sm := NewServiceMessage()
+		sm.LineOne, sm.LineTwo, sm.LineThree, sm.LineFour = lines[0], lines[1], lines[2], lines[3]
+		sm.LineFive, sm.LineSix, sm.LineSeven, sm.LineEight = lines[4], lines[5], lines[6], lines[7]
+		sm.LineNine, sm.LineTen, sm.LineEleven, sm.LineTwelve = lines[8], lines[9], lines[10], lines[11]
+		b.fwm.ServiceMessage = sm
+	}
+	return b, nil
+}
+
+// IsReversal returns true if the message is a ReversalTransfer (02) or ReversalPriorDayTransfer (08)
+func (fwm *FEDWireMessage) IsReversal() bool {
+	if fwm.TypeSubType == nil {
+		return false
+	}
+	switch fwm.TypeSubType.SubTypeCode {
+	case ReversalTransfer, ReversalPriorDayTransfer:
+		return true
+	}
+	return false
+}
+
+// checkReversible returns an error unless orig is a basic value transfer of a business function code which
+// can be reversed
+func checkReversible(orig *FEDWireMessage) error {
+	if err := requireReplyTags(orig); err != nil {
+		return err
+	}
+	typeSubType := orig.TypeSubType.TypeCode + orig.TypeSubType.SubTypeCode
+	if orig.TypeSubType.SubTypeCode != BasicFundsTransfer {
+		return fieldError("TypeSubType", ErrNotReversible, typeSubType)
+	}
+	bfc := orig.BusinessFunctionCode.BusinessFunctionCode
+	if !typeSubTypesOf(bfc).Contains(orig.TypeSubType.TypeCode + ReversalTransfer) {
+		return fieldError("BusinessFunctionCode", ErrNotReversible, bfc)
+	}
+	return nil
+}
+
+// reverse returns a reply to orig of business function code bfc and subType with orig's parties mirrored
+func reverse(orig *FEDWireMessage, bfc, subType string) (*Builder, error) {
+	b, err := reply(orig, bfc, subType)
+	if err != nil {
+		return nil, err
+	}
+	if ben := orig.Beneficiary; ben != nil {
+		b.fwm.Originator = NewOriginator()
+		b.fwm.Originator.Personal = ben.Personal
+	}
+	switch {
+	case orig.Originator != nil:
+		b.fwm.Beneficiary = NewBeneficiary()
+		b.fwm.Beneficiary.Personal = orig.Originator.Personal
+	case orig.OriginatorOptionF != nil:
+		b.fwm.Beneficiary = NewBeneficiary()
+		b.fwm.Beneficiary.Personal = optionFPersonal(orig.OriginatorOptionF)
+	}
+	if fi := orig.BeneficiaryFI; fi != nil {
+		b.fwm.OriginatorFI = NewOriginatorFI()
+		b.fwm.OriginatorFI.FinancialInstitution = fi.FinancialInstitution
+	}
+	if fi := orig.OriginatorFI; fi != nil {
+		b.fwm.BeneficiaryFI = NewBeneficiaryFI()
+		b.fwm.BeneficiaryFI.FinancialInstitution = fi.FinancialInstitution
+	}
+	return b, nil
+}
+
+// optionFPersonal returns the Personal of an OriginatorOptionF. An account number party identifier becomes a
+// DemandDepositAccountNumber and the line numbers of the name and address lines are dropped.
+func optionFPersonal(oof *OriginatorOptionF) Personal {
+	var p Personal
+	if id := strings.TrimSpace(oof.PartyIdentifier); strings.HasPrefix(id, "/") {
+		p.IdentificationCode, p.Identifier = DemandDepositAccountNumber, strings.TrimPrefix(id, "/")
+	}
+	line := func(s string) string {
+		if len(s) > 2 && s[1] == '/' {
+			return s[2:]
+		}
+		return s
+	}
+	p.Name = line(oof.Name)
+	p.Address = Address{
+		AddressLineOne:   line(oof.LineOne),
+		AddressLineTwo:   line(oof.LineTwo),
+		AddressLineThree: line(oof.LineThree),
+	}
+	return p
+}
+
+// reasonLines splits reason into n lines of at most reasonLineWidth characters, breaking them between words
+// where it can, or returns nil when there's no reason. Words longer than a line are broken across lines.
+func reasonLines(field, reason string, n int) ([]string, error) {
+	words := strings.Fields(reason)
+	if len(words) == 0 {
+		return nil, nil
+	}
+	var lines []string
+	var line []rune
+	for _, word := range words {
+		w := []rune(word)
+		if len(line) > 0 && len(line)+1+len(w) <= reasonLineWidth {
+			line = append(append(line, ' '), w...)
+			continue
+		}
+		if len(line) > 0 {
+			lines = append(lines, string(line))
+		}
+		for len(w) > reasonLineWidth {
+			lines = append(lines, string(w[:reasonLineWidth]))
+			w = w[reasonLineWidth:]
+		}
+		line = w
+	}
+	lines = append(lines, string(line))
+	if len(lines) > n {
+		return nil, fieldError(field, ErrValidLength, reason)
+	}
+	return append(lines, make([]string, n-len(lines))...), nil
+}
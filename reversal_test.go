@@ -0,0 +1,145 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// readTestMessage reads the only FEDWireMessage of a file in test/testdata
+func readTestMessage(t *testing.T, name string) *FEDWireMessage {
+	t.Helper()
+
+	fd, err := os.Open(filepath.Join("test", "testdata", name))
+	require.NoError(t, err)
+	defer fd.Close()
+
+	file, err := NewReader(fd).Read()
+	require.NoError(t, err)
+	require.Len(t, file.FEDWireMessages, 1)
+	return &file.FEDWireMessages[0]
+}
+
+func TestBuildReversal(t *testing.T) {
+	orig := readTestMessage(t, "fedWireMessage-CustomerTransfer.txt")
+
+	b, err := BuildReversal(orig, true, "Duplicate payment")
+	require.NoError(t, err)
+	fwm, err := b.IMAD("20190411", "Source08", "000002").Build()
+	require.NoError(t, err)
+
+	require.Equal(t, FundsTransfer+ReversalTransfer, fwm.TypeSubType.TypeCode+fwm.TypeSubType.SubTypeCode)
+	require.Equal(t, CustomerTransfer, fwm.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, orig.Amount.Amount, fwm.Amount.Amount)
+	require.Equal(t, "231380104", fwm.SenderDepositoryInstitution.SenderABANumber)
+	require.Equal(t, "Citadel", fwm.SenderDepositoryInstitution.SenderShortName)
+	require.Equal(t, "121042882", fwm.ReceiverDepositoryInstitution.ReceiverABANumber)
+	require.Equal(t, "20190410Source08000001", fwm.PreviousMessageIdentifier.PreviousMessageIdentifier)
+	require.Equal(t, orig.Beneficiary.Personal, fwm.Originator.Personal)
+	require.Equal(t, orig.Originator.Personal, fwm.Beneficiary.Personal)
+	require.Equal(t, orig.BeneficiaryFI.FinancialInstitution, fwm.OriginatorFI.FinancialInstitution)
+	require.Equal(t, orig.OriginatorFI.FinancialInstitution, fwm.BeneficiaryFI.FinancialInstitution)
+	require.Equal(t, "Duplicate payment", fwm.OriginatorToBeneficiary.LineOne)
+	require.Nil(t, fwm.Charges)
+	require.True(t, fwm.IsReversal())
+	require.False(t, orig.IsReversal())
+	requireWrites(t, fwm)
+
+	b, err = BuildReversal(orig, false, "")
+	require.NoError(t, err)
+	fwm, err = b.IMAD("20190412", "Source08", "000003").Build()
+	require.NoError(t, err)
+	require.Equal(t, ReversalPriorDayTransfer, fwm.TypeSubType.SubTypeCode)
+	require.Nil(t, fwm.OriginatorToBeneficiary)
+}
+
+func TestBuildReversal_outputMessage(t *testing.T) {
+	orig := readTestMessage(t, "fedWireMessage-BankTransfer.txt")
+	orig.InputMessageAccountabilityData = nil
+	orig.OutputMessageAccountabilityData = NewOutputMessageAccountabilityData()
+	orig.OutputMessageAccountabilityData.OutputCycleDate = "20190410"
+	orig.OutputMessageAccountabilityData.OutputDestinationID = "Dest0001"
+	orig.OutputMessageAccountabilityData.OutputSequenceNumber = "000042"
+
+	b, err := BuildReversal(orig, true, "")
+	require.NoError(t, err)
+	fwm, err := b.IMAD("20190410", "Source08", "000002").Build()
+	require.NoError(t, err)
+	require.Equal(t, BankTransfer, fwm.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, "20190410Dest0001000042", fwm.PreviousMessageIdentifier.PreviousMessageIdentifier)
+
+	orig.OutputMessageAccountabilityData = nil
+	_, err = BuildReversal(orig, true, "")
+	require.ErrorIs(t, err, ErrFieldRequired)
+}
+
+func TestBuildReversalRequest(t *testing.T) {
+	orig := readTestMessage(t, "fedWireMessage-CustomerTransfer.txt")
+
+	b, err := BuildReversalRequest(orig, false, "Sent in error, please return")
+	require.NoError(t, err)
+	fwm, err := b.IMAD("20190411", "Source08", "000002").Build()
+	require.NoError(t, err)
+
+	require.Equal(t, FundsTransfer+RequestReversalPriorDayTransfer, fwm.TypeSubType.TypeCode+fwm.TypeSubType.SubTypeCode)
+	require.Equal(t, BFCServiceMessage, fwm.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, "20190410Source08000001", fwm.PreviousMessageIdentifier.PreviousMessageIdentifier)
+	require.Equal(t, "Sent in error, please return", fwm.ServiceMessage.LineOne)
+	requireWrites(t, fwm)
+
+	// customer transfers plus are reversed with one, mirroring an option F originator
+	orig = readTestMessage(t, "fedWireMessage-CustomerTransferPlus.txt")
+	orig.Originator = nil
+	b, err = BuildReversalRequest(orig, true, strings.Repeat("Reason ", 10))
+	require.NoError(t, err)
+	fwm, err = b.IMAD("20190508", "Source08", "000002").Build()
+	require.NoError(t, err)
+
+	require.Equal(t, FundsTransfer+RequestReversal, fwm.TypeSubType.TypeCode+fwm.TypeSubType.SubTypeCode)
+	require.Equal(t, CustomerTransferPlus, fwm.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, "Reason Reason Reason Reason Reason", fwm.ServiceMessage.LineOne)
+	require.Equal(t, "Reason Reason Reason Reason Reason", fwm.ServiceMessage.LineTwo)
+	require.Equal(t, Personal{
+		Name:    "Name",
+		Address: Address{AddressLineOne: "1234", AddressLineTwo: "1000 Colonial Farm Rd", AddressLineThree: "Pottstown"},
+	}, fwm.Beneficiary.Personal)
+}
+
+func TestBuildReversal_notReversible(t *testing.T) {
+	_, err := BuildReversal(readTestMessage(t, "fedWireMessage-CustomerCorporateDrawDownRequest.txt"), true, "")
+	require.ErrorIs(t, err, ErrNotReversible)
+
+	orig := readTestMessage(t, "fedWireMessage-CustomerTransfer.txt")
+	orig.TypeSubType.SubTypeCode = ReversalTransfer
+	_, err = BuildReversalRequest(orig, true, "")
+	require.ErrorIs(t, err, ErrNotReversible)
+
+	_, err = BuildReversal(nil, true, "")
+	require.ErrorIs(t, err, ErrFieldRequired)
+
+	orig = readTestMessage(t, "fedWireMessage-CustomerTransfer.txt")
+	_, err = BuildReversal(orig, true, strings.Repeat("x", 4*35+1))
+	require.ErrorIs(t, err, ErrValidLength)
+}
+
+func TestReasonLines(t *testing.T) {
+	lines, err := reasonLines("ServiceMessage", "  ", 2)
+	require.NoError(t, err)
+	require.Nil(t, lines)
+
+	// lines are broken between words and counted in characters rather than bytes
+	reason := strings.Repeat("Réversal ", 4) + strings.Repeat("é", 40)
+	lines, err = reasonLines("ServiceMessage", reason, 3)
+	require.NoError(t, err)
+	require.Equal(t, []string{"Réversal Réversal Réversal Réversal", strings.Repeat("é", 35), "ééééé"}, lines)
+
+	_, err = reasonLines("ServiceMessage", reason, 2)
+	require.ErrorIs(t, err, ErrValidLength)
+}
@@ -0,0 +1,143 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"sync"
+)
+
+// RuleFunc checks a FEDWireMessage against a policy of its own and returns every error found, or none
+type RuleFunc func(fwm *FEDWireMessage) []error
+
+// Rule is a custom validation rule, such as a bank's own policy on top of the Fedwire rules. Rules are
+// registered globally with RegisterRule or for some messages on their ValidateOpts, and run once the
+// mandatory tags are valid, so a Rule can rely on them being present.
+type Rule struct {
+	// ID identifies the Rule, so it can be enabled or disabled through ValidateOpts
+	ID string
+	// Check is the check the Rule runs
+	Check RuleFunc
+	// Disabled rules only run when enabled through ValidateOpts.EnableRules
+	Disabled bool
+}
+
+var (
+	// ErrRuleID is the error given when a Rule has no ID or shares its ID with a registered Rule
+	ErrRuleID = errors.New("rule must have a unique ID")
+	// ErrRuleCheck is the error given when a Rule has no Check
+	ErrRuleCheck = errors.New("rule must have a Check")
+)
+
+var (
+	ruleRegistryMu sync.RWMutex
+	ruleRegistry   = make(map[string]Rule)
+)
+
+// RegisterRule registers rule to run when any FEDWireMessage is validated
+func RegisterRule(rule Rule) error {
+	if err := rule.validate(); err != nil {
+		return err
+	}
+	ruleRegistryMu.Lock()
+	defer ruleRegistryMu.Unlock()
+	if _, ok := ruleRegistry[rule.ID]; ok {
+		return fieldError("ID", ErrRuleID, rule.ID)
+	}
+	ruleRegistry[rule.ID] = rule
+	return nil
+}
+
+// UnregisterRule removes the registered Rule of id, if any
+func UnregisterRule(id string) {
+	ruleRegistryMu.Lock()
+	defer ruleRegistryMu.Unlock()
+	delete(ruleRegistry, id)
+}
+
+// RegisteredRules returns the registered rules ordered by ID
+func RegisteredRules() []Rule {
+	ruleRegistryMu.RLock()
+	defer ruleRegistryMu.RUnlock()
+	out := make([]Rule, 0, len(ruleRegistry))
+	for _, rule := range ruleRegistry {
+		out = append(out, rule)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	return out
+}
+
+func (rule Rule) validate() error {
+	if rule.ID == "" {
+		return fieldError("ID", ErrRuleID, rule.ID)
+	}
+	if rule.Check == nil {
+		return fieldError("Check", ErrRuleCheck)
+	}
+	return nil
+}
+
+// RuleError is the error given when a FEDWireMessage breaks a Rule
+type RuleError struct {
+	RuleID string // ID of the Rule broken
+	Err    error  // the error returned by the Rule's Check
+}
+
+func (e *RuleError) Error() string {
+	return fmt.Sprintf("rule %s: %v", e.RuleID, e.Err)
+}
+
+// Unwrap implements the base.UnwrappableError interface for RuleError
+func (e *RuleError) Unwrap() error {
+	return e.Err
+}
+
+// rules returns the rules to run for opts, the registered rules followed by those of opts, without those
+// disabled. A Rule of opts replaces a registered Rule of the same ID.
+func (opts *ValidateOpts) rules() []Rule {
+	var own []Rule
+	if opts != nil {
+		own = opts.Rules
+	}
+	var out []Rule
+	for _, rule := range RegisteredRules() {
+		if !slices.ContainsFunc(own, func(r Rule) bool { return r.ID == rule.ID }) && opts.ruleEnabled(rule) {
+			out = append(out, rule)
+		}
+	}
+	for _, rule := range own {
+		if rule.Check != nil && opts.ruleEnabled(rule) {
+			out = append(out, rule)
+		}
+	}
+	return out
+}
+
+// ruleEnabled returns true if rule should run for opts. opts may be nil.
+func (opts *ValidateOpts) ruleEnabled(rule Rule) bool {
+	if opts == nil {
+		return !rule.Disabled
+	}
+	if slices.Contains(opts.DisableRules, rule.ID) {
+		return false
+	}
+	return !rule.Disabled || slices.Contains(opts.EnableRules, rule.ID)
+}
+
+// runRules runs the rules of the message's ValidateOptions and returns the errors found as RuleErrors
+func (fwm *FEDWireMessage) runRules() []error {
+	var errs []error
+	for _, rule := range fwm.validation().rules() {
+		for _, err := range rule.Check(fwm) {
+			if err != nil {
+				errs = append(errs, &RuleError{RuleID: rule.ID, Err: err})
+			}
+		}
+	}
+	return errs
+}
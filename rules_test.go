@@ -0,0 +1,113 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/moov-io/base"
+	"github.com/stretchr/testify/require"
+)
+
+// requireOriginatorToBeneficiary is a bank policy requiring {6000} on customer transfers of $1M or more
+var requireOriginatorToBeneficiary = RuleFunc(func(fwm *FEDWireMessage) []error {
+	if fwm.BusinessFunctionCode.BusinessFunctionCode == CustomerTransfer &&
+		fwm.Amount.Amount >= "000100000000" && fwm.OriginatorToBeneficiary == nil {
+		return []error{fieldError("OriginatorToBeneficiary", ErrFieldRequired)}
+	}
+	return nil
+})
+
+func mockLargeCustomerTransfer() FEDWireMessage {
+	fwm := mockCustomerTransferData()
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.Originator = mockOriginator()
+	fwm.Amount.Amount = "000200000000"
+	return fwm
+}
+
+func TestRegisterRule(t *testing.T) {
+	t.Cleanup(func() { UnregisterRule("ctr-otb") })
+
+	require.ErrorIs(t, RegisterRule(Rule{Check: requireOriginatorToBeneficiary}), ErrRuleID)
+	require.ErrorIs(t, RegisterRule(Rule{ID: "ctr-otb"}), ErrRuleCheck)
+	require.NoError(t, RegisterRule(Rule{ID: "ctr-otb", Check: requireOriginatorToBeneficiary}))
+	require.ErrorIs(t, RegisterRule(Rule{ID: "ctr-otb", Check: requireOriginatorToBeneficiary}), ErrRuleID)
+	require.Len(t, RegisteredRules(), 1)
+
+	fwm := mockLargeCustomerTransfer()
+	err := fwm.verify()
+	var ruleErr *RuleError
+	require.ErrorAs(t, err, &ruleErr)
+	require.Equal(t, "ctr-otb", ruleErr.RuleID)
+	require.ErrorIs(t, err, ErrFieldRequired)
+
+	// the rule is met
+	fwm.OriginatorToBeneficiary = mockOriginatorToBeneficiary()
+	require.NoError(t, fwm.verify())
+
+	// or disabled for the message
+	fwm.OriginatorToBeneficiary = nil
+	fwm.ValidateOptions = &ValidateOpts{DisableRules: []string{"ctr-otb"}}
+	require.NoError(t, fwm.verify())
+
+	UnregisterRule("ctr-otb")
+	require.Empty(t, RegisteredRules())
+	fwm.ValidateOptions = nil
+	require.NoError(t, fwm.verify())
+}
+
+func TestValidateOpts__Rules(t *testing.T) {
+	forbidden := Rule{
+		ID: "btr-forbidden-aba",
+		Check: func(fwm *FEDWireMessage) []error {
+			if fwm.ReceiverDepositoryInstitution.ReceiverABANumber == "231380104" {
+				return []error{fieldError("ReceiverABANumber", errors.New("receiver is forbidden"), "231380104")}
+			}
+			return nil
+		},
+		Disabled: true,
+	}
+	fwm := mockLargeCustomerTransfer()
+	fwm.ValidateOptions = &ValidateOpts{
+		Rules: []Rule{{ID: "ctr-otb", Check: requireOriginatorToBeneficiary}, forbidden},
+	}
+
+	// a disabled rule only runs once enabled
+	err := fwm.ValidateAll()
+	require.Len(t, err.(base.ErrorList), 1)
+	fwm.ValidateOptions.EnableRules = []string{forbidden.ID}
+	err = fwm.ValidateAll()
+	require.Len(t, err.(base.ErrorList), 2)
+
+	var ve *ValidationError
+	require.ErrorAs(t, err.(base.ErrorList)[0], &ve)
+	require.Equal(t, "ctr-otb", ve.Rule)
+	require.Equal(t, "field_required", ve.Code)
+	require.Equal(t, "/fedWireMessage/originatorToBeneficiary", ve.Pointer)
+	require.ErrorAs(t, err.(base.ErrorList)[1], &ve)
+	require.Equal(t, forbidden.ID, ve.Rule)
+	require.Equal(t, "rule", ve.Code)
+
+	// rules aren't run against messages missing mandatory tags
+	fwm.Amount = nil
+	err = fwm.ValidateAll()
+	require.Len(t, err.(base.ErrorList), 1)
+	require.ErrorIs(t, err.(base.ErrorList)[0], ErrFieldRequired)
+
+	fwm.ValidateOptions.SkipAll = true
+	require.NoError(t, fwm.ValidateAll())
+}
+
+func TestFile__ValidateRules(t *testing.T) {
+	file := NewFile()
+	file.AddFEDWireMessage(mockLargeCustomerTransfer())
+	require.NoError(t, file.Validate())
+
+	file.SetValidation(&ValidateOpts{Rules: []Rule{{ID: "ctr-otb", Check: requireOriginatorToBeneficiary}}})
+	err := file.Validate()
+	require.ErrorContains(t, err, "rule ctr-otb: OriginatorToBeneficiary is a required field")
+}
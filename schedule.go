@@ -0,0 +1,117 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"time"
+)
+
+// Schedule is an operating schedule of the Fedwire Funds Service, the window in which messages are accepted
+// for a cycle date. Times are offsets from the start of the cycle date in EasternTime.
+type Schedule struct {
+	// Open is when the Funds Service opens for a cycle date
+	Open time.Duration
+	// Close is the cutoff of messages without a cutoff in Cutoffs
+	Close time.Duration
+	// Cutoffs are the cutoffs of BusinessFunctionCodes closing before the Funds Service, such as third-party transfers
+	Cutoffs map[string]time.Duration
+	// Extensions postpone every cutoff of a cycle date, such as when the Federal Reserve Banks extend the
+	// operating hours for the day, keyed by cycle date in CycleDateFormat
+	Extensions map[string]time.Duration
+}
+
+// DefaultSchedule returns the standard schedule of the Fedwire Funds Service, open from 9:00 p.m. the preceding
+// calendar day to 7:00 p.m., with a 6:00 p.m. cutoff for customer transfers
+func DefaultSchedule() *Schedule {
+	return &Schedule{
+		Open:  -3 * time.Hour,
+		Close: 19 * time.Hour,
+		Cutoffs: map[string]time.Duration{
+			CustomerTransfer:     18 * time.Hour,
+			CustomerTransferPlus: 18 * time.Hour,
+		},
+	}
+}
+
+// fedSchedule is the schedule NextCycleDate follows
+var fedSchedule = DefaultSchedule()
+
+// Opens returns when the Funds Service opens for the cycle date of cycleDate's date
+func (s *Schedule) Opens(cycleDate time.Time) time.Time {
+	return cycleDay(cycleDate).Add(s.Open)
+}
+
+// Cutoff returns the time messages of code must be sent by for the cycle date of cycleDate's date
+func (s *Schedule) Cutoff(code string, cycleDate time.Time) time.Time {
+	day := cycleDay(cycleDate)
+	cutoff, ok := s.Cutoffs[code]
+	if !ok {
+		cutoff = s.Close
+	}
+	return day.Add(cutoff + s.Extensions[day.Format(CycleDateFormat)])
+}
+
+// CanSendToday returns true if a message of code sent at now makes the cycle date of now's date in EasternTime
+func (s *Schedule) CanSendToday(code string, now time.Time) bool {
+	today := startOfDay(now.In(EasternTime))
+	return IsBusinessDay(today) && now.Before(s.Cutoff(code, today))
+}
+
+// NextCycleDate returns the first cycle date, as the start of the day in EasternTime, a message of code sent at
+// now makes
+func (s *Schedule) NextCycleDate(code string, now time.Time) time.Time {
+	today := startOfDay(now.In(EasternTime))
+	if s.CanSendToday(code, now) {
+		return today
+	}
+	return NextBusinessDay(today)
+}
+
+// TimeUntilCutoff returns how long is left at now to send a message of code for its NextCycleDate
+func (s *Schedule) TimeUntilCutoff(code string, now time.Time) time.Duration {
+	return s.Cutoff(code, s.NextCycleDate(code, now)).Sub(now)
+}
+
+// ScheduleStatus describes when a message of a BusinessFunctionCode can be sent at a point in time
+type ScheduleStatus struct {
+	// BusinessFunctionCode of the message
+	BusinessFunctionCode string
+	// Time the status is of
+	Time time.Time
+	// SendToday is true if the message still makes today's cycle date
+	SendToday bool
+	// Open is true if the Funds Service is accepting the message for CycleDate
+	Open bool
+	// CycleDate is the next cycle date the message makes
+	CycleDate time.Time
+	// Opens is when the Funds Service opens for CycleDate
+	Opens time.Time
+	// Cutoff is the time the message must be sent by for CycleDate
+	Cutoff time.Time
+	// UntilCutoff is how long is left until Cutoff
+	UntilCutoff time.Duration
+}
+
+// Status returns the ScheduleStatus of a message of code at now
+func (s *Schedule) Status(code string, now time.Time) ScheduleStatus {
+	cycleDate := s.NextCycleDate(code, now)
+	opens, cutoff := s.Opens(cycleDate), s.Cutoff(code, cycleDate)
+	return ScheduleStatus{
+		BusinessFunctionCode: code,
+		Time:                 now,
+		SendToday:            s.CanSendToday(code, now),
+		Open:                 !now.Before(opens) && now.Before(cutoff),
+		CycleDate:            cycleDate,
+		Opens:                opens,
+		Cutoff:               cutoff,
+		UntilCutoff:          cutoff.Sub(now),
+	}
+}
+
+// cycleDay returns the start of the day in EasternTime of t's date
+func cycleDay(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, EasternTime)
+}
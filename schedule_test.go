@@ -0,0 +1,85 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchedule_Cutoff(t *testing.T) {
+	s := DefaultSchedule()
+	day := eastern(2023, time.July, 5, 0)
+
+	require.Equal(t, eastern(2023, time.July, 5, 18), s.Cutoff(CustomerTransfer, day))
+	require.Equal(t, eastern(2023, time.July, 5, 18), s.Cutoff(CustomerTransferPlus, day))
+	require.Equal(t, eastern(2023, time.July, 5, 19), s.Cutoff(BankTransfer, day))
+	require.Equal(t, eastern(2023, time.July, 4, 21), s.Opens(day))
+
+	// the date of the cycle date counts, not its time or location
+	require.Equal(t, eastern(2023, time.July, 5, 19), s.Cutoff(BankTransfer, time.Date(2023, time.July, 5, 23, 0, 0, 0, time.UTC)))
+
+	s.Cutoffs[BankTransfer] = 17*time.Hour + 30*time.Minute
+	s.Extensions = map[string]time.Duration{"20230705": time.Hour}
+	require.Equal(t, eastern(2023, time.July, 5, 18).Add(30*time.Minute), s.Cutoff(BankTransfer, day))
+	require.Equal(t, eastern(2023, time.July, 5, 19), s.Cutoff(CustomerTransfer, day))
+	require.Equal(t, eastern(2023, time.July, 6, 19), s.Cutoff(FEDFundsSold, eastern(2023, time.July, 6, 0)))
+}
+
+func TestSchedule_NextCycleDate(t *testing.T) {
+	s := DefaultSchedule()
+
+	// between the customer transfer cutoff and the close
+	now := eastern(2023, time.July, 5, 18).Add(30 * time.Minute)
+	require.False(t, s.CanSendToday(CustomerTransfer, now))
+	require.True(t, s.CanSendToday(BankTransfer, now))
+	require.Equal(t, eastern(2023, time.July, 6, 0), s.NextCycleDate(CustomerTransfer, now))
+	require.Equal(t, eastern(2023, time.July, 5, 0), s.NextCycleDate(BankTransfer, now))
+	require.Equal(t, 23*time.Hour+30*time.Minute, s.TimeUntilCutoff(CustomerTransfer, now))
+	require.Equal(t, 30*time.Minute, s.TimeUntilCutoff(BankTransfer, now))
+
+	// a holiday
+	now = eastern(2023, time.July, 4, 10)
+	require.False(t, s.CanSendToday(BankTransfer, now))
+	require.Equal(t, eastern(2023, time.July, 5, 0), s.NextCycleDate(BankTransfer, now))
+
+	// an extension
+	s.Extensions = map[string]time.Duration{"20230705": time.Hour}
+	now = eastern(2023, time.July, 5, 18).Add(30 * time.Minute)
+	require.True(t, s.CanSendToday(CustomerTransfer, now))
+	require.Equal(t, 30*time.Minute, s.TimeUntilCutoff(CustomerTransfer, now))
+}
+
+func TestSchedule_Status(t *testing.T) {
+	s := DefaultSchedule()
+
+	// Friday evening, after the close: open again Sunday evening for Monday
+	now := eastern(2023, time.July, 7, 20)
+	status := s.Status(CustomerTransfer, now)
+	require.Equal(t, ScheduleStatus{
+		BusinessFunctionCode: CustomerTransfer,
+		Time:                 now,
+		SendToday:            false,
+		Open:                 false,
+		CycleDate:            eastern(2023, time.July, 10, 0),
+		Opens:                eastern(2023, time.July, 9, 21),
+		Cutoff:               eastern(2023, time.July, 10, 18),
+		UntilCutoff:          70 * time.Hour,
+	}, status)
+
+	// Sunday evening, once open for Monday
+	status = s.Status(CustomerTransfer, eastern(2023, time.July, 9, 22))
+	require.False(t, status.SendToday)
+	require.True(t, status.Open)
+	require.Equal(t, eastern(2023, time.July, 10, 0), status.CycleDate)
+
+	// Monday morning
+	status = s.Status(CustomerTransfer, eastern(2023, time.July, 10, 9))
+	require.True(t, status.SendToday)
+	require.True(t, status.Open)
+	require.Equal(t, 9*time.Hour, status.UntilCutoff)
+}
@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // SecondaryRemittanceDocument is the date of remittance document
@@ -128,25 +130,26 @@ func (srd *SecondaryRemittanceDocument) Format(options FormatOptions) string {
 // * Document Type Code and Document Identification Number are mandatory.
 // * Proprietary Document Type Code is mandatory for Document Type Code PROP; otherwise not permitted.
 func (srd *SecondaryRemittanceDocument) Validate() error {
+	var errs base.ErrorList
 	if err := srd.fieldInclusion(); err != nil {
-		return err
+		errs.Add(err)
 	}
 	if srd.tag != TagSecondaryRemittanceDocument {
-		return fieldError("tag", ErrValidTagForType, srd.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, srd.tag))
 	}
 	if err := srd.isDocumentTypeCode(srd.DocumentTypeCode); err != nil {
-		return fieldError("DocumentTypeCode", err, srd.DocumentTypeCode)
+		errs.Add(fieldError("DocumentTypeCode", err, srd.DocumentTypeCode))
 	}
 	if err := srd.isAlphanumeric(srd.ProprietaryDocumentTypeCode); err != nil {
-		return fieldError("ProprietaryDocumentTypeCode", err, srd.ProprietaryDocumentTypeCode)
+		errs.Add(fieldError("ProprietaryDocumentTypeCode", err, srd.ProprietaryDocumentTypeCode))
 	}
 	if err := srd.isAlphanumeric(srd.DocumentIdentificationNumber); err != nil {
-		return fieldError("DocumentIdentificationNumber", err, srd.DocumentIdentificationNumber)
+		errs.Add(fieldError("DocumentIdentificationNumber", err, srd.DocumentIdentificationNumber))
 	}
 	if err := srd.isAlphanumeric(srd.Issuer); err != nil {
-		return fieldError("Issuer", err, srd.Issuer)
+		errs.Add(fieldError("Issuer", err, srd.Issuer))
 	}
-	return nil
+	return srd.collected(errs)
 }
 
 // fieldInclusion validate mandatory fields. If fields are
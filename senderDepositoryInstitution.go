@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // SenderDepositoryInstitution {3100}
@@ -102,19 +104,20 @@ func (sdi *SenderDepositoryInstitution) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on SenderDepositoryInstitution and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (sdi *SenderDepositoryInstitution) Validate() error {
+	var errs base.ErrorList
 	if err := sdi.fieldInclusion(); err != nil {
-		return err
+		errs.Add(err)
 	}
 	if sdi.tag != TagSenderDepositoryInstitution {
-		return fieldError("tag", ErrValidTagForType, sdi.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, sdi.tag))
 	}
-	if err := sdi.isNumeric(sdi.SenderABANumber); err != nil {
-		return fieldError("SenderABANumber", err, sdi.SenderABANumber)
+	if err := sdi.isRoutingNumber(sdi.SenderABANumber); err != nil {
+		errs.Add(fieldError("SenderABANumber", err, sdi.SenderABANumber))
 	}
 	if err := sdi.isAlphanumeric(sdi.SenderShortName); err != nil {
-		return fieldError("SenderShortName", err, sdi.SenderShortName)
+		errs.Add(fieldError("SenderShortName", err, sdi.SenderShortName))
 	}
-	return nil
+	return sdi.collected(errs)
 }
 
 // fieldInclusion validate mandatory fields. If fields are
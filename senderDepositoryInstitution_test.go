@@ -92,28 +92,28 @@ func TestSenderDepositoryInstitutionTagError(t *testing.T) {
 // TestStringSenderDepositoryInstitutionVariableLength parses using variable length
 func TestStringSenderDepositoryInstitutionVariableLength(t *testing.T) {
 	var line = "{3100}1        A*"
-	r := NewReader(strings.NewReader(line))
+	r := NewReader(strings.NewReader(line), skipRoutingNumberCheck)
 	r.line = line
 
 	err := r.parseSenderDepositoryInstitution()
 	require.NoError(t, err)
 
 	line = "{3100}1        A                 NNN"
-	r = NewReader(strings.NewReader(line))
+	r = NewReader(strings.NewReader(line), skipRoutingNumberCheck)
 	r.line = line
 
 	err = r.parseSenderDepositoryInstitution()
 	require.ErrorContains(t, err, ErrRequireDelimiter.Error())
 
 	line = "{3100}1*A***"
-	r = NewReader(strings.NewReader(line))
+	r = NewReader(strings.NewReader(line), skipRoutingNumberCheck)
 	r.line = line
 
 	err = r.parseSenderDepositoryInstitution()
 	require.ErrorContains(t, err, ErrValidLength.Error())
 
 	line = "{3100}1        A*"
-	r = NewReader(strings.NewReader(line))
+	r = NewReader(strings.NewReader(line), skipRoutingNumberCheck)
 	r.line = line
 
 	err = r.parseSenderDepositoryInstitution()
@@ -123,7 +123,7 @@ func TestStringSenderDepositoryInstitutionVariableLength(t *testing.T) {
 // TestStringSenderDepositoryInstitutionOptions validates Format() formatted according to the FormatOptions
 func TestStringSenderDepositoryInstitutionOptions(t *testing.T) {
 	var line = "{3100}1        A*"
-	r := NewReader(strings.NewReader(line))
+	r := NewReader(strings.NewReader(line), skipRoutingNumberCheck)
 	r.line = line
 
 	err := r.parseSenderDepositoryInstitution()
@@ -135,7 +135,7 @@ func TestStringSenderDepositoryInstitutionOptions(t *testing.T) {
 	require.Equal(t, record.String(), record.Format(FormatOptions{VariableLengthFields: false}))
 
 	line = "{3100}1        *"
-	r = NewReader(strings.NewReader(line))
+	r = NewReader(strings.NewReader(line), skipRoutingNumberCheck)
 	r.line = line
 
 	err = r.parseSenderDepositoryInstitution()
@@ -147,7 +147,7 @@ func TestStringSenderDepositoryInstitutionOptions(t *testing.T) {
 	require.Equal(t, record.String(), record.Format(FormatOptions{VariableLengthFields: false}))
 
 	line = "{3100}111111111*"
-	r = NewReader(strings.NewReader(line))
+	r = NewReader(strings.NewReader(line), skipRoutingNumberCheck)
 	r.line = line
 
 	err = r.parseSenderDepositoryInstitution()
@@ -158,3 +158,17 @@ func TestStringSenderDepositoryInstitutionOptions(t *testing.T) {
 	require.Equal(t, "{3100}111111111*", record.Format(FormatOptions{VariableLengthFields: true}))
 	require.Equal(t, record.String(), record.Format(FormatOptions{VariableLengthFields: false}))
 }
+
+func TestSenderABANumberCheckDigit(t *testing.T) {
+	sdi := mockSenderDepositoryInstitution()
+	sdi.SenderABANumber = "121042883"
+	require.ErrorIs(t, sdi.Validate(), ErrRoutingNumberCheckDigit)
+
+	sdi.SenderABANumber = "12104288"
+	require.ErrorIs(t, sdi.Validate(), ErrRoutingNumberLength)
+}
+
+// skipRoutingNumberCheck lets tests of a tag's layout read short, made up routing numbers
+func skipRoutingNumberCheck(f *File) {
+	f.SetValidation(&ValidateOpts{SkipRoutingNumberCheck: true})
+}
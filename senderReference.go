@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // SenderReference is the SenderReference of the wire
@@ -92,13 +94,14 @@ func (sr *SenderReference) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on SenderReference and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (sr *SenderReference) Validate() error {
+	var errs base.ErrorList
 	if sr.tag != TagSenderReference {
-		return fieldError("tag", ErrValidTagForType, sr.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, sr.tag))
 	}
 	if err := sr.isAlphanumeric(sr.SenderReference); err != nil {
-		return fieldError("SenderReference", err, sr.SenderReference)
+		errs.Add(fieldError("SenderReference", err, sr.SenderReference))
 	}
-	return nil
+	return sr.collected(errs)
 }
 
 // FormatSenderReference returns SenderReference formatted according to the FormatOptions
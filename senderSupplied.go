@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // SenderSupplied {1500}
@@ -115,25 +117,26 @@ func (ss *SenderSupplied) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on SenderSupplied and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (ss *SenderSupplied) Validate() error {
+	var errs base.ErrorList
 	if err := ss.fieldInclusion(); err != nil {
-		return err
+		errs.Add(err)
 	}
 	if ss.tag != TagSenderSupplied {
-		return fieldError("tag", ErrValidTagForType, ss.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, ss.tag))
 	}
 	if ss.FormatVersion != FormatVersion {
-		return fieldError("FormatVersion", ErrFormatVersion, ss.FormatVersion)
+		errs.Add(fieldError("FormatVersion", ErrFormatVersion, ss.FormatVersion))
 	}
 	if err := ss.isAlphanumeric(ss.UserRequestCorrelation); err != nil {
-		return fieldError("UserRequestCorrelation", err, ss.UserRequestCorrelation)
+		errs.Add(fieldError("UserRequestCorrelation", err, ss.UserRequestCorrelation))
 	}
 	if err := ss.isTestProductionCode(ss.TestProductionCode); err != nil {
-		return fieldError("TestProductionCode", err, ss.TestProductionCode)
+		errs.Add(fieldError("TestProductionCode", err, ss.TestProductionCode))
 	}
 	if err := ss.isMessageDuplicationCode(ss.MessageDuplicationCode); err != nil {
-		return fieldError("MessageDuplicationCode", err, ss.MessageDuplicationCode)
+		errs.Add(fieldError("MessageDuplicationCode", err, ss.MessageDuplicationCode))
 	}
-	return nil
+	return ss.collected(errs)
 }
 
 // fieldInclusion validate mandatory fields. If fields are
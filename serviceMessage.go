@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // ServiceMessage is the ServiceMessage of the wire
@@ -206,49 +208,50 @@ func (sm *ServiceMessage) Format(options FormatOptions) string {
 // Validate performs WIRE format rule checks on ServiceMessage and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (sm *ServiceMessage) Validate() error {
+	var errs base.ErrorList
 	if err := sm.fieldInclusion(); err != nil {
-		return err
+		errs.Add(err)
 	}
 	if sm.tag != TagServiceMessage {
-		return fieldError("tag", ErrValidTagForType, sm.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, sm.tag))
 	}
 	if err := sm.isAlphanumeric(sm.LineOne); err != nil {
-		return fieldError("LineOne", err, sm.LineOne)
+		errs.Add(fieldError("LineOne", err, sm.LineOne))
 	}
 	if err := sm.isAlphanumeric(sm.LineTwo); err != nil {
-		return fieldError("LineTwo", err, sm.LineTwo)
+		errs.Add(fieldError("LineTwo", err, sm.LineTwo))
 	}
 	if err := sm.isAlphanumeric(sm.LineThree); err != nil {
-		return fieldError("LineThree", err, sm.LineThree)
+		errs.Add(fieldError("LineThree", err, sm.LineThree))
 	}
 	if err := sm.isAlphanumeric(sm.LineFour); err != nil {
-		return fieldError("LineFour", err, sm.LineFour)
+		errs.Add(fieldError("LineFour", err, sm.LineFour))
 	}
 	if err := sm.isAlphanumeric(sm.LineFive); err != nil {
-		return fieldError("LineFive", err, sm.LineFive)
+		errs.Add(fieldError("LineFive", err, sm.LineFive))
 	}
 	if err := sm.isAlphanumeric(sm.LineSix); err != nil {
-		return fieldError("LineSix", err, sm.LineSix)
+		errs.Add(fieldError("LineSix", err, sm.LineSix))
 	}
 	if err := sm.isAlphanumeric(sm.LineSeven); err != nil {
-		return fieldError("LineSeven", err, sm.LineSeven)
+		errs.Add(fieldError("LineSeven", err, sm.LineSeven))
 	}
 	if err := sm.isAlphanumeric(sm.LineEight); err != nil {
-		return fieldError("LineEight", err, sm.LineEight)
+		errs.Add(fieldError("LineEight", err, sm.LineEight))
 	}
 	if err := sm.isAlphanumeric(sm.LineNine); err != nil {
-		return fieldError("LineNine", err, sm.LineNine)
+		errs.Add(fieldError("LineNine", err, sm.LineNine))
 	}
 	if err := sm.isAlphanumeric(sm.LineTen); err != nil {
-		return fieldError("LineTen", err, sm.LineTen)
+		errs.Add(fieldError("LineTen", err, sm.LineTen))
 	}
 	if err := sm.isAlphanumeric(sm.LineEleven); err != nil {
-		return fieldError("LineEleven", err, sm.LineEleven)
+		errs.Add(fieldError("LineEleven", err, sm.LineEleven))
 	}
 	if err := sm.isAlphanumeric(sm.LineTwelve); err != nil {
-		return fieldError("LineTwelve", err, sm.LineTwelve)
+		errs.Add(fieldError("LineTwelve", err, sm.LineTwelve))
 	}
-	return nil
+	return sm.collected(errs)
 }
 
 // fieldInclusion validate mandatory fields. If fields are
@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // TypeSubType {1510}
@@ -74,19 +76,20 @@ func (tst *TypeSubType) String() string {
 // Validate performs WIRE format rule checks on TypeSubType and returns an error if not Validated
 // The first error encountered is returned and stops that parsing.
 func (tst *TypeSubType) Validate() error {
+	var errs base.ErrorList
 	if err := tst.fieldInclusion(); err != nil {
-		return err
+		errs.Add(err)
 	}
 	if tst.tag != TagTypeSubType {
-		return fieldError("tag", ErrValidTagForType, tst.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, tst.tag))
 	}
 	if err := tst.isTypeCode(tst.TypeCode); err != nil {
-		return fieldError("TypeCode", err, tst.TypeCode)
+		errs.Add(fieldError("TypeCode", err, tst.TypeCode))
 	}
 	if err := tst.isSubTypeCode(tst.SubTypeCode); err != nil {
-		return fieldError("SubTypeCode", err, tst.SubTypeCode)
+		errs.Add(fieldError("SubTypeCode", err, tst.SubTypeCode))
 	}
-	return nil
+	return tst.collected(errs)
 }
 
 // fieldInclusion validate mandatory fields. If fields are
@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/moov-io/base"
 )
 
 // UnstructuredAddenda is the unstructured addenda information
@@ -89,20 +91,21 @@ func (ua *UnstructuredAddenda) String() string {
 //	length of content in Addenda Information (e.g., if content of Addenda Information is 987 characters,
 //	Addenda Length must be 0987).
 func (ua *UnstructuredAddenda) Validate() error {
+	var errs base.ErrorList
 	if err := ua.fieldInclusion(); err != nil {
-		return err
+		errs.Add(err)
 	}
 	if ua.tag != TagUnstructuredAddenda {
-		return fieldError("tag", ErrValidTagForType, ua.tag)
+		errs.Add(fieldError("tag", ErrValidTagForType, ua.tag))
 	}
 	if err := ua.isNumeric(ua.AddendaLength); err != nil {
-		return fieldError("AddendaLength", err, ua.AddendaLength)
+		errs.Add(fieldError("AddendaLength", err, ua.AddendaLength))
 	}
 	if err := ua.isAlphanumeric(ua.Addenda); err != nil {
-		return fieldError("Addenda", err, ua.Addenda)
+		errs.Add(fieldError("Addenda", err, ua.Addenda))
 	}
 
-	return nil
+	return ua.collected(errs)
 }
 
 // fieldInclusion validate mandatory fields. If fields are
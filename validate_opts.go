@@ -1,10 +1,78 @@
 package wire
 
+import "reflect"
+
 // ValidateOpts contains specific overrides from the default set of validations
 type ValidateOpts struct {
+	// SkipAll skips all validation, of each tag as it's read as well as of the FEDWireMessage.
+	SkipAll bool `json:"skipAll"`
+
 	// SkipMandatoryIMAD skips checking that InputMessageAccountabilityData is mandatory tag.
 	SkipMandatoryIMAD bool `json:"skipMandatoryIMAD"`
 
 	// AllowMissingSenderSupplied allows the senderSupplied field to be omitted.
 	AllowMissingSenderSupplied bool `json:"allowMissingSenderSupplied"`
+
+	// SkipProhibitedTags skips checking for the tags a business function code prohibits, such as
+	// ServiceMessage in a BankTransfer.
+	SkipProhibitedTags bool `json:"skipProhibitedTags"`
+
+	// SkipCharacterSet skips checking that text fields only contain the characters Fedwire permits.
+	SkipCharacterSet bool `json:"skipCharacterSet"`
+
+	// SkipRoutingNumberCheck skips checking that routing numbers are 9 digits with a valid check digit. They're
+	// still checked to be numeric.
+	SkipRoutingNumberCheck bool `json:"skipRoutingNumberCheck"`
+
+	// AllowUnknownTags allows tags the Reader doesn't recognize, which are skipped rather than returned as
+	// an ErrInvalidTag.
+	AllowUnknownTags bool `json:"allowUnknownTags"`
+
+	// SkipRemittanceRules skips the rules between the LocalInstrument of a CustomerTransferPlus and the
+	// UnstructuredAddenda and {8250}-{8750} remittance tags it requires or doesn't permit. The fields of the
+	// remittance tags present are still validated.
+	SkipRemittanceRules bool `json:"skipRemittanceRules"`
+
+	// Rules are custom rules run along with those registered with RegisterRule. A Rule here replaces a
+	// registered Rule of the same ID.
+	Rules []Rule `json:"-"`
+
+	// EnableRules are the IDs of Disabled rules to run
+	EnableRules []string `json:"enableRules,omitempty"`
+
+	// DisableRules are the IDs of rules not to run, even if given in EnableRules
+	DisableRules []string `json:"disableRules,omitempty"`
+
+	// allErrors makes the Validate of a tag return a base.ErrorList of every invalid field rather than the
+	// first one, as FEDWireMessage.ValidateAll does
+	allErrors bool
+}
+
+// optsTag is a tag whose checks follow the ValidateOpts set on it
+type optsTag interface {
+	tagValidator
+	setValidateOpts(opts *ValidateOpts)
+}
+
+// validateTag returns tag's Validate error, skipping the checks opts skip. opts may be nil.
+func (opts *ValidateOpts) validateTag(tag tagValidator) error {
+	if opts == nil {
+		return tag.Validate()
+	}
+	if opts.SkipAll {
+		return nil
+	}
+	// opts are set on a copy of the tag, so the tag itself is left as it is
+	v := reflect.ValueOf(tag)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return tag.Validate()
+	}
+	c := reflect.New(v.Type().Elem())
+	c.Elem().Set(v.Elem())
+	t, ok := c.Interface().(optsTag)
+	if !ok {
+		return tag.Validate()
+	}
+	t.setValidateOpts(opts)
+	return t.Validate()
 }
@@ -0,0 +1,297 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Severity is how serious a ValidationError is
+type Severity string
+
+const (
+	// SeverityError marks a ValidationError the Fedwire Funds Service would reject the message for
+	SeverityError Severity = "error"
+	// SeverityWarning marks a ValidationError which doesn't stop the message from being sent
+	SeverityWarning Severity = "warning"
+)
+
+// ValidationError is a validation error located within a FEDWireMessage, so that applications can point
+// to the offending field rather than parse the error's text. It wraps the original error, so errors.Is
+// still matches sentinel errors such as ErrNonAlphanumeric.
+type ValidationError struct {
+	// Tag is the tag the error was found in, e.g. {4200}. It's empty for errors about the message as a whole.
+	Tag string `json:"tag,omitempty"`
+	// Field is the path of the Go field within the FEDWireMessage, e.g. Beneficiary.Personal.Identifier
+	Field string `json:"field,omitempty"`
+	// Pointer is the JSON pointer of the field, e.g. /fedWireMessage/beneficiary/personal/identifier, or
+	// /fedWireMessages/0/beneficiary/personal/identifier once the error is placed within a File by a MessageError.
+	// It's empty for errors of the File itself.
+	Pointer string `json:"pointer"`
+	// Code identifies the kind of error, e.g. non_alphanumeric. Codes don't change between releases.
+	Code string `json:"code"`
+	// Value is the offending value, if any
+	Value interface{} `json:"value,omitempty"`
+	// Severity is how serious the error is
+	Severity Severity `json:"severity"`
+	// Rule is the ID of the custom Rule the message broke, if any
+	Rule string `json:"rule,omitempty"`
+	// Err is the original error
+	Err error `json:"-"`
+}
+
+// fedWireMessagePointer returns the JSON pointer of the FEDWireMessage at index within a File, or of a lone
+// FEDWireMessage when index is negative
+func fedWireMessagePointer(index int) string {
+	if index < 0 {
+		return "/fedWireMessage"
+	}
+	return fmt.Sprintf("/fedWireMessages/%d", index)
+}
+
+// newValidationError returns err, found in the FEDWireMessage field named field, as a ValidationError. field
+// is empty for errors of the rules between tags, their location is then taken from err itself.
+func newValidationError(field string, err error) *ValidationError {
+	if err == nil {
+		return nil
+	}
+	var ve *ValidationError
+	if errors.As(err, &ve) {
+		return ve
+	}
+	ve = &ValidationError{
+		Code:     errorCode(err),
+		Severity: SeverityError,
+		Err:      err,
+	}
+
+	var ruleErr *RuleError
+	if errors.As(err, &ruleErr) {
+		ve.Rule = ruleErr.RuleID
+		if ve.Code == "invalid" {
+			ve.Code = "rule"
+		}
+	}
+
+	var names []string
+	if field != "" {
+		names = append(names, field)
+	}
+	var fe *FieldError
+	var bfcErr ErrBusinessFunctionCodeProperty
+	var propErr ErrInvalidPropertyForProperty
+	switch {
+	case errors.As(err, &fe):
+		ve.Value = fe.Value
+		names = append(names, strings.Split(fe.FieldName, ".")...)
+	case errors.As(err, &bfcErr):
+		ve.Value = bfcErr.PropertyValue
+		names = append(names, bfcErr.Property)
+	case errors.As(err, &propErr):
+		ve.Value = propErr.PropertyValue
+		names = append(names, propErr.Property)
+	}
+
+	// the first name must be a field of the message itself, a bare field name of some tag can't be placed
+	fwm := reflect.TypeOf(FEDWireMessage{})
+	var goPath, jsonPath []string
+	if len(names) > 0 {
+		if _, ok := fwm.FieldByName(names[0]); ok {
+			goPath, jsonPath = resolveField(fwm, names)
+		}
+	}
+	if len(goPath) > 0 {
+		ve.Field = strings.Join(goPath, ".")
+		ve.Tag = messageTags[goPath[0]]
+	}
+	ve.Pointer = fedWireMessagePointer(-1)
+	if len(jsonPath) > 0 {
+		ve.Pointer += "/" + strings.Join(jsonPath, "/")
+	}
+	return ve
+}
+
+// newFileError returns err, found in a File rather than one of its FEDWireMessages, as a ValidationError
+// pointing to the whole File
+func newFileError(err error) *ValidationError {
+	return &ValidationError{
+		Code:     errorCode(err),
+		Severity: SeverityError,
+		Err:      err,
+	}
+}
+
+// inMessage returns a copy of the ValidationError of a lone FEDWireMessage pointing into the FEDWireMessage
+// at index within a File
+func (e *ValidationError) inMessage(index int) *ValidationError {
+	lone := fedWireMessagePointer(-1)
+	if e.Pointer != lone && !strings.HasPrefix(e.Pointer, lone+"/") {
+		return e
+	}
+	c := *e
+	c.Pointer = fedWireMessagePointer(index) + strings.TrimPrefix(e.Pointer, lone)
+	return &c
+}
+
+// Error returns the message of the original error, so that the errors of Validate and the Reader read as
+// they did before being located. The tag is given by Tag.
+func (e *ValidationError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap implements the base.UnwrappableError interface for ValidationError
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// MarshalJSON writes the ValidationError along with the message of the original error
+func (e *ValidationError) MarshalJSON() ([]byte, error) {
+	type validationError ValidationError
+	return json.Marshal(struct {
+		*validationError
+		Message string `json:"message"`
+	}{
+		validationError: (*validationError)(e),
+		Message:         e.Err.Error(),
+	})
+}
+
+// resolveField follows names, each the name of a field or of a field nested in it, from the struct t. It
+// returns the Go and JSON names of the fields along the way up to the first name which can't be found.
+func resolveField(t reflect.Type, names []string) (goPath, jsonPath []string) {
+	for _, name := range names {
+		gp, jp, ft := findField(t, name)
+		if ft == nil {
+			break
+		}
+		goPath, jsonPath, t = append(goPath, gp...), append(jsonPath, jp...), ft
+	}
+	return goPath, jsonPath
+}
+
+// findField searches the struct t, and the structs nested within it, breadth first for the exported field
+// name. It returns the Go and JSON names of the path to the field and the field's type.
+func findField(t reflect.Type, name string) (goPath, jsonPath []string, ft reflect.Type) {
+	type node struct {
+		t        reflect.Type
+		goPath   []string
+		jsonPath []string
+	}
+	queue := []node{{t: t}}
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+		for n.t.Kind() == reflect.Ptr {
+			n.t = n.t.Elem()
+		}
+		if n.t.Kind() != reflect.Struct {
+			continue
+		}
+		for i := 0; i < n.t.NumField(); i++ {
+			f := n.t.Field(i)
+			jsonName, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+			if !f.IsExported() || jsonName == "-" {
+				continue
+			}
+			if jsonName == "" {
+				jsonName = f.Name
+			}
+			gp := append(append([]string{}, n.goPath...), f.Name)
+			jp := append(append([]string{}, n.jsonPath...), jsonName)
+			if f.Name == name {
+				return gp, jp, f.Type
+			}
+			queue = append(queue, node{t: f.Type, goPath: gp, jsonPath: jp})
+		}
+	}
+	return nil, nil, nil
+}
+
+// errorCode returns the stable code of err, the kind of error it is or wraps
+func errorCode(err error) string {
+	for e := err; e != nil; e = errors.Unwrap(e) {
+		// errors such as base.ErrorList can't be map keys
+		if reflect.TypeOf(e).Comparable() {
+			if code, ok := errorCodes[e]; ok {
+				return code
+			}
+		}
+		switch e.(type) {
+		case TagWrongLengthErr:
+			return "tag_length"
+		case FieldWrongLengthErr:
+			return "field_length"
+		case ErrBusinessFunctionCodeProperty:
+			return "business_function_code_property"
+		case ErrInvalidPropertyForProperty:
+			return "invalid_property_for_property"
+		case ErrInvalidTag:
+			return "invalid_tag"
+		}
+	}
+	return "invalid"
+}
+
+// errorCodes are the stable codes of the sentinel errors. A code must never change once released, add a new
+// one instead.
+var errorCodes = map[error]string{
+	ErrValidTagForType:                "invalid_tag_for_type",
+	ErrNonNumeric:                     "non_numeric",
+	ErrNonAlphanumeric:                "non_alphanumeric",
+	ErrNonAmount:                      "non_amount",
+	ErrNonCurrencyCode:                "non_currency_code",
+	ErrUpperAlpha:                     "upper_alpha",
+	ErrRoutingNumberLength:            "routing_number_length",
+	ErrRoutingNumberCheckDigit:        "routing_number_check_digit",
+	ErrBIC:                            "invalid_bic",
+	ErrIBAN:                           "invalid_iban",
+	ErrFieldInclusion:                 "field_inclusion",
+	ErrConstructor:                    "constructor",
+	ErrFieldRequired:                  "field_required",
+	ErrNotPermitted:                   "not_permitted",
+	ErrValidMonth:                     "invalid_month",
+	ErrValidDay:                       "invalid_day",
+	ErrValidYear:                      "invalid_year",
+	ErrValidCentury:                   "invalid_century",
+	ErrValidDate:                      "invalid_date",
+	ErrCycleDateNotBusinessDay:        "cycle_date_not_business_day",
+	ErrCycleDateFuture:                "cycle_date_future",
+	ErrInvalidProperty:                "invalid_property",
+	ErrErrorCategory:                  "error_category",
+	ErrFormatVersion:                  "format_version",
+	ErrTestProductionCode:             "test_production_code",
+	ErrMessageDuplicationCode:         "message_duplication_code",
+	ErrTypeCode:                       "type_code",
+	ErrSubTypeCode:                    "sub_type_code",
+	ErrBusinessFunctionCode:           "business_function_code",
+	ErrTransactionTypeCode:            "transaction_type_code",
+	ErrLocalInstrumentNotPermitted:    "local_instrument_not_permitted",
+	ErrLocalInstrumentCode:            "local_instrument_code",
+	ErrPaymentNotificationIndicator:   "payment_notification_indicator",
+	ErrChargeDetails:                  "charge_details",
+	ErrIdentificationCode:             "identification_code",
+	ErrAdviceCode:                     "advice_code",
+	ErrRemittanceLocationMethod:       "remittance_location_method",
+	ErrAddressType:                    "address_type",
+	ErrIdentificationType:             "identification_type",
+	ErrOrganizationIdentificationCode: "organization_identification_code",
+	ErrPrivateIdentificationCode:      "private_identification_code",
+	ErrDocumentTypeCode:               "document_type_code",
+	ErrCreditDebitIndicator:           "credit_debit_indicator",
+	ErrAdjustmentReasonCode:           "adjustment_reason_code",
+	ErrPartyIdentifier:                "party_identifier",
+	ErrOptionFLine:                    "option_f_line",
+	ErrOptionFName:                    "option_f_name",
+	ErrNotReversible:                  "not_reversible",
+	ErrNotDrawdownRequest:             "not_drawdown_request",
+	ErrValidLength:                    "invalid_length",
+	ErrRequireDelimiter:               "require_delimiter",
+	ErrFileNoMessages:                 "file_no_messages",
+	ErrFileTooLong:                    "file_too_long",
+}
@@ -0,0 +1,127 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/moov-io/base"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidationError(t *testing.T) {
+	fwm := mockCustomerTransferData()
+	fwm.Beneficiary = mockBeneficiary()
+	fwm.Beneficiary.Personal.Identifier = "®"
+	fwm.Originator = mockOriginator()
+	fwm.Originator.Personal.Address.AddressLineTwo = "®"
+	fwm.Amount.Amount = "000000000000"
+
+	var errs base.ErrorList
+	require.ErrorAs(t, fwm.ValidateAll(), &errs)
+	require.Len(t, errs, 3)
+
+	var ve *ValidationError
+	require.ErrorAs(t, errs[0], &ve)
+	require.ErrorIs(t, ve, ErrNonAlphanumeric)
+	require.Equal(t, TagBeneficiary, ve.Tag)
+	require.Equal(t, "Beneficiary.Personal.Identifier", ve.Field)
+	require.Equal(t, "/fedWireMessage/beneficiary/personal/identifier", ve.Pointer)
+	require.Equal(t, "non_alphanumeric", ve.Code)
+	require.Equal(t, "®", ve.Value)
+	require.Equal(t, SeverityError, ve.Severity)
+
+	require.ErrorAs(t, errs[1], &ve)
+	require.Equal(t, "Originator.Personal.Address.AddressLineTwo", ve.Field)
+	require.Equal(t, "/fedWireMessage/originator/personal/address/addressLineTwo", ve.Pointer)
+
+	require.ErrorAs(t, errs[2], &ve)
+	require.Equal(t, TagAmount, ve.Tag)
+	require.Equal(t, "/fedWireMessage/amount", ve.Pointer)
+	require.Equal(t, "invalid_property_for_property", ve.Code)
+
+	bs, err := json.Marshal(errs[0])
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"tag": "{4200}",
+		"field": "Beneficiary.Personal.Identifier",
+		"pointer": "/fedWireMessage/beneficiary/personal/identifier",
+		"code": "non_alphanumeric",
+		"value": "®",
+		"severity": "error",
+		"message": "Identifier ® has non alphanumeric characters"
+	}`, string(bs))
+}
+
+func TestValidationError__rules(t *testing.T) {
+	ve := newValidationError("", fieldError("BusinessFunctionCode.TransactionTypeCode", ErrTransactionTypeCode, "COV"))
+	require.Equal(t, TagBusinessFunctionCode, ve.Tag)
+	require.Equal(t, "/fedWireMessage/businessFunctionCode/transactionTypeCode", ve.Pointer)
+	require.Equal(t, "transaction_type_code", ve.Code)
+
+	// a field of some tag can't be placed without the tag
+	ve = newValidationError("", fieldError("Identifier", ErrNonAlphanumeric))
+	require.Empty(t, ve.Tag)
+	require.Equal(t, "/fedWireMessage", ve.Pointer)
+
+	ve = newValidationError("", errors.New("unknown"))
+	require.Equal(t, "invalid", ve.Code)
+	require.Equal(t, "unknown", ve.Error())
+
+	require.Same(t, ve, newValidationError("Amount", ve))
+	require.Nil(t, newValidationError("Amount", nil))
+}
+
+func TestValidationError__validate(t *testing.T) {
+	valid := mockCustomerTransferData()
+	valid.Beneficiary = mockBeneficiary()
+	valid.Originator = mockOriginator()
+	fwm := valid
+	fwm.Amount = nil
+
+	// verify, and so Validate, locates its first error too
+	var ve *ValidationError
+	require.ErrorAs(t, fwm.verify(), &ve)
+	require.Equal(t, TagAmount, ve.Tag)
+	require.Equal(t, "/fedWireMessage/amount", ve.Pointer)
+
+	// within a file the error points to its message
+	file := NewFile()
+	file.AddFEDWireMessage(valid)
+	file.AddFEDWireMessage(fwm)
+	var errs base.ErrorList
+	require.ErrorAs(t, file.Validate(), &errs)
+	require.Len(t, errs, 1)
+	var msgErr *MessageError
+	require.ErrorAs(t, errs[0], &msgErr)
+	require.Equal(t, 1, msgErr.Index)
+	require.ErrorAs(t, errs[0], &ve)
+	require.Equal(t, "/fedWireMessages/1/amount", ve.Pointer)
+	require.EqualError(t, errs[0], "FEDWireMessage[1]: Amount is a required field")
+
+	// errors of the file itself point to the whole file
+	require.ErrorAs(t, NewFile().Validate(), &ve)
+	require.ErrorIs(t, ve, ErrFileNoMessages)
+	require.Equal(t, "file_no_messages", ve.Code)
+	require.Empty(t, ve.Pointer)
+}
+
+func TestValidationError__reader(t *testing.T) {
+	line := "{1500}30User ReqT " + "\n{1510}1000\n{1520}20190410Source08000001\n{2000}00000000001Z\n"
+	_, err := NewReader(strings.NewReader(line)).Read()
+
+	var errs base.ErrorList
+	require.ErrorAs(t, err, &errs)
+	var pe *base.ParseError
+	require.ErrorAs(t, errs[0], &pe)
+	var ve *ValidationError
+	require.ErrorAs(t, errs[0], &ve)
+	require.ErrorIs(t, ve, ErrNonAmount)
+	require.Equal(t, TagAmount, ve.Tag)
+	require.Equal(t, "/fedWireMessage/amount/amount", ve.Pointer)
+}
@@ -7,9 +7,12 @@ package wire
 import (
 	"regexp"
 	"strings"
+	"time"
 	"unicode/utf8"
 
+	"github.com/moov-io/base"
 	"golang.org/x/text/currency"
+	"golang.org/x/text/language"
 )
 
 var (
@@ -22,6 +25,11 @@ var (
 
 	numericRegex = regexp.MustCompile(`[^0-9]`)
 	amountRegex  = regexp.MustCompile("[^0-9,.]")
+
+	// bicRegex is the structure of an ISO 9362 BIC: party prefix, country code, party suffix and optional branch
+	bicRegex = regexp.MustCompile(`^[A-Z0-9]{4}[A-Z]{2}[A-Z0-9]{2}([A-Z0-9]{3})?$`)
+	// ibanRegex is the structure of an ISO 13616 IBAN: country code, check digits and up to 30 characters of BBAN
+	ibanRegex = regexp.MustCompile(`^[A-Z]{2}[0-9]{2}[A-Z0-9]{11,30}$`)
 )
 
 const (
@@ -40,10 +48,43 @@ func validSizeUint(n uint) bool {
 }
 
 // validator is common validation and formatting of golang types to WIRE type strings
-type validator struct{}
+type validator struct {
+	// opts are the ValidateOpts of the checks which can be skipped. They're set by ValidateOpts.validateTag
+	// on a copy of the tag being validated, and nil otherwise.
+	opts *ValidateOpts
+}
+
+// setValidateOpts sets the ValidateOpts the checks follow
+func (v *validator) setValidateOpts(opts *ValidateOpts) {
+	v.opts = opts
+}
+
+// collected returns the errors a tag's Validate found: nil if there are none, every one of them as a
+// base.ErrorList when validating with ValidateOpts.allErrors, or else the first one
+func (v *validator) collected(errs base.ErrorList) error {
+	if errs.Empty() {
+		return nil
+	}
+	if v.opts == nil || !v.opts.allErrors {
+		return errs.Err()
+	}
+	// the errors of the FinancialInstitution of a tag are a list of their own
+	var out base.ErrorList
+	for _, err := range errs {
+		if el, ok := err.(base.ErrorList); ok {
+			out = append(out, el...)
+		} else {
+			out.Add(err)
+		}
+	}
+	return out
+}
 
 // isAlphanumeric checks if a string only contains ASCII alphanumeric characters
 func (v *validator) isAlphanumeric(s string) error {
+	if v.opts != nil && v.opts.SkipCharacterSet {
+		return nil
+	}
 	if alphanumericRegex.MatchString(s) {
 		return ErrNonAlphanumeric
 	}
@@ -61,6 +102,29 @@ func (v *validator) isNumeric(s string) error {
 
 // ToDo: Amount Decimal and AmountComma (only 1 per each) ?
 
+// isRoutingNumber checks if a string is a 9 digit ABA routing number whose last digit is the 3-7-1 check
+// digit of the first eight. Only the digits are checked when the ValidateOpts skip the routing number check.
+func (v *validator) isRoutingNumber(s string) error {
+	if err := v.isNumeric(s); err != nil {
+		return err
+	}
+	if v.opts != nil && v.opts.SkipRoutingNumberCheck {
+		return nil
+	}
+	if len(s) != 9 {
+		return ErrRoutingNumberLength
+	}
+	weights := [...]int{3, 7, 1, 3, 7, 1, 3, 7, 1}
+	sum := 0
+	for i := range weights {
+		sum += int(s[i]-'0') * weights[i]
+	}
+	if sum%10 != 0 {
+		return ErrRoutingNumberCheckDigit
+	}
+	return nil
+}
+
 // isAmount checks if a string only contains one comma and ASCII numeric (0-9) characters
 func (v *validator) isAmount(s string) error {
 	str := strings.Trim(s, ",")
@@ -152,6 +216,20 @@ func (v *validator) isMessageDuplicationCode(code string) error {
 	return ErrMessageDuplicationCode
 }
 
+func (v *validator) isErrorCategory(code string) error {
+	switch code {
+	case
+		ErrorCategoryData,
+		ErrorCategoryInsufficientBalance,
+		ErrorCategoryAccountability,
+		ErrorCategoryInProcess,
+		ErrorCategoryCutoffHour,
+		ErrorCategoryDuplicateIMAD:
+		return nil
+	}
+	return ErrErrorCategory
+}
+
 func (v *validator) isBusinessFunctionCode(code string) error {
 	switch code {
 	case
@@ -349,6 +427,83 @@ func (v *validator) isAdjustmentReasonCode(code string) error {
 	return ErrAdjustmentReasonCode
 }
 
+// isSWIFTIdentifier checks the identifier of a SWIFTBankIdentifierCode (B) is a BIC and the identifier of a
+// SWIFTBICORBEIANDAccountNumber (T) a BIC followed by an account number, which is checked when it's an IBAN.
+// Identifiers of other codes aren't checked.
+func (v *validator) isSWIFTIdentifier(code, identifier string) error {
+	switch code {
+	case SWIFTBankIdentifierCode:
+		return v.isBIC(identifier)
+	case SWIFTBICORBEIANDAccountNumber:
+		bic, account := splitBICAccount(identifier)
+		if err := v.isBIC(bic); err != nil {
+			return err
+		}
+		if looksLikeIBAN(account) {
+			return v.isIBAN(account)
+		}
+	}
+	return nil
+}
+
+// isBIC checks if a string is an 8 or 11 character ISO 9362 BIC of an ISO 3166 country
+func (v *validator) isBIC(s string) error {
+	if !bicRegex.MatchString(s) || !isCountryCode(s[4:6]) {
+		return ErrBIC
+	}
+	return nil
+}
+
+// isIBAN checks if a string is an ISO 13616 IBAN of an ISO 3166 country whose check digits are valid
+func (v *validator) isIBAN(s string) error {
+	if !ibanRegex.MatchString(s) || !isCountryCode(s[:2]) {
+		return ErrIBAN
+	}
+	// move the country code and check digits to the end, turn letters into 10-35 and take the number mod 97
+	rem := 0
+	for _, c := range s[4:] + s[:4] {
+		if c >= 'A' {
+			rem = (rem*100 + int(c-'A') + 10) % 97
+		} else {
+			rem = (rem*10 + int(c-'0')) % 97
+		}
+	}
+	if rem != 1 {
+		return ErrIBAN
+	}
+	return nil
+}
+
+// splitBICAccount splits the identifier of a SWIFTBICORBEIANDAccountNumber into the BIC and the account number
+// following it, optionally after a /. Without a / an 11 character BIC is only told apart from an 8 character
+// one when the account is an IBAN, otherwise the branch code is taken to be part of the account.
+func splitBICAccount(s string) (bic, account string) {
+	if bic, account, ok := strings.Cut(s, "/"); ok {
+		return bic, account
+	}
+	switch {
+	case len(s) <= 8, len(s) == 11:
+		return s, ""
+	case len(s) > 11 && !looksLikeIBAN(s[8:]) && looksLikeIBAN(s[11:]):
+		return s[:11], s[11:]
+	}
+	return s[:8], s[8:]
+}
+
+// looksLikeIBAN returns true if s starts like an IBAN, with a country code followed by two check digits
+func looksLikeIBAN(s string) bool {
+	return len(s) > 4 && isCountryCode(s[:2]) && s[2] >= '0' && s[2] <= '9' && s[3] >= '0' && s[3] <= '9'
+}
+
+// isCountryCode returns true if s is an ISO 3166 alpha-2 country code
+func isCountryCode(s string) bool {
+	if len(s) != 2 || strings.ToUpper(s) != s {
+		return false
+	}
+	region, err := language.ParseRegion(s)
+	return err == nil && region.IsCountry() && region.String() == s
+}
+
 func (v *validator) isCurrencyCode(code string) error {
 	_, err := currency.ParseISO(code)
 	if err != nil {
@@ -448,6 +603,22 @@ func (v *validator) validateDate(s string) error {
 	return nil
 }
 
+// isCycleDate checks if a string is a date in CycleDateFormat which is a business day of the Federal Reserve
+// Banks
+func (v *validator) isCycleDate(s string) error {
+	if err := v.validateDate(s); err != nil {
+		return err
+	}
+	date, err := time.ParseInLocation(CycleDateFormat, s, EasternTime)
+	if err != nil {
+		return ErrValidDate
+	}
+	if !IsBusinessDay(date) {
+		return ErrCycleDateNotBusinessDay
+	}
+	return nil
+}
+
 // validatePartyIdentifier validates that PartyIdentifier must be one of the following two formats:
 // 1. /Account Number (slash followed by at least one valid non-space character:  e.g., /123456)
 func (v *validator) validatePartyIdentifier(s string) error {
@@ -57,3 +57,51 @@ func TestValidators__isAlphanumeric(t *testing.T) {
 	require.Error(t, v.isAlphanumeric("{1100}"))
 	require.Error(t, v.isAlphanumeric("*"))
 }
+
+func TestValidators__isRoutingNumber(t *testing.T) {
+	v := &validator{}
+	require.NoError(t, v.isRoutingNumber("121042882"))
+	require.NoError(t, v.isRoutingNumber("021000021"))
+	require.ErrorIs(t, v.isRoutingNumber("121042883"), ErrRoutingNumberCheckDigit)
+	require.ErrorIs(t, v.isRoutingNumber("12104288"), ErrRoutingNumberLength)
+	require.ErrorIs(t, v.isRoutingNumber("1210428820"), ErrRoutingNumberLength)
+	require.ErrorIs(t, v.isRoutingNumber("12104288A"), ErrNonNumeric)
+}
+
+func TestValidators__isBIC(t *testing.T) {
+	v := &validator{}
+	require.NoError(t, v.isBIC("DEUTDEFF"))
+	require.NoError(t, v.isBIC("DEUTDEFF500"))
+	require.NoError(t, v.isBIC("CHASUS33XXX"))
+	require.ErrorIs(t, v.isBIC("DEUTDEF"), ErrBIC)
+	require.ErrorIs(t, v.isBIC("DEUTDEFF50"), ErrBIC)
+	require.ErrorIs(t, v.isBIC("deutdeff"), ErrBIC)
+	require.ErrorIs(t, v.isBIC("DEUTZZFF"), ErrBIC) // not a country
+	require.ErrorIs(t, v.isBIC("DEUT12FF"), ErrBIC)
+}
+
+func TestValidators__isIBAN(t *testing.T) {
+	v := &validator{}
+	require.NoError(t, v.isIBAN("DE89370400440532013000"))
+	require.NoError(t, v.isIBAN("GB82WEST12345698765432"))
+	require.ErrorIs(t, v.isIBAN("DE88370400440532013000"), ErrIBAN)
+	require.ErrorIs(t, v.isIBAN("ZZ89370400440532013000"), ErrIBAN)
+	require.ErrorIs(t, v.isIBAN("DE89 3704 0044 0532 0130 00"), ErrIBAN)
+	require.ErrorIs(t, v.isIBAN("DE89"), ErrIBAN)
+}
+
+func TestValidators__isSWIFTIdentifier(t *testing.T) {
+	v := &validator{}
+	require.NoError(t, v.isSWIFTIdentifier(SWIFTBankIdentifierCode, "DEUTDEFF"))
+	require.ErrorIs(t, v.isSWIFTIdentifier(SWIFTBankIdentifierCode, "1234"), ErrBIC)
+
+	require.NoError(t, v.isSWIFTIdentifier(SWIFTBICORBEIANDAccountNumber, "DEUTDEFF/DE89370400440532013000"))
+	require.NoError(t, v.isSWIFTIdentifier(SWIFTBICORBEIANDAccountNumber, "DEUTDEFFDE89370400440532013000"))
+	require.NoError(t, v.isSWIFTIdentifier(SWIFTBICORBEIANDAccountNumber, "DEUTDEFF500DE89370400440532013000"))
+	require.NoError(t, v.isSWIFTIdentifier(SWIFTBICORBEIANDAccountNumber, "CHASUS33123456789"))
+	require.ErrorIs(t, v.isSWIFTIdentifier(SWIFTBICORBEIANDAccountNumber, "DEUTDEFF/DE88370400440532013000"), ErrIBAN)
+	require.ErrorIs(t, v.isSWIFTIdentifier(SWIFTBICORBEIANDAccountNumber, "000100011"), ErrBIC)
+
+	// other codes aren't checked
+	require.NoError(t, v.isSWIFTIdentifier(DemandDepositAccountNumber, "1234"))
+}
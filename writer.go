@@ -56,7 +56,7 @@ func NewWriter(w io.Writer, opts ...OptionFunc) *Writer {
 	return writer
 }
 
-// Writer writes a single FEDWireMessage record to w
+// Writer writes each FEDWireMessage record in file to w, one after another
 // options
 //
 //	first bool : has variable length
@@ -67,10 +67,12 @@ func (w *Writer) Write(file *File) error {
 	}
 	w.lineNum = 0
 	// Iterate over all records in the file
-	if err := w.writeFEDWireMessage(file); err != nil {
-		return err
+	for i := range file.FEDWireMessages {
+		if err := w.writeFEDWireMessage(file.FEDWireMessages[i]); err != nil {
+			return err
+		}
+		w.lineNum++
 	}
-	w.lineNum++
 
 	return w.w.Flush()
 }
@@ -82,9 +84,7 @@ func (w *Writer) Flush() error {
 	return w.w.Flush()
 }
 
-func (w *Writer) writeFEDWireMessage(file *File) error {
-	fwm := file.FEDWireMessage
-
+func (w *Writer) writeFEDWireMessage(fwm FEDWireMessage) error {
 	var outputLines []string
 
 	mandatoryLines, err := w.writeMandatory(fwm)
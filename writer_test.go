@@ -33,7 +33,7 @@ func TestSenderSupplied_Mandatory(t *testing.T) {
 
 	err := file.Validate()
 
-	require.EqualError(t, err, fieldError("SenderSupplied", ErrFieldRequired).Error())
+	require.EqualError(t, err, NewMessageError(0, fieldError("SenderSupplied", ErrFieldRequired)).Error())
 }
 
 func TestTypeSubType_Mandatory(t *testing.T) {
@@ -61,7 +61,7 @@ func TestTypeSubType_Mandatory(t *testing.T) {
 
 	err := file.Validate()
 
-	require.EqualError(t, err, fieldError("TypeSubType", ErrFieldRequired).Error())
+	require.EqualError(t, err, NewMessageError(0, fieldError("TypeSubType", ErrFieldRequired)).Error())
 }
 
 func TestInputMessageAccountabilityData_Mandatory(t *testing.T) {
@@ -89,7 +89,7 @@ func TestInputMessageAccountabilityData_Mandatory(t *testing.T) {
 
 	err := file.Validate()
 
-	require.EqualError(t, err, fieldError("InputMessageAccountabilityData", ErrFieldRequired).Error())
+	require.EqualError(t, err, NewMessageError(0, fieldError("InputMessageAccountabilityData", ErrFieldRequired)).Error())
 }
 
 func TestAmount_Mandatory(t *testing.T) {
@@ -117,7 +117,7 @@ func TestAmount_Mandatory(t *testing.T) {
 
 	err := file.Validate()
 
-	require.EqualError(t, err, fieldError("Amount", ErrFieldRequired).Error())
+	require.EqualError(t, err, NewMessageError(0, fieldError("Amount", ErrFieldRequired)).Error())
 }
 
 func TestSenderDepositoryInstitution_Mandatory(t *testing.T) {
@@ -145,7 +145,7 @@ func TestSenderDepositoryInstitution_Mandatory(t *testing.T) {
 
 	err := file.Validate()
 
-	require.EqualError(t, err, fieldError("SenderDepositoryInstitution", ErrFieldRequired).Error())
+	require.EqualError(t, err, NewMessageError(0, fieldError("SenderDepositoryInstitution", ErrFieldRequired)).Error())
 }
 
 func TestReceiverDepositoryInstitution_Mandatory(t *testing.T) {
@@ -173,7 +173,7 @@ func TestReceiverDepositoryInstitution_Mandatory(t *testing.T) {
 
 	err := file.Validate()
 
-	require.EqualError(t, err, fieldError("ReceiverDepositoryInstitution", ErrFieldRequired).Error())
+	require.EqualError(t, err, NewMessageError(0, fieldError("ReceiverDepositoryInstitution", ErrFieldRequired)).Error())
 }
 
 func TestBusinessFunctionCode_Mandatory(t *testing.T) {
@@ -201,7 +201,7 @@ func TestBusinessFunctionCode_Mandatory(t *testing.T) {
 
 	err := file.Validate()
 
-	require.EqualError(t, err, fieldError("BusinessFunctionCode", ErrFieldRequired).Error())
+	require.EqualError(t, err, NewMessageError(0, fieldError("BusinessFunctionCode", ErrFieldRequired)).Error())
 }
 
 // TestFEDWireMessageWriteBankTransfer writes a FEDWireMessage to a file with BusinessFunctionCode = BTR